@@ -4,15 +4,27 @@ package main
 import "github.com/aryszka/mml"
 
 var _args interface{} = mml.Args
+var _atomic interface{} = mml.Atomic
+var _await interface{} = mml.Await
 var _bool interface{} = mml.Bool
+var _bufchan interface{} = mml.Bufchan
+var _cancel interface{} = mml.Cancel
+var _chan interface{} = mml.Chan
 var _close interface{} = mml.Close
+var _closed interface{} = mml.Closed
 var _error interface{} = mml.Error
+var _executable interface{} = mml.Executable
+var _execute interface{} = mml.Execute
 var _exit interface{} = mml.Exit
 var _float interface{} = mml.Float
 var _format interface{} = mml.Format
+var _formatGo interface{} = mml.FormatGo
+var _getEnv interface{} = mml.GetEnv
 var _has interface{} = mml.Has
 var _int interface{} = mml.Int
+var _interopUse interface{} = mml.InteropUse
 var _isBool interface{} = mml.IsBool
+var _isCancelled interface{} = mml.IsCancelled
 var _isChannel interface{} = mml.IsChannel
 var _isError interface{} = mml.IsError
 var _isFloat interface{} = mml.IsFloat
@@ -23,29 +35,68 @@ var _isString interface{} = mml.IsString
 var _isStruct interface{} = mml.IsStruct
 var _keys interface{} = mml.Keys
 var _len interface{} = mml.Len
+var _listFilter interface{} = mml.ListFilter
+var _listFold interface{} = mml.ListFold
+var _listFoldr interface{} = mml.ListFoldr
+var _listMap interface{} = mml.ListMap
+var _listSort interface{} = mml.ListSort
+var _makeDir interface{} = mml.MakeDir
+var _makeTempDir interface{} = mml.MakeTempDir
+var _mutex interface{} = mml.Mutex
+var _once interface{} = mml.Once
 var _open interface{} = mml.Open
 var _panic interface{} = mml.Panic
 var _parseAST interface{} = mml.ParseAST
 var _parseFloat interface{} = mml.ParseFloat
 var _parseInt interface{} = mml.ParseInt
+var _readDir interface{} = mml.ReadDir
+var _removeAll interface{} = mml.RemoveAll
+var _spawn interface{} = mml.Spawn
+var _spawnIn interface{} = mml.SpawnIn
 var _stderr interface{} = mml.Stderr
 var _stdin interface{} = mml.Stdin
+var _stdlibSource interface{} = mml.StdlibSource
 var _stdout interface{} = mml.Stdout
 var _string interface{} = mml.String
+var _stringEscape interface{} = mml.StringEscape
+var _stringHash interface{} = mml.StringHash
+var _stringJoin interface{} = mml.StringJoin
+var _stringSplit interface{} = mml.StringSplit
+var _stringUnescape interface{} = mml.StringUnescape
+var _symlink interface{} = mml.Symlink
+var _taskGroup interface{} = mml.TaskGroup
+var _wait interface{} = mml.Wait
+var _workDir interface{} = mml.WorkDir
+var _writeFile interface{} = mml.WriteFile
 
 func init() {
 	var modulePath string
 	modulePath = "main"
 
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
 		exports := make(map[string]interface{})
 
 		var c interface{}
 		mml.Nop(c)
 
+		var _usage string
+		var _warnModules interface{}
+		var _warn interface{}
+		var _checkInterop interface{}
+		var _program interface{}
+		var _goCode interface{}
+		var _packages interface{}
+		var _build interface{}
+		var _run interface{}
+		var _binaryName interface{}
+		var _paths interface{}
 		var _read interface{}
 		var _errors interface{}
 		var _compile interface{}
+		var _cache interface{}
+		var _toolchain interface{}
+		var _races interface{}
+		var _signatures interface{}
 		var _fold interface{}
 		var _foldr interface{}
 		var _map interface{}
@@ -54,8 +105,6 @@ func init() {
 		var _sort interface{}
 		var _flat interface{}
 		var _flats interface{}
-		var _concat interface{}
-		var _concats interface{}
 		var _uniq interface{}
 		var _every interface{}
 		var _some interface{}
@@ -82,64 +131,246 @@ func init() {
 		var _and interface{}
 		var _not interface{}
 		var _predicate interface{}
-		var _predicates interface{}
 		var _is interface{}
-		mml.Nop(_read, _errors, _compile, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _flats, _concat, _concats, _uniq, _every, _some, _join, _joins, _formats, _enum, _log, _fatal, _bind, _identity, _eq, _any, _function, _channel, _natural, _type, _listOf, _structOf, _range, _rangeMin, _listLength, _or, _and, _not, _predicate, _predicates, _is)
-		var __lang = mml.Modules.Use("lang")
-		_fold = __lang.Values["fold"]
-		_foldr = __lang.Values["foldr"]
-		_map = __lang.Values["map"]
-		_filter = __lang.Values["filter"]
-		_contains = __lang.Values["contains"]
-		_sort = __lang.Values["sort"]
-		_flat = __lang.Values["flat"]
-		_flats = __lang.Values["flats"]
-		_concat = __lang.Values["concat"]
-		_concats = __lang.Values["concats"]
-		_uniq = __lang.Values["uniq"]
-		_every = __lang.Values["every"]
-		_some = __lang.Values["some"]
-		_join = __lang.Values["join"]
-		_joins = __lang.Values["joins"]
-		_formats = __lang.Values["formats"]
-		_enum = __lang.Values["enum"]
-		_log = __lang.Values["log"]
-		_fatal = __lang.Values["fatal"]
-		_bind = __lang.Values["bind"]
-		_identity = __lang.Values["identity"]
-		_eq = __lang.Values["eq"]
-		_any = __lang.Values["any"]
-		_function = __lang.Values["function"]
-		_channel = __lang.Values["channel"]
-		_natural = __lang.Values["natural"]
-		_type = __lang.Values["type"]
-		_listOf = __lang.Values["listOf"]
-		_structOf = __lang.Values["structOf"]
-		_range = __lang.Values["range"]
-		_rangeMin = __lang.Values["rangeMin"]
-		_listLength = __lang.Values["listLength"]
-		_or = __lang.Values["or"]
-		_and = __lang.Values["and"]
-		_not = __lang.Values["not"]
-		_predicate = __lang.Values["predicate"]
-		_predicates = __lang.Values["predicates"]
-		_is = __lang.Values["is"]
-		_read = mml.Modules.Use("read")
-		_errors = mml.Modules.Use("errors")
-		_compile = mml.Modules.Use("compile")
-		c = mml.BinaryOp(13, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _args)}).Values), 2)
-		if c.(bool) {
+		var direct_warnModules func(interface{}) interface{}
+		mml.Nop(direct_warnModules)
+		var direct_warn func(interface{}) interface{}
+		mml.Nop(direct_warn)
+		var direct_checkInterop func(interface{}) interface{}
+		mml.Nop(direct_checkInterop)
+		var direct_program func(interface{}) interface{}
+		mml.Nop(direct_program)
+		var direct_goCode func(interface{}) interface{}
+		mml.Nop(direct_goCode)
+		var direct_packages func(interface{}) interface{}
+		mml.Nop(direct_packages)
+		var direct_build func(interface{}, interface{}) interface{}
+		mml.Nop(direct_build)
+		var direct_run func(interface{}, interface{}) interface{}
+		mml.Nop(direct_run)
+		var direct_binaryName func(interface{}) interface{}
+		mml.Nop(direct_binaryName)
+		mml.Nop(_usage, _warnModules, _warn, _checkInterop, _program, _goCode, _packages, _build, _run, _binaryName, _paths, _read, _errors, _compile, _cache, _toolchain, _races, _signatures, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _flats, _uniq, _every, _some, _join, _joins, _formats, _enum, _log, _fatal, _bind, _identity, _eq, _any, _function, _channel, _natural, _type, _listOf, _structOf, _range, _rangeMin, _listLength, _or, _and, _not, _predicate, _is)
+		var __lang = use("lang")
+		_fold = __lang.Get("fold")
+		_foldr = __lang.Get("foldr")
+		_map = __lang.Get("map")
+		_filter = __lang.Get("filter")
+		_contains = __lang.Get("contains")
+		_sort = __lang.Get("sort")
+		_flat = __lang.Get("flat")
+		_flats = __lang.Get("flats")
+		_uniq = __lang.Get("uniq")
+		_every = __lang.Get("every")
+		_some = __lang.Get("some")
+		_join = __lang.Get("join")
+		_joins = __lang.Get("joins")
+		_formats = __lang.Get("formats")
+		_enum = __lang.Get("enum")
+		_log = __lang.Get("log")
+		_fatal = __lang.Get("fatal")
+		_bind = __lang.Get("bind")
+		_identity = __lang.Get("identity")
+		_eq = __lang.Get("eq")
+		_any = __lang.Get("any")
+		_function = __lang.Get("function")
+		_channel = __lang.Get("channel")
+		_natural = __lang.Get("natural")
+		_type = __lang.Get("type")
+		_listOf = __lang.Get("listOf")
+		_structOf = __lang.Get("structOf")
+		_range = __lang.Get("range")
+		_rangeMin = __lang.Get("rangeMin")
+		_listLength = __lang.Get("listLength")
+		_or = __lang.Get("or")
+		_and = __lang.Get("and")
+		_not = __lang.Get("not")
+		_predicate = __lang.Get("predicate")
+		_is = __lang.Get("is")
+		_paths = use("paths")
+		_read = use("read")
+		_errors = use("errors")
+		_compile = use("compile")
+		_cache = use("cache")
+		_toolchain = use("toolchain")
+		_races = use("races")
+		_signatures = use("signatures")
+		_usage = "usage:\n\tmml <module>                       prints the Go code of a program\n\tmml -cache <directory> <module>    compiles the modules of a program into separate Go files\n\tmml -lib <package> <module>        prints the Go code of a library package\n\tmml build <module> [-o <binary>]   builds an executable binary\n\tmml run <module> [arguments...]    builds and runs a program"
+		direct_warnModules = func(_modules interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _warnings interface{}
+			mml.Nop(_warnings)
+			_warnings = mml.Ref(_races, "find").(*mml.Function).Call([]interface{}{_modules})
+			for _, _w := range _warnings.(*mml.List).Values() {
+
+				mml.Nop()
+				_log.(*mml.Function).Call([]interface{}{"warning:", _w})
+			}
+			return _modules
+		}
+		_warnModules = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_warnModules(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_warn = func(_module interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			direct_warnModules(mml.Ref(_compile, "allModules").(*mml.Function).Call([]interface{}{_module}))
+			return _module
+		}
+		_warn = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_warn(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_checkInterop = func(_module interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _checked interface{}
+			mml.Nop(_checked)
+			_checked = mml.Ref(_toolchain, "checkInterop").(*mml.Function).Call([]interface{}{mml.Ref(_compile, "allModules").(*mml.Function).Call([]interface{}{_module})})
+			if v := _checked; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			return _module
+		}
+		_checkInterop = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_checkInterop(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_program = func(_path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return mml.Ref(_errors, "pass").(*mml.Function).Call([]interface{}{_checkInterop}).(*mml.Function).Call([]interface{}{mml.Ref(_errors, "pass").(*mml.Function).Call([]interface{}{_warn}).(*mml.Function).Call([]interface{}{mml.Ref(_read, "do").(*mml.Function).Call([]interface{}{_path})})})
+		}
+		_program = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_program(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_goCode = func(_path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return mml.Ref(_errors, "pass").(*mml.Function).Call([]interface{}{mml.Ref(_compile, "toGo")}).(*mml.Function).Call([]interface{}{direct_program(_path)})
+		}
+		_goCode = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_goCode(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_packages = func(_module interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return mml.Ref(_signatures, "packages").(*mml.Function).Call([]interface{}{mml.Ref(_compile, "allModules").(*mml.Function).Call([]interface{}{_module})})
+		}
+		_packages = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_packages(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_build = func(_output, _path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _module interface{}
+			var _code interface{}
+			mml.Nop(_module, _code)
+			_module = direct_program(_path)
+			if v := _module; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_code = mml.Ref(_compile, "toGo").(*mml.Function).Call([]interface{}{_module})
+			if v := _code; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			return mml.Ref(_toolchain, "build").(*mml.Function).Call([]interface{}{_output, direct_packages(_module), _code})
+		}
+		_build = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_build(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_run = func(_args, _path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _module interface{}
+			var _code interface{}
+			mml.Nop(_module, _code)
+			_module = direct_program(_path)
+			if v := _module; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_code = mml.Ref(_compile, "toGo").(*mml.Function).Call([]interface{}{_module})
+			if v := _code; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			return mml.Ref(_toolchain, "run").(*mml.Function).Call([]interface{}{_args, direct_packages(_module), _code})
+		}
+		_run = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_run(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_binaryName = func(_path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return mml.Ref(_paths, "base").(*mml.Function).Call([]interface{}{mml.Ref(_paths, "trimExtension").(*mml.Function).Call([]interface{}{_path})})
+		}
+		_binaryName = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_binaryName(a[0])
+			},
+			FixedArgs: 1,
+		}
+		switch {
+		case (mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_args}), 4).(bool) && mml.BinaryOp(11, mml.Ref(_args, 1), "-cache").(bool)):
+
+			mml.Nop()
+			mml.Ref(_errors, "only").(*mml.Function).Call([]interface{}{_fatal}).(*mml.Function).Call([]interface{}{mml.Ref(_errors, "pass").(*mml.Function).Call([]interface{}{_warnModules}).(*mml.Function).Call([]interface{}{mml.Ref(_cache, "build").(*mml.Function).Call([]interface{}{mml.Ref(_args, 2)}).(*mml.Function).Call([]interface{}{mml.Ref(_args, 3)})})})
+		case (mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_args}), 4).(bool) && mml.BinaryOp(11, mml.Ref(_args, 1), "-lib").(bool)):
+
+			mml.Nop()
+			mml.Ref(_errors, "only").(*mml.Function).Call([]interface{}{_fatal}).(*mml.Function).Call([]interface{}{mml.Ref(_errors, "pass").(*mml.Function).Call([]interface{}{_stdout}).(*mml.Function).Call([]interface{}{mml.Ref(_errors, "pass").(*mml.Function).Call([]interface{}{_formatGo}).(*mml.Function).Call([]interface{}{mml.Ref(_errors, "pass").(*mml.Function).Call([]interface{}{mml.Ref(_compile, "libraryToGo").(*mml.Function).Call([]interface{}{mml.Ref(_args, 2)})}).(*mml.Function).Call([]interface{}{direct_program(mml.Ref(_args, 3))})})})})
+		case (mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_args}), 3).(bool) && mml.BinaryOp(11, mml.Ref(_args, 1), "build").(bool)):
+
+			mml.Nop()
+			mml.Ref(_errors, "only").(*mml.Function).Call([]interface{}{_fatal}).(*mml.Function).Call([]interface{}{_build.(*mml.Function).Call([]interface{}{direct_binaryName(mml.Ref(_args, 2))}).(*mml.Function).Call([]interface{}{mml.Ref(_args, 2)})})
+		case ((mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_args}), 5).(bool) && mml.BinaryOp(11, mml.Ref(_args, 1), "build").(bool)) && mml.BinaryOp(11, mml.Ref(_args, 3), "-o").(bool)):
+
+			mml.Nop()
+			mml.Ref(_errors, "only").(*mml.Function).Call([]interface{}{_fatal}).(*mml.Function).Call([]interface{}{_build.(*mml.Function).Call([]interface{}{mml.Ref(_args, 4)}).(*mml.Function).Call([]interface{}{mml.Ref(_args, 2)})})
+		case (mml.BinaryOp(16, _len.(*mml.Function).Call([]interface{}{_args}), 3).(bool) && mml.BinaryOp(11, mml.Ref(_args, 1), "run").(bool)):
+
+			mml.Nop()
+			mml.Ref(_errors, "only").(*mml.Function).Call([]interface{}{_fatal}).(*mml.Function).Call([]interface{}{mml.Ref(_errors, "pass").(*mml.Function).Call([]interface{}{_exit}).(*mml.Function).Call([]interface{}{_run.(*mml.Function).Call([]interface{}{mml.RefRange(_args, 3, nil)}).(*mml.Function).Call([]interface{}{mml.Ref(_args, 2)})})})
+		case mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_args}), 2):
+
+			mml.Nop()
+			mml.Ref(_errors, "only").(*mml.Function).Call([]interface{}{_fatal}).(*mml.Function).Call([]interface{}{mml.Ref(_errors, "pass").(*mml.Function).Call([]interface{}{_stdout}).(*mml.Function).Call([]interface{}{direct_goCode(mml.Ref(_args, 1))})})
+		default:
+
 			mml.Nop()
-			_fatal.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "usage: mml <source without the extension>")}).Values)
+			_fatal.(*mml.Function).Call([]interface{}{_usage})
 		}
-		mml.Ref(_errors, "only").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _fatal)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_errors, "pass").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _stdout)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_errors, "pass").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_compile, "toGo"))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_read, "do").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_args, 1))}).Values))}).Values))}).Values))}).Values)
 
 		return exports
 	})
 
 	modulePath = "lang"
 
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
 		exports := make(map[string]interface{})
 
 		var c interface{}
@@ -153,8 +384,6 @@ func init() {
 		var _sort interface{}
 		var _flat interface{}
 		var _flats interface{}
-		var _concat interface{}
-		var _concats interface{}
 		var _uniq interface{}
 		var _every interface{}
 		var _some interface{}
@@ -181,7 +410,6 @@ func init() {
 		var _and interface{}
 		var _not interface{}
 		var _predicate interface{}
-		var _predicates interface{}
 		var _is interface{}
 		var _lists interface{}
 		var _strings interface{}
@@ -189,13 +417,9 @@ func init() {
 		var _functions interface{}
 		var _match interface{}
 		var _logger interface{}
-		mml.Nop(_fold, _foldr, _map, _filter, _contains, _sort, _flat, _flats, _concat, _concats, _uniq, _every, _some, _join, _joins, _formats, _enum, _log, _fatal, _bind, _identity, _eq, _any, _function, _channel, _natural, _type, _listOf, _structOf, _range, _rangeMin, _listLength, _or, _and, _not, _predicate, _predicates, _is, _lists, _strings, _ints, _functions, _match, _logger)
-		_lists = mml.Modules.Use("lists")
-		_strings = mml.Modules.Use("strings")
-		_ints = mml.Modules.Use("ints")
-		_logger = mml.Modules.Use("log")
-		_functions = mml.Modules.Use("functions")
-		_match = mml.Modules.Use("match")
+		mml.Nop(_fold, _foldr, _map, _filter, _contains, _sort, _flat, _flats, _uniq, _every, _some, _join, _joins, _formats, _enum, _log, _fatal, _bind, _identity, _eq, _any, _function, _channel, _natural, _type, _listOf, _structOf, _range, _rangeMin, _listLength, _or, _and, _not, _predicate, _is, _lists, _strings, _ints, _functions, _match, _logger)
+		_logger = use("log")
+		_lists = use("lists")
 		_fold = mml.Ref(_lists, "fold")
 		exports["fold"] = _fold
 		_foldr = mml.Ref(_lists, "foldr")
@@ -212,34 +436,34 @@ func init() {
 		exports["flat"] = _flat
 		_flats = mml.Ref(_lists, "flats")
 		exports["flats"] = _flats
-		_concat = mml.Ref(_lists, "concat")
-		exports["concat"] = _concat
-		_concats = mml.Ref(_lists, "concats")
-		exports["concats"] = _concats
 		_uniq = mml.Ref(_lists, "uniq")
 		exports["uniq"] = _uniq
 		_every = mml.Ref(_lists, "every")
 		exports["every"] = _every
 		_some = mml.Ref(_lists, "some")
 		exports["some"] = _some
+		_strings = use("strings")
 		_join = mml.Ref(_strings, "join")
 		exports["join"] = _join
 		_joins = mml.Ref(_strings, "joins")
 		exports["joins"] = _joins
 		_formats = mml.Ref(_strings, "formats")
 		exports["formats"] = _formats
+		_ints = use("ints")
 		_enum = mml.Ref(_ints, "enum")
 		exports["enum"] = _enum
 		_log = mml.Ref(_logger, "println")
 		exports["log"] = _log
 		_fatal = mml.Ref(_logger, "fatal")
 		exports["fatal"] = _fatal
+		_functions = use("functions")
 		_bind = mml.Ref(_functions, "bind")
 		exports["bind"] = _bind
 		_identity = mml.Ref(_functions, "identity")
 		exports["identity"] = _identity
 		_eq = mml.Ref(_functions, "eq")
 		exports["eq"] = _eq
+		_match = use("match")
 		_any = mml.Ref(_match, "any")
 		exports["any"] = _any
 		_function = mml.Ref(_match, "function")
@@ -268,17 +492,73 @@ func init() {
 		exports["not"] = _not
 		_predicate = mml.Ref(_match, "predicate")
 		exports["predicate"] = _predicate
-		_predicates = mml.Ref(_match, "predicates")
-		exports["predicates"] = _predicates
 		_is = mml.Ref(_match, "is")
 		exports["is"] = _is
 
 		return exports
 	})
 
+	modulePath = "log"
+
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
+		exports := make(map[string]interface{})
+
+		var c interface{}
+		mml.Nop(c)
+
+		var _println interface{}
+		var _fatal interface{}
+		var _lists interface{}
+		var _strings interface{}
+		mml.Nop(_println, _fatal, _lists, _strings)
+		_lists = use("lists")
+		_strings = use("strings")
+		_println = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				var c interface{}
+				mml.Nop(c)
+				var _a interface{}
+				_a = mml.NewList(a[0:])
+				mml.Nop(_a)
+
+				mml.Nop()
+				_stderr.(*mml.Function).Call([]interface{}{mml.Ref(_strings, "join").(*mml.Function).Call([]interface{}{" "}).(*mml.Function).Call([]interface{}{mml.Ref(_lists, "map").(*mml.Function).Call([]interface{}{_string}).(*mml.Function).Call([]interface{}{_a})})})
+				_stderr.(*mml.Function).Call([]interface{}{"\n"})
+				return func() interface{} {
+					c = mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_a}), 0)
+					if c.(bool) {
+						return ""
+					} else {
+						return mml.Ref(_a, mml.BinaryOp(10, _len.(*mml.Function).Call([]interface{}{_a}), 1))
+					}
+				}()
+			},
+			FixedArgs: 0,
+		}
+		exports["println"] = _println
+		_fatal = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				var c interface{}
+				mml.Nop(c)
+				var _a interface{}
+				_a = mml.NewList(a[0:])
+				mml.Nop(_a)
+
+				mml.Nop()
+				_println.(*mml.Function).Call(append([]interface{}{}, _a.(*mml.List).Values()...))
+				_exit.(*mml.Function).Call([]interface{}{1})
+				return nil
+			},
+			FixedArgs: 0,
+		}
+		exports["fatal"] = _fatal
+
+		return exports
+	})
+
 	modulePath = "lists"
 
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
 		exports := make(map[string]interface{})
 
 		var c interface{}
@@ -288,211 +568,108 @@ func init() {
 		var _foldr interface{}
 		var _map interface{}
 		var _filter interface{}
+		var _sort interface{}
 		var _first interface{}
 		var _contains interface{}
-		var _concat interface{}
-		var _concats interface{}
 		var _flat interface{}
 		var _flats interface{}
 		var _uniq interface{}
 		var _every interface{}
 		var _some interface{}
-		var _intersect interface{}
-		var _sort interface{}
 		var _group interface{}
 		var _indexes interface{}
 		var _flatDepth interface{}
-		mml.Nop(_fold, _foldr, _map, _filter, _first, _contains, _concat, _concats, _flat, _flats, _uniq, _every, _some, _intersect, _sort, _group, _indexes, _flatDepth)
-		_fold = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _f = a[0]
-				var _i = a[1]
-				var _l = a[2]
-				var _ interface{}
-				_ = &mml.List{a[3:]}
-				mml.Nop(_f, _i, _l)
-				return func() interface{} {
-					c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _l)}).Values), 0)
-					if c.(bool) {
-						return _i
-					} else {
-						return _fold.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _f, _f.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_l, 0), _i)}).Values), mml.RefRange(_l, 1, nil))}).Values)
-					}
-				}()
-			},
-			FixedArgs: 3,
-		}
+		var direct_first func(interface{}, interface{}) interface{}
+		mml.Nop(direct_first)
+		var direct_contains func(interface{}, interface{}) interface{}
+		mml.Nop(direct_contains)
+		var direct_flat func(interface{}) interface{}
+		mml.Nop(direct_flat)
+		var direct_uniq func(interface{}, interface{}) interface{}
+		mml.Nop(direct_uniq)
+		var direct_every func(interface{}, interface{}) interface{}
+		mml.Nop(direct_every)
+		var direct_some func(interface{}, interface{}) interface{}
+		mml.Nop(direct_some)
+		var direct_group func(interface{}, interface{}) interface{}
+		mml.Nop(direct_group)
+		var direct_indexes func(interface{}) interface{}
+		mml.Nop(direct_indexes)
+		var direct_flatDepth func(interface{}, interface{}) interface{}
+		mml.Nop(direct_flatDepth)
+		mml.Nop(_fold, _foldr, _map, _filter, _sort, _first, _contains, _flat, _flats, _uniq, _every, _some, _group, _indexes, _flatDepth)
+		_fold = _listFold
 		exports["fold"] = _fold
-		_foldr = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _f = a[0]
-				var _i = a[1]
-				var _l = a[2]
-				var _ interface{}
-				_ = &mml.List{a[3:]}
-				mml.Nop(_f, _i, _l)
-				return func() interface{} {
-					c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _l)}).Values), 0)
-					if c.(bool) {
-						return _i
-					} else {
-						return _f.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_l, 0), _foldr.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _f, _i, mml.RefRange(_l, 1, nil))}).Values))}).Values)
-					}
-				}()
-			},
-			FixedArgs: 3,
-		}
+		_foldr = _listFoldr
 		exports["foldr"] = _foldr
-		_map = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _m = a[0]
-				var _l = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_m, _l)
-				return _fold.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _c = a[0]
-						var _r = a[1]
-						var _ interface{}
-						_ = &mml.List{a[2:]}
-						mml.Nop(_c, _r)
-						return &mml.List{Values: append(append([]interface{}{}, _r.(*mml.List).Values...), _m.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _c)}).Values))}
-					},
-					FixedArgs: 2,
-				}, &mml.List{Values: []interface{}{}}, _l)}).Values)
-			},
-			FixedArgs: 2,
-		}
+		_map = _listMap
 		exports["map"] = _map
-		_filter = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _p = a[0]
-				var _l = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_p, _l)
-				return _fold.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _c = a[0]
-						var _r = a[1]
-						var _ interface{}
-						_ = &mml.List{a[2:]}
-						mml.Nop(_c, _r)
-						return func() interface{} {
-							c = _p.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _c)}).Values)
-							if c.(bool) {
-								return &mml.List{Values: append(append([]interface{}{}, _r.(*mml.List).Values...), _c)}
-							} else {
-								return _r
-							}
-						}()
-					},
-					FixedArgs: 2,
-				}, &mml.List{Values: []interface{}{}}, _l)}).Values)
-			},
-			FixedArgs: 2,
-		}
+		_filter = _listFilter
 		exports["filter"] = _filter
-		_first = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
+		_sort = _listSort
+		exports["sort"] = _sort
+		direct_first = func(_p, _l interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			a := []interface{}{_p, _l}
+			_ = a
+		tailcall:
+			for {
 				var _p = a[0]
 				var _l = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
 				mml.Nop(_p, _l)
-				return func() interface{} {
-					c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _l)}).Values), 0)
+
+				mml.Nop()
+				c = mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_l}), 0)
+				if c.(bool) {
+					return (&mml.List{})
+				} else {
+					c = _p.(*mml.Function).Call([]interface{}{mml.Ref(_l, 0)})
 					if c.(bool) {
-						return &mml.List{Values: []interface{}{}}
+						return _l
 					} else {
-						return func() interface{} {
-							c = _p.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_l, 0))}).Values)
-							if c.(bool) {
-								return _l
-							} else {
-								return _first.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _p, mml.RefRange(_l, 1, nil))}).Values)
-							}
-						}()
+						a = []interface{}{_p, mml.RefRange(_l, 1, nil)}
+						continue tailcall
 					}
-				}()
+				}
+			}
+		}
+		_first = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_first(a[0], a[1])
 			},
 			FixedArgs: 2,
 		}
 		exports["first"] = _first
+		direct_contains = func(_i, _l interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return mml.BinaryOp(15, _len.(*mml.Function).Call([]interface{}{direct_first(&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _ii = a[0]
+					mml.Nop(_ii)
+					return mml.BinaryOp(11, _ii, _i)
+				},
+				FixedArgs: 1,
+			}, _l)}), 0)
+		}
 		_contains = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _i = a[0]
-				var _l = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_i, _l)
-				return mml.BinaryOp(15, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _first.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _ii = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_ii)
-						return mml.BinaryOp(11, _ii, _i)
-					},
-					FixedArgs: 1,
-				}, _l)}).Values))}).Values), 0)
+				return direct_contains(a[0], a[1])
 			},
 			FixedArgs: 2,
 		}
 		exports["contains"] = _contains
-		_concat = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _l = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_l)
-				return _flat.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _l)}).Values)
-			},
-			FixedArgs: 1,
-		}
-		exports["concat"] = _concat
-		_concats = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _l interface{}
-				_l = &mml.List{a[0:]}
-				mml.Nop(_l)
-				return _concat.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _l)}).Values)
-			},
-			FixedArgs: 0,
+		direct_flat = func(_l interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return direct_flatDepth(1, _l)
 		}
-		exports["concats"] = _concats
 		_flat = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _l = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_l)
-				return _flatDepth.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, 1, _l)}).Values)
+				return direct_flat(a[0])
 			},
 			FixedArgs: 1,
 		}
@@ -502,274 +679,177 @@ func init() {
 				var c interface{}
 				mml.Nop(c)
 				var _l interface{}
-				_l = &mml.List{a[0:]}
+				_l = mml.NewList(a[0:])
 				mml.Nop(_l)
-				return _flat.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _l)}).Values)
+				return direct_flat(_l)
 			},
 			FixedArgs: 0,
 		}
 		exports["flats"] = _flats
+		direct_uniq = func(_eq, _l interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _c = a[0]
+					var _u = a[1]
+					mml.Nop(_c, _u)
+					return func() interface{} {
+						c = mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{&mml.Function{
+							F: func(a []interface{}) interface{} {
+								var c interface{}
+								mml.Nop(c)
+								var _i = a[0]
+								mml.Nop(_i)
+								return _eq.(*mml.Function).Call([]interface{}{_i, _c})
+							},
+							FixedArgs: 1,
+						}, _u})}), 0)
+						if c.(bool) {
+							return (&mml.List{}).Concat(_u.(*mml.List)).Append(_c)
+						} else {
+							return _u
+						}
+					}()
+				},
+				FixedArgs: 2,
+			}, (&mml.List{}), _l})
+		}
 		_uniq = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _eq = a[0]
-				var _l = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_eq, _l)
-				return _fold.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _c = a[0]
-						var _u = a[1]
-						var _ interface{}
-						_ = &mml.List{a[2:]}
-						mml.Nop(_c, _u)
-						return func() interface{} {
-							c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-								F: func(a []interface{}) interface{} {
-									var c interface{}
-									mml.Nop(c)
-									var _i = a[0]
-									var _ interface{}
-									_ = &mml.List{a[1:]}
-									mml.Nop(_i)
-									return _eq.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _i, _c)}).Values)
-								},
-								FixedArgs: 1,
-							}, _u)}).Values))}).Values), 0)
-							if c.(bool) {
-								return &mml.List{Values: append(append([]interface{}{}, _u.(*mml.List).Values...), _c)}
-							} else {
-								return _u
-							}
-						}()
-					},
-					FixedArgs: 2,
-				}, &mml.List{Values: []interface{}{}}, _l)}).Values)
+				return direct_uniq(a[0], a[1])
 			},
 			FixedArgs: 2,
 		}
 		exports["uniq"] = _uniq
+		direct_every = func(_p, _l interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _i = a[0]
+					var _r = a[1]
+					mml.Nop(_i, _r)
+					return (_r.(bool) && _p.(*mml.Function).Call([]interface{}{_i}).(bool))
+				},
+				FixedArgs: 2,
+			}, true, _l})
+		}
 		_every = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _p = a[0]
-				var _l = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_p, _l)
-				return _fold.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _i = a[0]
-						var _r = a[1]
-						var _ interface{}
-						_ = &mml.List{a[2:]}
-						mml.Nop(_i, _r)
-						return (_r.(bool) && _p.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _i)}).Values).(bool))
-					},
-					FixedArgs: 2,
-				}, true, _l)}).Values)
+				return direct_every(a[0], a[1])
 			},
 			FixedArgs: 2,
 		}
 		exports["every"] = _every
+		direct_some = func(_p, _l interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _i = a[0]
+					var _r = a[1]
+					mml.Nop(_i, _r)
+					return (_r.(bool) || _p.(*mml.Function).Call([]interface{}{_i}).(bool))
+				},
+				FixedArgs: 2,
+			}, false, _l})
+		}
 		_some = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _p = a[0]
-				var _l = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_p, _l)
-				return _fold.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _i = a[0]
-						var _r = a[1]
-						var _ interface{}
-						_ = &mml.List{a[2:]}
-						mml.Nop(_i, _r)
-						return (_r.(bool) || _p.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _i)}).Values).(bool))
-					},
-					FixedArgs: 2,
-				}, false, _l)}).Values)
+				return direct_some(a[0], a[1])
 			},
 			FixedArgs: 2,
 		}
 		exports["some"] = _some
-		_intersect = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _l0 = a[0]
-				var _l1 = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_l0, _l1)
-				return _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _i0 = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_i0)
-						return _some.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-							F: func(a []interface{}) interface{} {
-								var c interface{}
-								mml.Nop(c)
-								var _i1 = a[0]
-								var _ interface{}
-								_ = &mml.List{a[1:]}
-								mml.Nop(_i1)
-								return mml.BinaryOp(11, _i0, _i1)
-							},
-							FixedArgs: 1,
-						}, _l1)}).Values)
-					},
-					FixedArgs: 1,
-				}, _l0)}).Values)
-			},
-			FixedArgs: 2,
-		}
-		exports["intersect"] = _intersect
-		_sort = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _less = a[0]
-				var _l = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_less, _l)
-				return func() interface{} {
-					c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _l)}).Values), 0)
-					if c.(bool) {
-						return &mml.List{Values: []interface{}{}}
-					} else {
-						return &mml.List{Values: append(append(append([]interface{}{}, _sort.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _less)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-							F: func(a []interface{}) interface{} {
-								var c interface{}
-								mml.Nop(c)
-								var _i = a[0]
-								var _ interface{}
-								_ = &mml.List{a[1:]}
-								mml.Nop(_i)
-								return !_less.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_l, 0), _i)}).Values).(bool)
-							},
-							FixedArgs: 1,
-						})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.RefRange(_l, 1, nil))}).Values))}).Values).(*mml.List).Values...), mml.Ref(_l, 0)), _sort.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _less)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _less.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_l, 0))}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.RefRange(_l, 1, nil))}).Values))}).Values).(*mml.List).Values...)}
-					}
-				}()
-			},
-			FixedArgs: 2,
+		direct_group = func(_n, _l interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _i = a[0]
+					var _g = a[1]
+					mml.Nop(_i, _g)
+					return func() interface{} {
+						if mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_g}), 0).(bool) || mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{mml.Ref(_g, mml.BinaryOp(10, _len.(*mml.Function).Call([]interface{}{_g}), 1))}), _n).(bool) {
+							return (&mml.List{}).Concat(_g.(*mml.List)).Append((&mml.List{}).Append(_i))
+						} else {
+							return (&mml.List{}).Concat(mml.RefRange(_g, nil, mml.BinaryOp(10, _len.(*mml.Function).Call([]interface{}{_g}), 1)).(*mml.List)).Append((&mml.List{}).Concat(mml.Ref(_g, mml.BinaryOp(10, _len.(*mml.Function).Call([]interface{}{_g}), 1)).(*mml.List)).Append(_i))
+						}
+					}()
+				},
+				FixedArgs: 2,
+			}, (&mml.List{}), _l})
 		}
-		exports["sort"] = _sort
 		_group = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _n = a[0]
-				var _l = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_n, _l)
-				return _fold.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _i = a[0]
-						var _g = a[1]
-						var _ interface{}
-						_ = &mml.List{a[2:]}
-						mml.Nop(_i, _g)
-						return func() interface{} {
-							c = (mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _g)}).Values), 0).(bool) || mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_g, mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _g)}).Values), 1)))}).Values), _n).(bool))
-							if c.(bool) {
-								return &mml.List{Values: append(append([]interface{}{}, _g.(*mml.List).Values...), &mml.List{Values: append([]interface{}{}, _i)})}
-							} else {
-								return &mml.List{Values: append(append([]interface{}{}, mml.RefRange(_g, nil, mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _g)}).Values), 1)).(*mml.List).Values...), &mml.List{Values: append(append([]interface{}{}, mml.Ref(_g, mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _g)}).Values), 1)).(*mml.List).Values...), _i)})}
-							}
-						}()
-					},
-					FixedArgs: 2,
-				}, &mml.List{Values: []interface{}{}}, _l)}).Values)
+				return direct_group(a[0], a[1])
 			},
 			FixedArgs: 2,
 		}
 		exports["group"] = _group
+		direct_indexes = func(_l interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var __ = a[0]
+					var _i = a[1]
+					mml.Nop(__, _i)
+					return (&mml.List{}).Concat(_i.(*mml.List)).Append(_len.(*mml.Function).Call([]interface{}{_i}))
+				},
+				FixedArgs: 2,
+			}, (&mml.List{}), _l})
+		}
 		_indexes = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _l = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_l)
-				return _fold.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var __ = a[0]
-						var _i = a[1]
-						var _ interface{}
-						_ = &mml.List{a[2:]}
-						mml.Nop(__, _i)
-						return &mml.List{Values: append(append([]interface{}{}, _i.(*mml.List).Values...), _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _i)}).Values))}
-					},
-					FixedArgs: 2,
-				}, &mml.List{Values: []interface{}{}}, _l)}).Values)
+				return direct_indexes(a[0])
 			},
 			FixedArgs: 1,
 		}
 		exports["indexes"] = _indexes
-		_flatDepth = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _d = a[0]
-				var _l = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_d, _l)
+		direct_flatDepth = func(_d, _l interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
 
+			mml.Nop()
+			c = mml.BinaryOp(11, _d, 0)
+			if c.(bool) {
 				mml.Nop()
-				c = mml.BinaryOp(11, _d, 0)
-				if c.(bool) {
-					mml.Nop()
-					return _l
-				}
-				return _fold.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _i = a[0]
-						var _r = a[1]
-						var _ interface{}
-						_ = &mml.List{a[2:]}
-						mml.Nop(_i, _r)
-						var _fi interface{}
-						mml.Nop(_fi)
-						c = !_isList.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _i)}).Values).(bool)
-						if c.(bool) {
-							mml.Nop()
-							return &mml.List{Values: append(append([]interface{}{}, _r.(*mml.List).Values...), _i)}
-						}
-						_fi = _flatDepth.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.BinaryOp(10, _d, 1), _i)}).Values)
-						return &mml.List{Values: append(append([]interface{}{}, _r.(*mml.List).Values...), _fi.(*mml.List).Values...)}
-						return nil
-					},
-					FixedArgs: 2,
-				}, &mml.List{Values: []interface{}{}}, _l)}).Values)
-				return nil
+				return _l
+			}
+			return _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _i = a[0]
+					var _r = a[1]
+					mml.Nop(_i, _r)
+					var _fi interface{}
+					mml.Nop(_fi)
+					if !_isList.(*mml.Function).Call([]interface{}{_i}).(bool) {
+						mml.Nop()
+						return (&mml.List{}).Concat(_r.(*mml.List)).Append(_i)
+					}
+					_fi = direct_flatDepth(mml.BinaryOp(10, _d, 1), _i)
+					return (&mml.List{}).Concat(_r.(*mml.List)).Concat(_fi.(*mml.List))
+				},
+				FixedArgs: 2,
+			}, (&mml.List{}), _l})
+		}
+		_flatDepth = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_flatDepth(a[0], a[1])
 			},
 			FixedArgs: 2,
 		}
@@ -780,244 +860,78 @@ func init() {
 
 	modulePath = "strings"
 
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
 		exports := make(map[string]interface{})
 
 		var c interface{}
 		mml.Nop(c)
 
-		var _firstOr interface{}
 		var _join interface{}
+		var _escape interface{}
+		var _unescape interface{}
+		var _split interface{}
+		var _hash interface{}
 		var _joins interface{}
-		var _joinTwo interface{}
 		var _formats interface{}
 		var _formatOne interface{}
-		var _escape interface{}
-		var _unescape interface{}
-		mml.Nop(_firstOr, _join, _joins, _joinTwo, _formats, _formatOne, _escape, _unescape)
-		_firstOr = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _v = a[0]
-				var _l = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_v, _l)
-				return func() interface{} {
-					c = mml.BinaryOp(15, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _l)}).Values), 0)
-					if c.(bool) {
-						return mml.Ref(_l, 0)
-					} else {
-						return _v
-					}
-				}()
-			},
-			FixedArgs: 2,
-		}
-		_join = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _j = a[0]
-				var _s = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_j, _s)
-				return func() interface{} {
-					c = mml.BinaryOp(13, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _s)}).Values), 2)
-					if c.(bool) {
-						return _firstOr.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "", _s)}).Values)
-					} else {
-						return mml.BinaryOp(9, mml.BinaryOp(9, mml.Ref(_s, 0), _j), _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _j, mml.RefRange(_s, 1, nil))}).Values))
-					}
-				}()
-			},
-			FixedArgs: 2,
-		}
+		var direct_formatOne func(interface{}, interface{}) interface{}
+		mml.Nop(direct_formatOne)
+		mml.Nop(_join, _escape, _unescape, _split, _hash, _joins, _formats, _formatOne)
+		_join = _stringJoin
 		exports["join"] = _join
+		_escape = _stringEscape
+		exports["escape"] = _escape
+		_unescape = _stringUnescape
+		exports["unescape"] = _unescape
+		_split = _stringSplit
+		exports["split"] = _split
+		_hash = _stringHash
+		exports["hash"] = _hash
 		_joins = &mml.Function{
 			F: func(a []interface{}) interface{} {
 				var c interface{}
 				mml.Nop(c)
 				var _j = a[0]
 				var _s interface{}
-				_s = &mml.List{a[1:]}
+				_s = mml.NewList(a[1:])
 				mml.Nop(_j, _s)
-				return _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _j, _s)}).Values)
+				return _join.(*mml.Function).Call([]interface{}{_j, _s})
 			},
 			FixedArgs: 1,
 		}
 		exports["joins"] = _joins
-		_joinTwo = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _j = a[0]
-				var _left = a[1]
-				var _right = a[2]
-				var _ interface{}
-				_ = &mml.List{a[3:]}
-				mml.Nop(_j, _left, _right)
-				return _joins.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _j, _left, _right)}).Values)
-			},
-			FixedArgs: 3,
-		}
-		exports["joinTwo"] = _joinTwo
 		_formats = &mml.Function{
 			F: func(a []interface{}) interface{} {
 				var c interface{}
 				mml.Nop(c)
 				var _f = a[0]
 				var _a interface{}
-				_a = &mml.List{a[1:]}
+				_a = mml.NewList(a[1:])
 				mml.Nop(_f, _a)
-				return _format.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _f, _a)}).Values)
+				return _format.(*mml.Function).Call([]interface{}{_f, _a})
 			},
 			FixedArgs: 1,
 		}
 		exports["formats"] = _formats
+		direct_formatOne = func(_f, _a interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _formats.(*mml.Function).Call([]interface{}{_f, _a})
+		}
 		_formatOne = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _f = a[0]
-				var _a = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_f, _a)
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _f, _a)}).Values)
+				return direct_formatOne(a[0], a[1])
 			},
 			FixedArgs: 2,
 		}
 		exports["formatOne"] = _formatOne
-		_escape = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_s)
-				var _first interface{}
-				mml.Nop(_first)
-				c = mml.BinaryOp(11, _s, "")
-				if c.(bool) {
-					mml.Nop()
-					return ""
-				}
-				_first = mml.Ref(_s, 0)
-				switch _first {
-				case "\b":
-
-					mml.Nop()
-					_first = "\\b"
-				case "\f":
-
-					mml.Nop()
-					_first = "\\f"
-				case "\n":
-
-					mml.Nop()
-					_first = "\\n"
-				case "\r":
-
-					mml.Nop()
-					_first = "\\r"
-				case "\t":
-
-					mml.Nop()
-					_first = "\\t"
-				case "\v":
-
-					mml.Nop()
-					_first = "\\v"
-				case "\"":
-
-					mml.Nop()
-					_first = "\\\""
-				case "\\":
-
-					mml.Nop()
-					_first = "\\\\"
-				}
-				return mml.BinaryOp(9, _first, _escape.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.RefRange(_s, 1, nil))}).Values))
-				return nil
-			},
-			FixedArgs: 1,
-		}
-		exports["escape"] = _escape
-		_unescape = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_s)
-				var _esc interface{}
-				var _r interface{}
-				mml.Nop(_esc, _r)
-				_esc = false
-				_r = &mml.List{Values: []interface{}{}}
-				for _i := 0; _i < _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _s)}).Values).(int); _i++ {
-					var _c interface{}
-					mml.Nop(_c)
-					_c = mml.Ref(_s, _i)
-					c = _esc
-					if c.(bool) {
-						mml.Nop()
-						switch _c {
-						case "b":
-
-							mml.Nop()
-							_c = "\b"
-						case "f":
-
-							mml.Nop()
-							_c = "\f"
-						case "n":
-
-							mml.Nop()
-							_c = "\n"
-						case "r":
-
-							mml.Nop()
-							_c = "\r"
-						case "t":
-
-							mml.Nop()
-							_c = "\t"
-						case "v":
-
-							mml.Nop()
-							_c = "\v"
-						}
-						_r = &mml.List{Values: append(append([]interface{}{}, _r.(*mml.List).Values...), _c)}
-						_esc = false
-						continue
-					}
-					c = mml.BinaryOp(11, _c, "\\")
-					if c.(bool) {
-						mml.Nop()
-						_esc = true
-						continue
-					}
-					_r = &mml.List{Values: append(append([]interface{}{}, _r.(*mml.List).Values...), _c)}
-				}
-				return _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "", _r)}).Values)
-				return nil
-			},
-			FixedArgs: 1,
-		}
-		exports["unescape"] = _unescape
 
 		return exports
 	})
 
 	modulePath = "ints"
 
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
 		exports := make(map[string]interface{})
 
 		var c interface{}
@@ -1025,35 +939,33 @@ func init() {
 
 		var _counter interface{}
 		var _enum interface{}
-		var _max interface{}
-		var _min interface{}
-		mml.Nop(_counter, _enum, _max, _min)
+		var _max int
+		var direct_counter func() interface{}
+		mml.Nop(direct_counter)
+		mml.Nop(_counter, _enum, _max)
+		direct_counter = func() interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _c int
+			mml.Nop(_c)
+			_c = -(1)
+			return &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+
+					mml.Nop()
+
+					mml.Nop()
+					_c = (_c + 1)
+					return _c
+				},
+				FixedArgs: 0,
+			}
+		}
 		_counter = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ interface{}
-				_ = &mml.List{a[0:]}
-				mml.Nop()
-				var _c interface{}
-				mml.Nop(_c)
-				_c = mml.UnaryOp(2, 1)
-				return &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _ interface{}
-						_ = &mml.List{a[0:]}
-						mml.Nop()
-
-						mml.Nop()
-						_c = mml.BinaryOp(9, _c, 1)
-						return _c
-						return nil
-					},
-					FixedArgs: 0,
-				}
-				return nil
+				return direct_counter()
 			},
 			FixedArgs: 0,
 		}
@@ -1062,151 +974,91 @@ func init() {
 		exports["enum"] = _enum
 		_max = 9000
 		exports["max"] = _max
-		_min = mml.UnaryOp(2, 9000)
-		exports["min"] = _min
 
 		return exports
 	})
 
-	modulePath = "log"
+	modulePath = "functions"
 
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
 		exports := make(map[string]interface{})
 
 		var c interface{}
 		mml.Nop(c)
 
-		var _println interface{}
-		var _fatal interface{}
+		var _identity interface{}
+		var _eq interface{}
+		var _not interface{}
+		var _apply interface{}
+		var _call interface{}
+		var _chain interface{}
+		var _bindAt interface{}
+		var _bind interface{}
+		var _only interface{}
 		var _lists interface{}
-		var _strings interface{}
-		mml.Nop(_println, _fatal, _lists, _strings)
-		_lists = mml.Modules.Use("lists")
-		_strings = mml.Modules.Use("strings")
-		_println = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _a interface{}
-				_a = &mml.List{a[0:]}
-				mml.Nop(_a)
-
-				mml.Nop()
-				_stderr.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_strings, "join").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, " ")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_lists, "map").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _string)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _a)}).Values))}).Values))}).Values)
-				_stderr.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "\n")}).Values)
-				return func() interface{} {
-					c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _a)}).Values), 0)
-					if c.(bool) {
-						return ""
-					} else {
-						return mml.Ref(_a, mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _a)}).Values), 1))
-					}
-				}()
-				return nil
-			},
-			FixedArgs: 0,
-		}
-		exports["println"] = _println
-		_fatal = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _a interface{}
-				_a = &mml.List{a[0:]}
-				mml.Nop(_a)
-
-				mml.Nop()
-				_println.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _a.(*mml.List).Values...)}).Values)
-				_exit.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, 1)}).Values)
-				return nil
-			},
-			FixedArgs: 0,
-		}
-		exports["fatal"] = _fatal
-
-		return exports
-	})
-
-	modulePath = "functions"
-
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
-		exports := make(map[string]interface{})
-
-		var c interface{}
-		mml.Nop(c)
-
-		var _identity interface{}
-		var _eq interface{}
-		var _not interface{}
-		var _apply interface{}
-		var _call interface{}
-		var _chain interface{}
-		var _chains interface{}
-		var _bindAt interface{}
-		var _bind interface{}
-		var _only interface{}
-		var _lists interface{}
-		mml.Nop(_identity, _eq, _not, _apply, _call, _chain, _chains, _bindAt, _bind, _only, _lists)
-		_lists = mml.Modules.Use("lists")
-		_identity = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _x = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_x)
-				return _x
-			},
-			FixedArgs: 1,
-		}
-		exports["identity"] = _identity
-		_eq = &mml.Function{
+		var direct_identity func(interface{}) interface{}
+		mml.Nop(direct_identity)
+		var direct_not func(interface{}) interface{}
+		mml.Nop(direct_not)
+		var direct_apply func(interface{}, interface{}) interface{}
+		mml.Nop(direct_apply)
+		var direct_chain func(interface{}) interface{}
+		mml.Nop(direct_chain)
+		mml.Nop(_identity, _eq, _not, _apply, _call, _chain, _bindAt, _bind, _only, _lists)
+		_lists = use("lists")
+		direct_identity = func(_x interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _x
+		}
+		_identity = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_identity(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["identity"] = _identity
+		_eq = &mml.Function{
 			F: func(a []interface{}) interface{} {
 				var c interface{}
 				mml.Nop(c)
 				var _x interface{}
-				_x = &mml.List{a[0:]}
+				_x = mml.NewList(a[0:])
 				mml.Nop(_x)
-				return (mml.BinaryOp(13, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _x)}).Values), 2).(bool) || (mml.BinaryOp(11, mml.Ref(_x, 0), mml.Ref(_x, 1)).(bool) && _eq.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.RefRange(_x, 1, nil).(*mml.List).Values...)}).Values).(bool)))
+				return (mml.BinaryOp(13, _len.(*mml.Function).Call([]interface{}{_x}), 2).(bool) || (mml.BinaryOp(11, mml.Ref(_x, 0), mml.Ref(_x, 1)).(bool) && _eq.(*mml.Function).Call(append([]interface{}{}, mml.RefRange(_x, 1, nil).(*mml.List).Values()...)).(bool)))
 			},
 			FixedArgs: 0,
 		}
 		exports["eq"] = _eq
+		direct_not = func(_p interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _a = a[0]
+					mml.Nop(_a)
+					return !_p.(*mml.Function).Call([]interface{}{_a}).(bool)
+				},
+				FixedArgs: 1,
+			}
+		}
 		_not = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _p = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_p)
-				return &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _a = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_a)
-						return !_p.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _a)}).Values).(bool)
-					},
-					FixedArgs: 1,
-				}
+				return direct_not(a[0])
 			},
 			FixedArgs: 1,
 		}
 		exports["not"] = _not
+		direct_apply = func(_f, _a interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _f.(*mml.Function).Call(append([]interface{}{}, _a.(*mml.List).Values()...))
+		}
 		_apply = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _f = a[0]
-				var _a = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_f, _a)
-				return _f.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _a.(*mml.List).Values...)}).Values)
+				return direct_apply(a[0], a[1])
 			},
 			FixedArgs: 2,
 		}
@@ -1217,49 +1069,34 @@ func init() {
 				mml.Nop(c)
 				var _f = a[0]
 				var _a interface{}
-				_a = &mml.List{a[1:]}
+				_a = mml.NewList(a[1:])
 				mml.Nop(_f, _a)
-				return _apply.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _f, _a)}).Values)
+				return direct_apply(_f, _a)
 			},
 			FixedArgs: 1,
 		}
 		exports["call"] = _call
+		direct_chain = func(_f interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _a = a[0]
+					mml.Nop(_a)
+					return mml.Ref(_lists, "fold").(*mml.Function).Call([]interface{}{_call, _a, _f})
+				},
+				FixedArgs: 1,
+			}
+		}
 		_chain = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _f = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_f)
-				return &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _a = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_a)
-						return mml.Ref(_lists, "fold").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _call, _a, _f)}).Values)
-					},
-					FixedArgs: 1,
-				}
+				return direct_chain(a[0])
 			},
 			FixedArgs: 1,
 		}
 		exports["chain"] = _chain
-		_chains = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _f interface{}
-				_f = &mml.List{a[0:]}
-				mml.Nop(_f)
-				return _chain.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _f)}).Values)
-			},
-			FixedArgs: 0,
-		}
-		exports["chains"] = _chains
 		_bindAt = &mml.Function{
 			F: func(a []interface{}) interface{} {
 				var c interface{}
@@ -1267,16 +1104,16 @@ func init() {
 				var _i = a[0]
 				var _f = a[1]
 				var _a interface{}
-				_a = &mml.List{a[2:]}
+				_a = mml.NewList(a[2:])
 				mml.Nop(_i, _f, _a)
 				return &mml.Function{
 					F: func(a []interface{}) interface{} {
 						var c interface{}
 						mml.Nop(c)
 						var _b interface{}
-						_b = &mml.List{a[0:]}
+						_b = mml.NewList(a[0:])
 						mml.Nop(_b)
-						return _f.(*mml.Function).Call((&mml.List{Values: append(append(append([]interface{}{}, mml.RefRange(_b, nil, _i).(*mml.List).Values...), _a.(*mml.List).Values...), mml.RefRange(_b, _i, nil).(*mml.List).Values...)}).Values)
+						return _f.(*mml.Function).Call(append(append(append([]interface{}{}, mml.RefRange(_b, nil, _i).(*mml.List).Values()...), _a.(*mml.List).Values()...), mml.RefRange(_b, _i, nil).(*mml.List).Values()...))
 					},
 					FixedArgs: 0,
 				}
@@ -1290,9 +1127,9 @@ func init() {
 				mml.Nop(c)
 				var _f = a[0]
 				var _a interface{}
-				_a = &mml.List{a[1:]}
+				_a = mml.NewList(a[1:])
 				mml.Nop(_f, _a)
-				return _bindAt.(*mml.Function).Call((&mml.List{Values: append(append([]interface{}{}, 0, _f), _a.(*mml.List).Values...)}).Values)
+				return _bindAt.(*mml.Function).Call(append([]interface{}{0, _f}, _a.(*mml.List).Values()...))
 			},
 			FixedArgs: 1,
 		}
@@ -1303,28 +1140,24 @@ func init() {
 				mml.Nop(c)
 				var _p = a[0]
 				var _f interface{}
-				_f = &mml.List{a[1:]}
+				_f = mml.NewList(a[1:])
 				mml.Nop(_p, _f)
-				return _chain.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_lists, "map").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
+				return direct_chain(mml.Ref(_lists, "map").(*mml.Function).Call([]interface{}{&mml.Function{
 					F: func(a []interface{}) interface{} {
 						var c interface{}
 						mml.Nop(c)
 						var _f = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
 						mml.Nop(_f)
 						return &mml.Function{
 							F: func(a []interface{}) interface{} {
 								var c interface{}
 								mml.Nop(c)
 								var _a = a[0]
-								var _ interface{}
-								_ = &mml.List{a[1:]}
 								mml.Nop(_a)
 								return func() interface{} {
-									c = _p.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _a)}).Values)
+									c = _p.(*mml.Function).Call([]interface{}{_a})
 									if c.(bool) {
-										return _f.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _a)}).Values)
+										return _f.(*mml.Function).Call([]interface{}{_a})
 									} else {
 										return _a
 									}
@@ -1334,7 +1167,7 @@ func init() {
 						}
 					},
 					FixedArgs: 1,
-				})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _f)}).Values))}).Values)
+				}}).(*mml.Function).Call([]interface{}{_f}))
 			},
 			FixedArgs: 1,
 		}
@@ -1345,7 +1178,7 @@ func init() {
 
 	modulePath = "match"
 
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
 		exports := make(map[string]interface{})
 
 		var c interface{}
@@ -1394,7 +1227,6 @@ func init() {
 		var _or interface{}
 		var _and interface{}
 		var _predicate interface{}
-		var _predicates interface{}
 		var _matchInt interface{}
 		var _matchFloat interface{}
 		var _matchString interface{}
@@ -1412,397 +1244,420 @@ func init() {
 		var _foldr interface{}
 		var _map interface{}
 		var _filter interface{}
+		var _sort interface{}
 		var _first interface{}
 		var _contains interface{}
-		var _concat interface{}
-		var _concats interface{}
 		var _flat interface{}
 		var _flats interface{}
 		var _uniq interface{}
 		var _every interface{}
 		var _some interface{}
-		var _intersect interface{}
-		var _sort interface{}
 		var _group interface{}
 		var _indexes interface{}
 		var _flatDepth interface{}
-		mml.Nop(_complexType, _defineRange, _listRange, _isSimpleType, _isComplexType, _isType, _complexTypeEq, _primitives, _matchPrimitive, _matchToList, _matchToListType, _matchList, _matchStruct, _matchOne, _token, _none, _integer, _floating, _stringType, _boolean, _errorType, _any, _function, _channel, _type, _intRangeType, _floatRangeType, _isRange, _isNaturalRange, _intRange, _floatRange, _stringRangeType, _stringRange, _listType, _listOf, _structOf, _range, _unionType, _intersectType, _predicateType, _or, _and, _predicate, _predicates, _matchInt, _matchFloat, _matchString, _matchUnion, _matchIntersection, _rangeMin, _listLength, _not, _natural, _is, _functions, _ints, _floats, _fold, _foldr, _map, _filter, _first, _contains, _concat, _concats, _flat, _flats, _uniq, _every, _some, _intersect, _sort, _group, _indexes, _flatDepth)
-		var __lists = mml.Modules.Use("lists")
-		_fold = __lists.Values["fold"]
-		_foldr = __lists.Values["foldr"]
-		_map = __lists.Values["map"]
-		_filter = __lists.Values["filter"]
-		_first = __lists.Values["first"]
-		_contains = __lists.Values["contains"]
-		_concat = __lists.Values["concat"]
-		_concats = __lists.Values["concats"]
-		_flat = __lists.Values["flat"]
-		_flats = __lists.Values["flats"]
-		_uniq = __lists.Values["uniq"]
-		_every = __lists.Values["every"]
-		_some = __lists.Values["some"]
-		_intersect = __lists.Values["intersect"]
-		_sort = __lists.Values["sort"]
-		_group = __lists.Values["group"]
-		_indexes = __lists.Values["indexes"]
-		_flatDepth = __lists.Values["flatDepth"]
-		_functions = mml.Modules.Use("functions")
-		_ints = mml.Modules.Use("ints")
-		_floats = mml.Modules.Use("floats")
+		var direct_complexType func(interface{}) interface{}
+		mml.Nop(direct_complexType)
+		var direct_defineRange func(interface{}, interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_defineRange)
+		var direct_listRange func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_listRange)
+		var direct_isSimpleType func(interface{}) interface{}
+		mml.Nop(direct_isSimpleType)
+		var direct_isComplexType func(interface{}) interface{}
+		mml.Nop(direct_isComplexType)
+		var direct_isType func(interface{}) interface{}
+		mml.Nop(direct_isType)
+		var direct_complexTypeEq func(interface{}, interface{}) interface{}
+		mml.Nop(direct_complexTypeEq)
+		var direct_matchPrimitive func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_matchPrimitive)
+		var direct_matchToList func(interface{}, interface{}) interface{}
+		mml.Nop(direct_matchToList)
+		var direct_matchToListType func(interface{}, interface{}) interface{}
+		mml.Nop(direct_matchToListType)
+		var direct_matchList func(interface{}, interface{}) interface{}
+		mml.Nop(direct_matchList)
+		var direct_matchStruct func(interface{}, interface{}) interface{}
+		mml.Nop(direct_matchStruct)
+		var direct_matchOne func(interface{}, interface{}) interface{}
+		mml.Nop(direct_matchOne)
+		var direct_token func() interface{}
+		mml.Nop(direct_token)
+		var direct_none func() interface{}
+		mml.Nop(direct_none)
+		var direct_integer func() interface{}
+		mml.Nop(direct_integer)
+		var direct_floating func() interface{}
+		mml.Nop(direct_floating)
+		var direct_stringType func() interface{}
+		mml.Nop(direct_stringType)
+		var direct_boolean func() interface{}
+		mml.Nop(direct_boolean)
+		var direct_errorType func() interface{}
+		mml.Nop(direct_errorType)
+		var direct_any func() interface{}
+		mml.Nop(direct_any)
+		var direct_function func() interface{}
+		mml.Nop(direct_function)
+		var direct_channel func() interface{}
+		mml.Nop(direct_channel)
+		var direct_type func(interface{}) interface{}
+		mml.Nop(direct_type)
+		var direct_isRange func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_isRange)
+		var direct_isNaturalRange func(interface{}, interface{}) interface{}
+		mml.Nop(direct_isNaturalRange)
+		var direct_listOf func(interface{}) interface{}
+		mml.Nop(direct_listOf)
+		var direct_structOf func(interface{}) interface{}
+		mml.Nop(direct_structOf)
+		var direct_range func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_range)
+		var direct_predicate func(interface{}) interface{}
+		mml.Nop(direct_predicate)
+		var direct_matchUnion func(interface{}, interface{}) interface{}
+		mml.Nop(direct_matchUnion)
+		var direct_matchIntersection func(interface{}, interface{}) interface{}
+		mml.Nop(direct_matchIntersection)
+		var direct_rangeMin func(interface{}, interface{}) interface{}
+		mml.Nop(direct_rangeMin)
+		var direct_listLength func(interface{}) interface{}
+		mml.Nop(direct_listLength)
+		var direct_not func(interface{}) interface{}
+		mml.Nop(direct_not)
+		mml.Nop(_complexType, _defineRange, _listRange, _isSimpleType, _isComplexType, _isType, _complexTypeEq, _primitives, _matchPrimitive, _matchToList, _matchToListType, _matchList, _matchStruct, _matchOne, _token, _none, _integer, _floating, _stringType, _boolean, _errorType, _any, _function, _channel, _type, _intRangeType, _floatRangeType, _isRange, _isNaturalRange, _intRange, _floatRange, _stringRangeType, _stringRange, _listType, _listOf, _structOf, _range, _unionType, _intersectType, _predicateType, _or, _and, _predicate, _matchInt, _matchFloat, _matchString, _matchUnion, _matchIntersection, _rangeMin, _listLength, _not, _natural, _is, _functions, _ints, _floats, _fold, _foldr, _map, _filter, _sort, _first, _contains, _flat, _flats, _uniq, _every, _some, _group, _indexes, _flatDepth)
+		var __lists = use("lists")
+		_fold = __lists.Get("fold")
+		_foldr = __lists.Get("foldr")
+		_map = __lists.Get("map")
+		_filter = __lists.Get("filter")
+		_sort = __lists.Get("sort")
+		_first = __lists.Get("first")
+		_contains = __lists.Get("contains")
+		_flat = __lists.Get("flat")
+		_flats = __lists.Get("flats")
+		_uniq = __lists.Get("uniq")
+		_every = __lists.Get("every")
+		_some = __lists.Get("some")
+		_group = __lists.Get("group")
+		_indexes = __lists.Get("indexes")
+		_flatDepth = __lists.Get("flatDepth")
+		_functions = use("functions")
+		_ints = use("ints")
+		_floats = use("floats")
+		direct_token = func() interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _token
+		}
 		_token = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ interface{}
-				_ = &mml.List{a[0:]}
-				mml.Nop()
-				return _token
+				return direct_token()
 			},
 			FixedArgs: 0,
 		}
+		direct_none = func() interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _none
+		}
 		_none = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ interface{}
-				_ = &mml.List{a[0:]}
-				mml.Nop()
-				return _none
+				return direct_none()
 			},
 			FixedArgs: 0,
 		}
+		direct_integer = func() interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _integer
+		}
 		_integer = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ interface{}
-				_ = &mml.List{a[0:]}
-				mml.Nop()
-				return _integer
+				return direct_integer()
 			},
 			FixedArgs: 0,
 		}
+		direct_floating = func() interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _floating
+		}
 		_floating = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ interface{}
-				_ = &mml.List{a[0:]}
-				mml.Nop()
-				return _floating
+				return direct_floating()
 			},
 			FixedArgs: 0,
 		}
+		direct_stringType = func() interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _stringType
+		}
 		_stringType = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ interface{}
-				_ = &mml.List{a[0:]}
-				mml.Nop()
-				return _stringType
+				return direct_stringType()
 			},
 			FixedArgs: 0,
 		}
+		direct_boolean = func() interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _boolean
+		}
 		_boolean = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ interface{}
-				_ = &mml.List{a[0:]}
-				mml.Nop()
-				return _boolean
+				return direct_boolean()
 			},
 			FixedArgs: 0,
 		}
+		direct_errorType = func() interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _errorType
+		}
 		_errorType = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ interface{}
-				_ = &mml.List{a[0:]}
-				mml.Nop()
-				return _errorType
+				return direct_errorType()
 			},
 			FixedArgs: 0,
 		}
+		direct_any = func() interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _any
+		}
 		_any = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ interface{}
-				_ = &mml.List{a[0:]}
-				mml.Nop()
-				return _any
+				return direct_any()
 			},
 			FixedArgs: 0,
 		}
 		exports["any"] = _any
+		direct_function = func() interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _function
+		}
 		_function = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ interface{}
-				_ = &mml.List{a[0:]}
-				mml.Nop()
-				return _function
+				return direct_function()
 			},
 			FixedArgs: 0,
 		}
 		exports["function"] = _function
+		direct_channel = func() interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _channel
+		}
 		_channel = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ interface{}
-				_ = &mml.List{a[0:]}
-				mml.Nop()
-				return _channel
+				return direct_channel()
 			},
 			FixedArgs: 0,
 		}
 		exports["channel"] = _channel
-		_type = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _t = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_t)
+		direct_type = func(_t interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
 
-				mml.Nop()
-				switch _t {
-				case _int:
+			mml.Nop()
+			switch _t {
+			case _int:
 
-					mml.Nop()
-					return _integer
-				case _float:
+				mml.Nop()
+				return _integer
+			case _float:
 
-					mml.Nop()
-					return _floating
-				case _string:
+				mml.Nop()
+				return _floating
+			case _string:
 
-					mml.Nop()
-					return _stringType
-				case _bool:
+				mml.Nop()
+				return _stringType
+			case _bool:
 
-					mml.Nop()
-					return _boolean
-				case _error:
+				mml.Nop()
+				return _boolean
+			case _error:
 
-					mml.Nop()
-					return _errorType
-				default:
+				mml.Nop()
+				return _errorType
+			default:
 
-					mml.Nop()
-					return _t
-				}
-				return nil
+				mml.Nop()
+				return _t
+			}
+		}
+		_type = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_type(a[0])
 			},
 			FixedArgs: 1,
 		}
 		exports["type"] = _type
+		direct_complexType = func(_name interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} { s := &mml.Struct{}; s.Set("token", _token); s.Set("type", _name); ; return s }()
+		}
 		_complexType = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _name = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_name)
-				return func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["token"] = _token
-					s.Values["type"] = _name
-					return s
-				}()
+				return direct_complexType(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_intRangeType = _complexType.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "int-range")}).Values)
-		_floatRangeType = _complexType.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "float-range")}).Values)
+		_intRangeType = direct_complexType("int-range")
+		_floatRangeType = direct_complexType("float-range")
+		direct_isRange = func(_ofType, _min, _max interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return ((_ofType.(*mml.Function).Call([]interface{}{_min}).(bool) && _ofType.(*mml.Function).Call([]interface{}{_max}).(bool)) && mml.BinaryOp(14, _min, _max).(bool))
+		}
 		_isRange = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ofType = a[0]
-				var _min = a[1]
-				var _max = a[2]
-				var _ interface{}
-				_ = &mml.List{a[3:]}
-				mml.Nop(_ofType, _min, _max)
-				return ((_ofType.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _min)}).Values).(bool) && _ofType.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _max)}).Values).(bool)) && mml.BinaryOp(14, _min, _max).(bool))
+				return direct_isRange(a[0], a[1], a[2])
 			},
 			FixedArgs: 3,
 		}
+		direct_isNaturalRange = func(_min, _max interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return (direct_isRange(_isInt, _min, _max).(bool) && mml.BinaryOp(16, _min, 0).(bool))
+		}
 		_isNaturalRange = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _min = a[0]
-				var _max = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_min, _max)
-				return (_isRange.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _isInt, _min, _max)}).Values).(bool) && mml.BinaryOp(16, _min, 0).(bool))
+				return direct_isNaturalRange(a[0], a[1])
 			},
 			FixedArgs: 2,
 		}
+		direct_defineRange = func(_ofType, _validate, _min, _max interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				c = _validate.(*mml.Function).Call([]interface{}{_min, _max})
+				if c.(bool) {
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_ofType.(*mml.Struct))
+						s.Set("min", _min)
+						s.Set("max", _max)
+						return s
+					}()
+				} else {
+					return _none
+				}
+			}()
+		}
 		_defineRange = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ofType = a[0]
-				var _validate = a[1]
-				var _min = a[2]
-				var _max = a[3]
-				var _ interface{}
-				_ = &mml.List{a[4:]}
-				mml.Nop(_ofType, _validate, _min, _max)
-				return func() interface{} {
-					c = _validate.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _min, _max)}).Values)
-					if c.(bool) {
-						return func() interface{} {
-							s := &mml.Struct{Values: make(map[string]interface{})}
-							func() {
-								sp := _ofType.(*mml.Struct)
-								for k, v := range sp.Values {
-									s.Values[k] = v
-								}
-							}()
-							s.Values["min"] = _min
-							s.Values["max"] = _max
-							return s
-						}()
-					} else {
-						return _none
-					}
-				}()
+				return direct_defineRange(a[0], a[1], a[2], a[3])
 			},
 			FixedArgs: 4,
 		}
-		_intRange = _defineRange.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _intRangeType, _isRange.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _isInt)}).Values))}).Values)
-		_floatRange = _defineRange.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _floatRangeType, _isRange.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _isFloat)}).Values))}).Values)
-		_stringRangeType = _complexType.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "string")}).Values)
-		_stringRange = _defineRange.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _stringRangeType, _isNaturalRange)}).Values)
-		_listType = _complexType.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "list")}).Values)
+		_intRange = _defineRange.(*mml.Function).Call([]interface{}{_intRangeType, _isRange.(*mml.Function).Call([]interface{}{_isInt})})
+		_floatRange = _defineRange.(*mml.Function).Call([]interface{}{_floatRangeType, _isRange.(*mml.Function).Call([]interface{}{_isFloat})})
+		_stringRangeType = direct_complexType("string")
+		_stringRange = _defineRange.(*mml.Function).Call([]interface{}{_stringRangeType, _isNaturalRange})
+		_listType = direct_complexType("list")
+		direct_listRange = func(_item, _min, _max interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Merge(direct_defineRange(_listType, _isNaturalRange, _min, _max).(*mml.Struct))
+				s.Set("item", _item)
+				return s
+			}()
+		}
 		_listRange = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _item = a[0]
-				var _min = a[1]
-				var _max = a[2]
-				var _ interface{}
-				_ = &mml.List{a[3:]}
-				mml.Nop(_item, _min, _max)
-				return func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					func() {
-						sp := _defineRange.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _listType, _isNaturalRange, _min, _max)}).Values).(*mml.Struct)
-						for k, v := range sp.Values {
-							s.Values[k] = v
-						}
-					}()
-					s.Values["item"] = _item
-					return s
-				}()
+				return direct_listRange(a[0], a[1], a[2])
 			},
 			FixedArgs: 3,
 		}
+		direct_listOf = func(_item interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return direct_listRange(direct_type(_item), 0, mml.Ref(_ints, "max"))
+		}
 		_listOf = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _item = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_item)
-				return _listRange.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _type.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _item)}).Values), 0, mml.Ref(_ints, "max"))}).Values)
+				return direct_listOf(a[0])
 			},
 			FixedArgs: 1,
 		}
 		exports["listOf"] = _listOf
+		direct_structOf = func(_s interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				c = _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; ; return s }(), _s})
+				if c.(bool) {
+					return _s
+				} else {
+					return _none
+				}
+			}()
+		}
 		_structOf = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_s)
-				return func() interface{} {
-					c = _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; ; return s }(), _s)}).Values)
-					if c.(bool) {
-						return _s
-					} else {
-						return _none
-					}
-				}()
+				return direct_structOf(a[0])
 			},
 			FixedArgs: 1,
 		}
 		exports["structOf"] = _structOf
-		_range = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _match = a[0]
-				var _min = a[1]
-				var _max = a[2]
-				var _ interface{}
-				_ = &mml.List{a[3:]}
-				mml.Nop(_match, _min, _max)
-				var _m interface{}
-				mml.Nop(_m)
-				_m = _type.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _match)}).Values)
-				switch {
-				case mml.BinaryOp(11, _m, _integer):
+		direct_range = func(_match, _min, _max interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _m interface{}
+			mml.Nop(_m)
+			_m = direct_type(_match)
+			switch {
+			case mml.BinaryOp(11, _m, _integer):
 
-					mml.Nop()
-					return _intRange.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _min, _max)}).Values)
-				case mml.BinaryOp(11, _m, _floating):
+				mml.Nop()
+				return _intRange.(*mml.Function).Call([]interface{}{_min, _max})
+			case mml.BinaryOp(11, _m, _floating):
 
-					mml.Nop()
-					return _floatRange.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _min, _max)}).Values)
-				case mml.BinaryOp(11, _m, _stringType):
+				mml.Nop()
+				return _floatRange.(*mml.Function).Call([]interface{}{_min, _max})
+			case mml.BinaryOp(11, _m, _stringType):
 
-					mml.Nop()
-					return _stringRange.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _min, _max)}).Values)
-				case _complexTypeEq.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _listType, _m)}).Values):
+				mml.Nop()
+				return _stringRange.(*mml.Function).Call([]interface{}{_min, _max})
+			case direct_complexTypeEq(_listType, _m):
 
-					mml.Nop()
-					return _listRange.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_m, "item"), _min, _max)}).Values)
-				default:
+				mml.Nop()
+				return direct_listRange(mml.Ref(_m, "item"), _min, _max)
+			default:
 
-					mml.Nop()
-					return _none
-				}
-				return nil
-			},
+				mml.Nop()
+				return _none
+			}
+		}
+		_range = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_range(a[0], a[1], a[2])
+			},
 			FixedArgs: 3,
 		}
 		exports["range"] = _range
-		_unionType = _complexType.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "union")}).Values)
-		_intersectType = _complexType.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "intersection")}).Values)
-		_predicateType = _complexType.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "predicate")}).Values)
+		_unionType = direct_complexType("union")
+		_intersectType = direct_complexType("intersection")
+		_predicateType = direct_complexType("predicate")
 		_or = &mml.Function{
 			F: func(a []interface{}) interface{} {
 				var c interface{}
 				mml.Nop(c)
 				var _matches interface{}
-				_matches = &mml.List{a[0:]}
+				_matches = mml.NewList(a[0:])
 				mml.Nop(_matches)
 				return func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					func() {
-						sp := _unionType.(*mml.Struct)
-						for k, v := range sp.Values {
-							s.Values[k] = v
-						}
-					}()
-					s.Values["matches"] = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _type, _matches)}).Values)
+					s := &mml.Struct{}
+					s.Merge(_unionType.(*mml.Struct))
+					s.Set("matches", _map.(*mml.Function).Call([]interface{}{_type, _matches}))
 					return s
 				}()
 			},
@@ -1814,465 +1669,403 @@ func init() {
 				var c interface{}
 				mml.Nop(c)
 				var _matches interface{}
-				_matches = &mml.List{a[0:]}
+				_matches = mml.NewList(a[0:])
 				mml.Nop(_matches)
 				return func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					func() {
-						sp := _intersectType.(*mml.Struct)
-						for k, v := range sp.Values {
-							s.Values[k] = v
-						}
-					}()
-					s.Values["matches"] = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _type, _matches)}).Values)
+					s := &mml.Struct{}
+					s.Merge(_intersectType.(*mml.Struct))
+					s.Set("matches", _map.(*mml.Function).Call([]interface{}{_type, _matches}))
 					return s
 				}()
 			},
 			FixedArgs: 0,
 		}
 		exports["and"] = _and
+		direct_predicate = func(_p interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Merge(_predicateType.(*mml.Struct))
+				s.Set("predicate", _p)
+				return s
+			}()
+		}
 		_predicate = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _p = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_p)
-				return func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					func() {
-						sp := _predicateType.(*mml.Struct)
-						for k, v := range sp.Values {
-							s.Values[k] = v
-						}
-					}()
-					s.Values["predicate"] = _p
-					return s
-				}()
+				return direct_predicate(a[0])
 			},
 			FixedArgs: 1,
 		}
 		exports["predicate"] = _predicate
-		_predicates = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _p interface{}
-				_p = &mml.List{a[0:]}
-				mml.Nop(_p)
-				return _and.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _predicate, _p)}).Values).(*mml.List).Values...)}).Values)
-			},
-			FixedArgs: 0,
+		direct_isSimpleType = func(_t interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return ((((((mml.BinaryOp(11, _t, _integer).(bool) || mml.BinaryOp(11, _t, _floating).(bool)) || mml.BinaryOp(11, _t, _stringType).(bool)) || mml.BinaryOp(11, _t, _boolean).(bool)) || mml.BinaryOp(11, _t, _function).(bool)) || mml.BinaryOp(11, _t, _errorType).(bool)) || mml.BinaryOp(11, _t, _channel).(bool))
 		}
-		exports["predicates"] = _predicates
 		_isSimpleType = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _t = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_t)
-				return _some.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_functions, "bind").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_functions, "eq"), _t)}).Values), &mml.List{Values: append([]interface{}{}, _integer, _floating, _stringType, _boolean, _function, _errorType, _channel)})}).Values)
+				return direct_isSimpleType(a[0])
 			},
 			FixedArgs: 1,
 		}
+		direct_isComplexType = func(_t interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return ((((_isStruct.(*mml.Function).Call([]interface{}{_t}).(bool) && _has.(*mml.Function).Call([]interface{}{"token", _t}).(bool)) && mml.BinaryOp(11, mml.Ref(_t, "token"), _token).(bool)) && _has.(*mml.Function).Call([]interface{}{"type", _t}).(bool)) && _isString.(*mml.Function).Call([]interface{}{mml.Ref(_t, "type")}).(bool))
+		}
 		_isComplexType = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _t = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_t)
-				return ((((_isStruct.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _t)}).Values).(bool) && _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "token", _t)}).Values).(bool)) && mml.BinaryOp(11, mml.Ref(_t, "token"), _token).(bool)) && _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "type", _t)}).Values).(bool)) && _isString.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_t, "type"))}).Values).(bool))
+				return direct_isComplexType(a[0])
 			},
 			FixedArgs: 1,
 		}
+		direct_isType = func(_t interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return (direct_isSimpleType(_t).(bool) || direct_isComplexType(_t).(bool))
+		}
 		_isType = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _t = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_t)
-				return (_isSimpleType.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _t)}).Values).(bool) || _isComplexType.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _t)}).Values).(bool))
+				return direct_isType(a[0])
 			},
 			FixedArgs: 1,
 		}
+		direct_complexTypeEq = func(_type, _value interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return ((direct_isComplexType(_type).(bool) && direct_isComplexType(_value).(bool)) && mml.BinaryOp(11, mml.Ref(_type, "type"), mml.Ref(_value, "type")).(bool))
+		}
 		_complexTypeEq = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _type = a[0]
-				var _value = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_type, _value)
-				return ((_isComplexType.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _type)}).Values).(bool) && _isComplexType.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _value)}).Values).(bool)) && mml.BinaryOp(11, mml.Ref(_type, "type"), mml.Ref(_value, "type")).(bool))
+				return direct_complexTypeEq(a[0], a[1])
 			},
 			FixedArgs: 2,
 		}
 		_primitives = func() interface{} {
-			s := &mml.Struct{Values: make(map[string]interface{})}
-			s.Values["int"] = func() interface{} {
-				s := &mml.Struct{Values: make(map[string]interface{})}
-				s.Values["checkValue"] = _isInt
-				s.Values["type"] = _integer
-				s.Values["rangeType"] = _intRangeType
-				s.Values["rangeValue"] = mml.Ref(_functions, "identity")
+			s := &mml.Struct{}
+			s.Set("int", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("checkValue", _isInt)
+				s.Set("type", _integer)
+				s.Set("rangeType", _intRangeType)
+				s.Set("rangeValue", mml.Ref(_functions, "identity"))
 				return s
-			}()
-			s.Values["float"] = func() interface{} {
-				s := &mml.Struct{Values: make(map[string]interface{})}
-				s.Values["checkValue"] = _isFloat
-				s.Values["type"] = _floating
-				s.Values["rangeType"] = _floatRangeType
-				s.Values["rangeValue"] = mml.Ref(_functions, "identity")
+			}())
+			s.Set("float", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("checkValue", _isFloat)
+				s.Set("type", _floating)
+				s.Set("rangeType", _floatRangeType)
+				s.Set("rangeValue", mml.Ref(_functions, "identity"))
 				return s
-			}()
-			s.Values["string"] = func() interface{} {
-				s := &mml.Struct{Values: make(map[string]interface{})}
-				s.Values["checkValue"] = _isString
-				s.Values["type"] = _stringType
-				s.Values["rangeType"] = _stringRangeType
-				s.Values["rangeValue"] = _len
+			}())
+			s.Set("string", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("checkValue", _isString)
+				s.Set("type", _stringType)
+				s.Set("rangeType", _stringRangeType)
+				s.Set("rangeValue", _len)
 				return s
-			}()
+			}())
 			return s
 		}()
-		_matchPrimitive = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _def = a[0]
-				var _match = a[1]
-				var _value = a[2]
-				var _ interface{}
-				_ = &mml.List{a[3:]}
-				mml.Nop(_def, _match, _value)
+		direct_matchPrimitive = func(_def, _match, _value interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
 
-				mml.Nop()
-				switch {
-				case !mml.Ref(_def, "checkValue").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _value)}).Values).(bool):
+			mml.Nop()
+			switch {
+			case !mml.Ref(_def, "checkValue").(*mml.Function).Call([]interface{}{_value}).(bool):
 
-					mml.Nop()
-					return false
-				case mml.BinaryOp(11, _match, mml.Ref(_def, "type")):
+				mml.Nop()
+				return false
+			case mml.BinaryOp(11, _match, mml.Ref(_def, "type")):
 
-					mml.Nop()
-					return true
-				case _complexTypeEq.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_def, "rangeType"), _match)}).Values):
-					var _rv interface{}
-					mml.Nop(_rv)
-					_rv = mml.Ref(_def, "rangeValue").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _value)}).Values)
-					return (mml.BinaryOp(16, _rv, mml.Ref(_match, "min")).(bool) && mml.BinaryOp(14, _rv, mml.Ref(_match, "max")).(bool))
-				default:
+				mml.Nop()
+				return true
+			case direct_complexTypeEq(mml.Ref(_def, "rangeType"), _match):
+				var _rv interface{}
+				mml.Nop(_rv)
+				_rv = mml.Ref(_def, "rangeValue").(*mml.Function).Call([]interface{}{_value})
+				return (mml.BinaryOp(16, _rv, mml.Ref(_match, "min")).(bool) && mml.BinaryOp(14, _rv, mml.Ref(_match, "max")).(bool))
+			default:
 
-					mml.Nop()
-					return false
-				}
-				return nil
+				mml.Nop()
+				return false
+			}
+		}
+		_matchPrimitive = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_matchPrimitive(a[0], a[1], a[2])
 			},
 			FixedArgs: 3,
 		}
-		_matchInt = _matchPrimitive.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_primitives, "int"))}).Values)
-		_matchFloat = _matchPrimitive.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_primitives, "float"))}).Values)
-		_matchString = _matchPrimitive.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_primitives, "string"))}).Values)
-		_matchToList = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _match = a[0]
-				var _value = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_match, _value)
+		_matchInt = _matchPrimitive.(*mml.Function).Call([]interface{}{mml.Ref(_primitives, "int")})
+		_matchFloat = _matchPrimitive.(*mml.Function).Call([]interface{}{mml.Ref(_primitives, "float")})
+		_matchString = _matchPrimitive.(*mml.Function).Call([]interface{}{mml.Ref(_primitives, "string")})
+		direct_matchToList = func(_match, _value interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
 
+			mml.Nop()
+			c = mml.BinaryOp(13, _len.(*mml.Function).Call([]interface{}{_value}), _len.(*mml.Function).Call([]interface{}{_match}))
+			if c.(bool) {
 				mml.Nop()
-				c = mml.BinaryOp(13, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _value)}).Values), _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _match)}).Values))
-				if c.(bool) {
-					mml.Nop()
-					return false
-				}
-				for _i := 0; _i < _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _match)}).Values).(int); _i++ {
+				return false
+			}
+			for _i := 0; _i < _len.(*mml.Function).Call([]interface{}{_match}).(int); _i++ {
 
+				mml.Nop()
+				if !_is.(*mml.Function).Call([]interface{}{mml.Ref(_match, _i), mml.Ref(_value, _i)}).(bool) {
 					mml.Nop()
-					c = !_is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_match, _i), mml.Ref(_value, _i))}).Values).(bool)
-					if c.(bool) {
-						mml.Nop()
-						return false
-					}
+					return false
 				}
-				return true
-				return nil
+			}
+			return true
+		}
+		_matchToList = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_matchToList(a[0], a[1])
 			},
 			FixedArgs: 2,
 		}
+		direct_matchToListType = func(_match, _value interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return ((mml.BinaryOp(16, _len.(*mml.Function).Call([]interface{}{_value}), mml.Ref(_match, "min")).(bool) && mml.BinaryOp(14, _len.(*mml.Function).Call([]interface{}{_value}), mml.Ref(_match, "max")).(bool)) && (mml.BinaryOp(11, mml.Ref(_match, "item"), _any).(bool) || _every.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{mml.Ref(_match, "item")}), _value}).(bool)))
+		}
 		_matchToListType = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _match = a[0]
-				var _value = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_match, _value)
-				return ((mml.BinaryOp(16, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _value)}).Values), mml.Ref(_match, "min")).(bool) && mml.BinaryOp(14, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _value)}).Values), mml.Ref(_match, "max")).(bool)) && (mml.BinaryOp(11, mml.Ref(_match, "item"), _any).(bool) || _every.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_match, "item"))}).Values), _value)}).Values).(bool)))
+				return direct_matchToListType(a[0], a[1])
 			},
 			FixedArgs: 2,
 		}
-		_matchList = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _match = a[0]
-				var _value = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_match, _value)
+		direct_matchList = func(_match, _value interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
 
-				mml.Nop()
-				switch {
-				case !_isList.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _value)}).Values).(bool):
+			mml.Nop()
+			switch {
+			case !_isList.(*mml.Function).Call([]interface{}{_value}).(bool):
 
-					mml.Nop()
-					return false
-				case _isList.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _match)}).Values):
+				mml.Nop()
+				return false
+			case _isList.(*mml.Function).Call([]interface{}{_match}):
 
-					mml.Nop()
-					return _matchToList.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _match, _value)}).Values)
-				case _complexTypeEq.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _listType, _match)}).Values):
+				mml.Nop()
+				return direct_matchToList(_match, _value)
+			case direct_complexTypeEq(_listType, _match):
 
-					mml.Nop()
-					return _matchToListType.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _match, _value)}).Values)
-				default:
+				mml.Nop()
+				return direct_matchToListType(_match, _value)
+			default:
 
-					mml.Nop()
-					return false
-				}
-				return nil
+				mml.Nop()
+				return false
+			}
+		}
+		_matchList = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_matchList(a[0], a[1])
 			},
 			FixedArgs: 2,
 		}
+		direct_matchStruct = func(_match, _value interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return (_isStruct.(*mml.Function).Call([]interface{}{_value}).(bool) && _every.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _key = a[0]
+					mml.Nop(_key)
+					return (_has.(*mml.Function).Call([]interface{}{_key, _value}).(bool) && _is.(*mml.Function).Call([]interface{}{mml.Ref(_match, _key), mml.Ref(_value, _key)}).(bool))
+				},
+				FixedArgs: 1,
+			}, _keys.(*mml.Function).Call([]interface{}{_match})}).(bool))
+		}
 		_matchStruct = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _match = a[0]
-				var _value = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_match, _value)
-				return (_isStruct.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _value)}).Values).(bool) && _every.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _key = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_key)
-						return (_has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _key, _value)}).Values).(bool) && _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_match, _key), mml.Ref(_value, _key))}).Values).(bool))
-					},
-					FixedArgs: 1,
-				}, _keys.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _match)}).Values))}).Values).(bool))
+				return direct_matchStruct(a[0], a[1])
 			},
 			FixedArgs: 2,
 		}
+		direct_matchUnion = func(_match, _value interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _some.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _m = a[0]
+					mml.Nop(_m)
+					return _is.(*mml.Function).Call([]interface{}{_m, _value})
+				},
+				FixedArgs: 1,
+			}, mml.Ref(_match, "matches")})
+		}
 		_matchUnion = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _match = a[0]
-				var _value = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_match, _value)
-				return _some.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _m = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_m)
-						return _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _m, _value)}).Values)
-					},
-					FixedArgs: 1,
-				}, mml.Ref(_match, "matches"))}).Values)
+				return direct_matchUnion(a[0], a[1])
 			},
 			FixedArgs: 2,
 		}
+		direct_matchIntersection = func(_match, _value interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _every.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _m = a[0]
+					mml.Nop(_m)
+					return _is.(*mml.Function).Call([]interface{}{_m, _value})
+				},
+				FixedArgs: 1,
+			}, mml.Ref(_match, "matches")})
+		}
 		_matchIntersection = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _match = a[0]
-				var _value = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_match, _value)
-				return _every.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _m = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_m)
-						return _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _m, _value)}).Values)
-					},
-					FixedArgs: 1,
-				}, mml.Ref(_match, "matches"))}).Values)
+				return direct_matchIntersection(a[0], a[1])
 			},
 			FixedArgs: 2,
 		}
-		_matchOne = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _match = a[0]
-				var _value = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_match, _value)
+		direct_matchOne = func(_match, _value interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
 
-				mml.Nop()
-				switch {
-				case mml.BinaryOp(11, _match, _none):
+			mml.Nop()
+			switch {
+			case mml.BinaryOp(11, _match, _none):
 
-					mml.Nop()
-					return false
-				case mml.BinaryOp(11, _match, _any):
+				mml.Nop()
+				return false
+			case mml.BinaryOp(11, _match, _any):
 
-					mml.Nop()
-					return true
-				case _complexTypeEq.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _predicateType, _match)}).Values):
+				mml.Nop()
+				return true
+			case direct_complexTypeEq(_predicateType, _match):
 
-					mml.Nop()
-					return mml.Ref(_match, "predicate").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _value)}).Values)
-				case (_isType.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _value)}).Values).(bool) && mml.BinaryOp(12, _value, _function).(bool)):
+				mml.Nop()
+				return mml.Ref(_match, "predicate").(*mml.Function).Call([]interface{}{_value})
+			case (direct_isType(_value).(bool) && mml.BinaryOp(12, _value, _function).(bool)):
 
-					mml.Nop()
-					return false
-				case mml.BinaryOp(11, _match, _value):
+				mml.Nop()
+				return false
+			case mml.BinaryOp(11, _match, _value):
 
-					mml.Nop()
-					return true
-				case (mml.BinaryOp(11, _match, _integer).(bool) || _complexTypeEq.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _intRangeType, _match)}).Values).(bool)):
+				mml.Nop()
+				return true
+			case (mml.BinaryOp(11, _match, _integer).(bool) || direct_complexTypeEq(_intRangeType, _match).(bool)):
 
-					mml.Nop()
-					return _matchInt.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _match, _value)}).Values)
-				case (mml.BinaryOp(11, _match, _floating).(bool) || _complexTypeEq.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _floatRangeType, _match)}).Values).(bool)):
+				mml.Nop()
+				return _matchInt.(*mml.Function).Call([]interface{}{_match, _value})
+			case (mml.BinaryOp(11, _match, _floating).(bool) || direct_complexTypeEq(_floatRangeType, _match).(bool)):
 
-					mml.Nop()
-					return _matchFloat.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _match, _value)}).Values)
-				case (mml.BinaryOp(11, _match, _stringType).(bool) || _complexTypeEq.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _stringRangeType, _match)}).Values).(bool)):
+				mml.Nop()
+				return _matchFloat.(*mml.Function).Call([]interface{}{_match, _value})
+			case (mml.BinaryOp(11, _match, _stringType).(bool) || direct_complexTypeEq(_stringRangeType, _match).(bool)):
 
-					mml.Nop()
-					return _matchString.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _match, _value)}).Values)
-				case mml.BinaryOp(11, _match, _boolean):
+				mml.Nop()
+				return _matchString.(*mml.Function).Call([]interface{}{_match, _value})
+			case mml.BinaryOp(11, _match, _boolean):
 
-					mml.Nop()
-					return _isBool.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _value)}).Values)
-				case mml.BinaryOp(11, _match, _function):
+				mml.Nop()
+				return _isBool.(*mml.Function).Call([]interface{}{_value})
+			case mml.BinaryOp(11, _match, _function):
 
-					mml.Nop()
-					return _isFunction.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _value)}).Values)
-				case mml.BinaryOp(11, _match, _channel):
+				mml.Nop()
+				return _isFunction.(*mml.Function).Call([]interface{}{_value})
+			case mml.BinaryOp(11, _match, _channel):
 
-					mml.Nop()
-					return _isChannel.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _value)}).Values)
-				case mml.BinaryOp(11, _match, _errorType):
+				mml.Nop()
+				return _isChannel.(*mml.Function).Call([]interface{}{_value})
+			case mml.BinaryOp(11, _match, _errorType):
 
-					mml.Nop()
-					return _isError.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _value)}).Values)
-				case (_isList.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _match)}).Values).(bool) || _complexTypeEq.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _listType, _match)}).Values).(bool)):
+				mml.Nop()
+				return _isError.(*mml.Function).Call([]interface{}{_value})
+			case (_isList.(*mml.Function).Call([]interface{}{_match}).(bool) || direct_complexTypeEq(_listType, _match).(bool)):
 
-					mml.Nop()
-					return _matchList.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _match, _value)}).Values)
-				case _complexTypeEq.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _unionType, _match)}).Values):
+				mml.Nop()
+				return direct_matchList(_match, _value)
+			case direct_complexTypeEq(_unionType, _match):
 
-					mml.Nop()
-					return _matchUnion.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _match, _value)}).Values)
-				case _complexTypeEq.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _intersectType, _match)}).Values):
+				mml.Nop()
+				return direct_matchUnion(_match, _value)
+			case direct_complexTypeEq(_intersectType, _match):
 
-					mml.Nop()
-					return _matchIntersection.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _match, _value)}).Values)
-				case _isStruct.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _match)}).Values):
+				mml.Nop()
+				return direct_matchIntersection(_match, _value)
+			case _isStruct.(*mml.Function).Call([]interface{}{_match}):
 
-					mml.Nop()
-					return _matchStruct.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _match, _value)}).Values)
-				default:
+				mml.Nop()
+				return direct_matchStruct(_match, _value)
+			default:
 
-					mml.Nop()
-					return false
-				}
-				return nil
+				mml.Nop()
+				return false
+			}
+		}
+		_matchOne = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_matchOne(a[0], a[1])
 			},
 			FixedArgs: 2,
 		}
+		direct_rangeMin = func(_match, _min interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return direct_range(_match, _min, func() interface{} {
+				c = _isInt.(*mml.Function).Call([]interface{}{_min})
+				if c.(bool) {
+					return mml.Ref(_ints, "max")
+				} else {
+					return mml.Ref(_float, "max")
+				}
+			}())
+		}
 		_rangeMin = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _match = a[0]
-				var _min = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_match, _min)
-				return _range.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _match, _min, func() interface{} {
-					c = _isInt.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _min)}).Values)
-					if c.(bool) {
-						return mml.Ref(_ints, "max")
-					} else {
-						return mml.Ref(_float, "max")
-					}
-				}())}).Values)
+				return direct_rangeMin(a[0], a[1])
 			},
 			FixedArgs: 2,
 		}
 		exports["rangeMin"] = _rangeMin
+		direct_listLength = func(_l interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return direct_range(direct_listOf(_any), _l, _l)
+		}
 		_listLength = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _l = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_l)
-				return _range.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _listOf.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _any)}).Values), _l, _l)}).Values)
+				return direct_listLength(a[0])
 			},
 			FixedArgs: 1,
 		}
 		exports["listLength"] = _listLength
+		direct_not = func(_m interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return direct_predicate(&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _v = a[0]
+					mml.Nop(_v)
+					return !_is.(*mml.Function).Call([]interface{}{_m, _v}).(bool)
+				},
+				FixedArgs: 1,
+			})
+		}
 		_not = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _m = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_m)
-				return _predicate.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _v = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_v)
-						return !_is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _m, _v)}).Values).(bool)
-					},
-					FixedArgs: 1,
-				})}).Values)
+				return direct_not(a[0])
 			},
 			FixedArgs: 1,
 		}
 		exports["not"] = _not
-		_natural = _rangeMin.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _int, 0)}).Values)
+		_natural = direct_rangeMin(_int, 0)
 		exports["natural"] = _natural
 		_is = &mml.Function{
 			F: func(a []interface{}) interface{} {
@@ -2280,14 +2073,14 @@ func init() {
 				mml.Nop(c)
 				var _match = a[0]
 				var _values interface{}
-				_values = &mml.List{a[1:]}
+				_values = mml.NewList(a[1:])
 				mml.Nop(_match, _values)
 				return func() interface{} {
-					c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _values)}).Values), 0)
+					c = mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_values}), 0)
 					if c.(bool) {
-						return mml.Ref(_functions, "bind").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _is, _match)}).Values)
+						return mml.Ref(_functions, "bind").(*mml.Function).Call([]interface{}{_is, _match})
 					} else {
-						return _every.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _matchOne.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _match)}).Values), _values)}).Values)
+						return _every.(*mml.Function).Call([]interface{}{_matchOne.(*mml.Function).Call([]interface{}{_match}), _values})
 					}
 				}()
 			},
@@ -2300,39 +2093,36 @@ func init() {
 
 	modulePath = "floats"
 
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
 		exports := make(map[string]interface{})
 
 		var c interface{}
 		mml.Nop(c)
 
-		var _min interface{}
-		var _max interface{}
-		mml.Nop(_min, _max)
-		_min = mml.UnaryOp(2, 9000)
-		exports["min"] = _min
-		_max = 9000
-		exports["max"] = _max
+		mml.Nop()
 
 		return exports
 	})
 
-	modulePath = "read"
+	modulePath = "paths"
 
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
 		exports := make(map[string]interface{})
 
 		var c interface{}
 		mml.Nop(c)
 
-		var _readModule interface{}
-		var _do interface{}
-		var _parse interface{}
-		var _errors interface{}
-		var _io interface{}
-		var _paths interface{}
-		var _structs interface{}
-		var _codetree interface{}
+		var _extension string
+		var _hasPrefix interface{}
+		var _appendSegment interface{}
+		var _isAbsolute interface{}
+		var _isRelative interface{}
+		var _normalize interface{}
+		var _trimExtension interface{}
+		var _dir interface{}
+		var _base interface{}
+		var _resolve interface{}
+		var _strings interface{}
 		var _fold interface{}
 		var _foldr interface{}
 		var _map interface{}
@@ -2341,8 +2131,6 @@ func init() {
 		var _sort interface{}
 		var _flat interface{}
 		var _flats interface{}
-		var _concat interface{}
-		var _concats interface{}
 		var _uniq interface{}
 		var _every interface{}
 		var _some interface{}
@@ -2369,284 +2157,290 @@ func init() {
 		var _and interface{}
 		var _not interface{}
 		var _predicate interface{}
-		var _predicates interface{}
 		var _is interface{}
-		mml.Nop(_readModule, _do, _parse, _errors, _io, _paths, _structs, _codetree, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _flats, _concat, _concats, _uniq, _every, _some, _join, _joins, _formats, _enum, _log, _fatal, _bind, _identity, _eq, _any, _function, _channel, _natural, _type, _listOf, _structOf, _range, _rangeMin, _listLength, _or, _and, _not, _predicate, _predicates, _is)
-		var __lang = mml.Modules.Use("lang")
-		_fold = __lang.Values["fold"]
-		_foldr = __lang.Values["foldr"]
-		_map = __lang.Values["map"]
-		_filter = __lang.Values["filter"]
-		_contains = __lang.Values["contains"]
-		_sort = __lang.Values["sort"]
-		_flat = __lang.Values["flat"]
-		_flats = __lang.Values["flats"]
-		_concat = __lang.Values["concat"]
-		_concats = __lang.Values["concats"]
-		_uniq = __lang.Values["uniq"]
-		_every = __lang.Values["every"]
-		_some = __lang.Values["some"]
-		_join = __lang.Values["join"]
-		_joins = __lang.Values["joins"]
-		_formats = __lang.Values["formats"]
-		_enum = __lang.Values["enum"]
-		_log = __lang.Values["log"]
-		_fatal = __lang.Values["fatal"]
-		_bind = __lang.Values["bind"]
-		_identity = __lang.Values["identity"]
-		_eq = __lang.Values["eq"]
-		_any = __lang.Values["any"]
-		_function = __lang.Values["function"]
-		_channel = __lang.Values["channel"]
-		_natural = __lang.Values["natural"]
-		_type = __lang.Values["type"]
-		_listOf = __lang.Values["listOf"]
-		_structOf = __lang.Values["structOf"]
-		_range = __lang.Values["range"]
-		_rangeMin = __lang.Values["rangeMin"]
-		_listLength = __lang.Values["listLength"]
-		_or = __lang.Values["or"]
-		_and = __lang.Values["and"]
-		_not = __lang.Values["not"]
-		_predicate = __lang.Values["predicate"]
-		_predicates = __lang.Values["predicates"]
-		_is = __lang.Values["is"]
-		_parse = mml.Modules.Use("parse")
-		_errors = mml.Modules.Use("errors")
-		_io = mml.Modules.Use("io")
-		_paths = mml.Modules.Use("paths")
-		_structs = mml.Modules.Use("structs")
-		_codetree = mml.Modules.Use("codetree")
-		_readModule = &mml.Function{
+		var direct_hasPrefix func(interface{}, interface{}) interface{}
+		mml.Nop(direct_hasPrefix)
+		var direct_appendSegment func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_appendSegment)
+		var direct_isAbsolute func(interface{}) interface{}
+		mml.Nop(direct_isAbsolute)
+		var direct_isRelative func(interface{}) interface{}
+		mml.Nop(direct_isRelative)
+		var direct_normalize func(interface{}) interface{}
+		mml.Nop(direct_normalize)
+		var direct_trimExtension func(interface{}) interface{}
+		mml.Nop(direct_trimExtension)
+		var direct_dir func(interface{}) interface{}
+		mml.Nop(direct_dir)
+		var direct_base func(interface{}) interface{}
+		mml.Nop(direct_base)
+		var direct_resolve func(interface{}, interface{}) interface{}
+		mml.Nop(direct_resolve)
+		mml.Nop(_extension, _hasPrefix, _appendSegment, _isAbsolute, _isRelative, _normalize, _trimExtension, _dir, _base, _resolve, _strings, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _flats, _uniq, _every, _some, _join, _joins, _formats, _enum, _log, _fatal, _bind, _identity, _eq, _any, _function, _channel, _natural, _type, _listOf, _structOf, _range, _rangeMin, _listLength, _or, _and, _not, _predicate, _is)
+		var __lang = use("lang")
+		_fold = __lang.Get("fold")
+		_foldr = __lang.Get("foldr")
+		_map = __lang.Get("map")
+		_filter = __lang.Get("filter")
+		_contains = __lang.Get("contains")
+		_sort = __lang.Get("sort")
+		_flat = __lang.Get("flat")
+		_flats = __lang.Get("flats")
+		_uniq = __lang.Get("uniq")
+		_every = __lang.Get("every")
+		_some = __lang.Get("some")
+		_join = __lang.Get("join")
+		_joins = __lang.Get("joins")
+		_formats = __lang.Get("formats")
+		_enum = __lang.Get("enum")
+		_log = __lang.Get("log")
+		_fatal = __lang.Get("fatal")
+		_bind = __lang.Get("bind")
+		_identity = __lang.Get("identity")
+		_eq = __lang.Get("eq")
+		_any = __lang.Get("any")
+		_function = __lang.Get("function")
+		_channel = __lang.Get("channel")
+		_natural = __lang.Get("natural")
+		_type = __lang.Get("type")
+		_listOf = __lang.Get("listOf")
+		_structOf = __lang.Get("structOf")
+		_range = __lang.Get("range")
+		_rangeMin = __lang.Get("rangeMin")
+		_listLength = __lang.Get("listLength")
+		_or = __lang.Get("or")
+		_and = __lang.Get("and")
+		_not = __lang.Get("not")
+		_predicate = __lang.Get("predicate")
+		_is = __lang.Get("is")
+		_strings = use("strings")
+		_extension = ".mml"
+		direct_isAbsolute = func(_path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return (mml.BinaryOp(15, _len.(*mml.Function).Call([]interface{}{_path}), 0).(bool) && mml.BinaryOp(11, mml.Ref(_path, 0), "/").(bool))
+		}
+		_isAbsolute = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _reading = a[0]
-				var _modules = a[1]
-				var _path = a[2]
-				var _ interface{}
-				_ = &mml.List{a[3:]}
-				mml.Nop(_reading, _modules, _path)
-				var _moduleCode interface{}
-				var _usePaths interface{}
-				var _readingUses interface{}
-				var _nextModules interface{}
-				var _setUsedModule interface{}
-				var _withUsedModules interface{}
-				mml.Nop(_moduleCode, _usePaths, _readingUses, _nextModules, _setUsedModule, _withUsedModules)
-				c = _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _path, _reading)}).Values)
-				if c.(bool) {
-					mml.Nop()
-					return _error.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "circular module reference")}).Values)
-				}
-				c = _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _path, _modules)}).Values)
-				if c.(bool) {
-					mml.Nop()
-					return _modules
-				}
-				_moduleCode = mml.Ref(_errors, "pass").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _bind.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _formats, "%s.mml")}).Values), mml.Ref(_io, "readFile"), mml.Ref(_parse, "do"))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _path)}).Values)
-				if v := _moduleCode; mml.IsError.F([]interface{}{v}).(bool) {
-					return v
-				}
-				_usePaths = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_structs, "get").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "value")}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_structs, "get").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "path")}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_codetree, "filter").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["type"] = "use"
-					return s
-				}())}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _moduleCode)}).Values))}).Values))}).Values)
-				_readingUses = func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					func() {
-						sp := _reading.(*mml.Struct)
-						for k, v := range sp.Values {
-							s.Values[k] = v
-						}
-					}()
-					s.Values[_path.(string)] = true
-					return s
-				}()
-				_nextModules = _fold.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _path = a[0]
-						var _modules = a[1]
-						var _ interface{}
-						_ = &mml.List{a[2:]}
-						mml.Nop(_path, _modules)
-						return func() interface{} {
-							c = _isError.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _modules)}).Values)
-							if c.(bool) {
-								return _modules
-							} else {
-								return _readModule.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _readingUses, _modules, _path)}).Values)
-							}
-						}()
-					},
-					FixedArgs: 2,
-				}, _modules, _usePaths)}).Values)
-				if v := _nextModules; mml.IsError.F([]interface{}{v}).(bool) {
-					return v
-				}
-				_setUsedModule = &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _code = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_code)
-						return func() interface{} {
-							c = _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-								s := &mml.Struct{Values: make(map[string]interface{})}
-								s.Values["type"] = "use"
-								return s
-							}(), _code)}).Values)
-							if c.(bool) {
-								return func() interface{} {
-									s := &mml.Struct{Values: make(map[string]interface{})}
-									func() {
-										sp := _code.(*mml.Struct)
-										for k, v := range sp.Values {
-											s.Values[k] = v
-										}
-									}()
-									s.Values["module"] = mml.Ref(_nextModules, mml.Ref(mml.Ref(_code, "path"), "value"))
-									return s
-								}()
-							} else {
-								return _code
-							}
-						}()
-					},
-					FixedArgs: 1,
-				}
-				_withUsedModules = mml.Ref(_codetree, "edit").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _setUsedModule, _moduleCode)}).Values)
-				return func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					func() {
-						sp := _nextModules.(*mml.Struct)
-						for k, v := range sp.Values {
-							s.Values[k] = v
-						}
-					}()
-					s.Values[_path.(string)] = func() interface{} {
-						s := &mml.Struct{Values: make(map[string]interface{})}
-						func() {
-							sp := _withUsedModules.(*mml.Struct)
-							for k, v := range sp.Values {
-								s.Values[k] = v
-							}
-						}()
-						s.Values["path"] = _path
-						return s
-					}()
-					return s
-				}()
-				return nil
+				return direct_isAbsolute(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["isAbsolute"] = _isAbsolute
+		direct_hasPrefix = func(_prefix, _path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return (mml.BinaryOp(16, _len.(*mml.Function).Call([]interface{}{_path}), _len.(*mml.Function).Call([]interface{}{_prefix})).(bool) && mml.BinaryOp(11, mml.RefRange(_path, nil, _len.(*mml.Function).Call([]interface{}{_prefix})), _prefix).(bool))
+		}
+		_hasPrefix = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_hasPrefix(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_isRelative = func(_path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return (((mml.BinaryOp(11, _path, ".").(bool) || mml.BinaryOp(11, _path, "..").(bool)) || direct_hasPrefix("./", _path).(bool)) || direct_hasPrefix("../", _path).(bool))
+		}
+		_isRelative = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_isRelative(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["isRelative"] = _isRelative
+		direct_appendSegment = func(_absolute, _segment, _segments interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			switch {
+			case mml.BinaryOp(12, _segment, ".."):
+
+				mml.Nop()
+				return (&mml.List{}).Concat(_segments.(*mml.List)).Append(_segment)
+			case (mml.BinaryOp(15, _len.(*mml.Function).Call([]interface{}{_segments}), 0).(bool) && mml.BinaryOp(12, mml.Ref(_segments, mml.BinaryOp(10, _len.(*mml.Function).Call([]interface{}{_segments}), 1)), "..").(bool)):
+
+				mml.Nop()
+				return mml.RefRange(_segments, nil, mml.BinaryOp(10, _len.(*mml.Function).Call([]interface{}{_segments}), 1))
+			case _absolute:
+
+				mml.Nop()
+				return _segments
+			default:
+
+				mml.Nop()
+				return (&mml.List{}).Concat(_segments.(*mml.List)).Append(_segment)
+			}
+		}
+		_appendSegment = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_appendSegment(a[0], a[1], a[2])
 			},
 			FixedArgs: 3,
 		}
-		_do = &mml.Function{
+		direct_normalize = func(_path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _absolute interface{}
+			var _segments interface{}
+			var _joined interface{}
+			mml.Nop(_absolute, _segments, _joined)
+			_absolute = direct_isAbsolute(_path)
+			_segments = _fold.(*mml.Function).Call([]interface{}{_appendSegment.(*mml.Function).Call([]interface{}{_absolute}), (&mml.List{})}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _s = a[0]
+					mml.Nop(_s)
+					return (mml.BinaryOp(12, _s, "").(bool) && mml.BinaryOp(12, _s, ".").(bool))
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{mml.Ref(_strings, "split").(*mml.Function).Call([]interface{}{"/"}).(*mml.Function).Call([]interface{}{_path})})})
+			_joined = _join.(*mml.Function).Call([]interface{}{"/", _segments})
+			switch {
+			case _absolute:
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"/%s", _joined})
+			case mml.BinaryOp(11, _joined, ""):
+
+				mml.Nop()
+				return "."
+			default:
+
+				mml.Nop()
+				return _joined
+			}
+		}
+		_normalize = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _path = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_path)
-				return mml.Ref(_errors, "pass").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_paths, "normalize"), mml.Ref(_paths, "trimExtension"), _readModule.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; ; return s }(), func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; ; return s }())}).Values), mml.Ref(_structs, "get").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _path)}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _path)}).Values)
+				return direct_normalize(a[0])
 			},
 			FixedArgs: 1,
 		}
-		exports["do"] = _do
+		exports["normalize"] = _normalize
+		direct_trimExtension = func(_path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _l interface{}
+			mml.Nop(_l)
+			_l = mml.BinaryOp(10, _len.(*mml.Function).Call([]interface{}{_path}), _len.(*mml.Function).Call([]interface{}{_extension}))
+			return func() interface{} {
+				if mml.BinaryOp(16, _l, 0).(bool) && mml.BinaryOp(11, mml.RefRange(_path, _l, nil), _extension).(bool) {
+					return mml.RefRange(_path, nil, _l)
+				} else {
+					return _path
+				}
+			}()
+		}
+		_trimExtension = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_trimExtension(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["trimExtension"] = _trimExtension
+		direct_dir = func(_path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return direct_normalize(_formats.(*mml.Function).Call([]interface{}{"%s/..", _path}))
+		}
+		_dir = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_dir(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["dir"] = _dir
+		direct_base = func(_path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _segments interface{}
+			mml.Nop(_segments)
+			_segments = mml.Ref(_strings, "split").(*mml.Function).Call([]interface{}{"/"}).(*mml.Function).Call([]interface{}{direct_normalize(_path)})
+			return mml.Ref(_segments, mml.BinaryOp(10, _len.(*mml.Function).Call([]interface{}{_segments}), 1))
+		}
+		_base = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_base(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["base"] = _base
+		direct_resolve = func(_dir, _path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				c = direct_isAbsolute(_path)
+				if c.(bool) {
+					return direct_normalize(_path)
+				} else {
+					return direct_normalize(_formats.(*mml.Function).Call([]interface{}{"%s/%s", _dir, _path}))
+				}
+			}()
+		}
+		_resolve = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_resolve(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		exports["resolve"] = _resolve
 
 		return exports
 	})
 
-	modulePath = "parse"
+	modulePath = "read"
 
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
 		exports := make(map[string]interface{})
 
 		var c interface{}
 		mml.Nop(c)
 
-		var _assortComments interface{}
-		var _create interface{}
-		var _functionFact interface{}
-		var _rangeExpression interface{}
-		var _indexer interface{}
-		var _application interface{}
-		var _unary interface{}
-		var _binary interface{}
-		var _chaining interface{}
-		var _ternary interface{}
-		var _ifStatement interface{}
-		var _parseCase interface{}
-		var _defaultStatements interface{}
-		var _switchStatement interface{}
-		var _sendStatement interface{}
-		var _receiveExpression interface{}
-		var _selectStatement interface{}
-		var _rangeOver interface{}
-		var _loop interface{}
-		var _assign interface{}
-		var _valueCapture interface{}
-		var _mutableCapture interface{}
-		var _valueDefinition interface{}
-		var _definitionGroup interface{}
-		var _mutableDefinitionGroup interface{}
-		var _functionCapture interface{}
-		var _effectCapture interface{}
-		var _functionDefinition interface{}
-		var _effectDefinitionGroup interface{}
-		var _exportStatement interface{}
-		var _useFact interface{}
-		var _parse interface{}
-		var _parserError interface{}
-		var _knownOrError interface{}
-		var _parsePrimitive interface{}
-		var _ast interface{}
-		var _commentLine interface{}
-		var _lineComment interface{}
-		var _blockCommentContent interface{}
-		var _blockComment interface{}
-		var _intCode interface{}
-		var _floatCode interface{}
-		var _stringCode interface{}
-		var _boolCode interface{}
-		var _symbol interface{}
-		var _spread interface{}
-		var _list interface{}
-		var _mutableList interface{}
-		var _expressionKey interface{}
-		var _entry interface{}
-		var _struct interface{}
-		var _mutableStruct interface{}
-		var _ret interface{}
-		var _checkRet interface{}
-		var _statementListOf interface{}
-		var _statementList interface{}
-		var _collectParameter interface{}
-		var _functionLiteral interface{}
-		var _effect interface{}
-		var _symbolIndex interface{}
-		var _expressionIndex interface{}
-		var _rangeIndex interface{}
-		var _goStatement interface{}
-		var _deferStatement interface{}
-		var _useEffect interface{}
-		var _useList interface{}
-		var _module interface{}
+		var _exists interface{}
+		var _usePosition interface{}
+		var _cycleError interface{}
+		var _parseModule interface{}
+		var _reexportedUse interface{}
+		var _exportedNames interface{}
+		var _reexportDefinitions interface{}
+		var _link interface{}
+		var _standardPath interface{}
+		var _stdlibRoot string
+		var _uses interface{}
+		var _usePositions interface{}
+		var _fileName interface{}
+		var _interfaceFile interface{}
+		var _isStdlib interface{}
+		var _readSource interface{}
+		var _searchPath interface{}
+		var _locate interface{}
+		var _locateAll interface{}
+		var _locateInterface interface{}
+		var _readInterfaces interface{}
+		var _setPaths interface{}
+		var _loadAll interface{}
+		var _checkCycles interface{}
+		var _verifyInterfaces interface{}
+		var _reexports interface{}
+		var _checkReexports interface{}
+		var _expandReexports interface{}
 		var _do interface{}
-		var _validateast interface{}
-		var _structs interface{}
-		var _lists interface{}
-		var _code interface{}
+		var _parse interface{}
 		var _errors interface{}
+		var _io interface{}
+		var _paths interface{}
+		var _lists interface{}
+		var _structs interface{}
 		var _codetree interface{}
+		var _tasks interface{}
 		var _strings interface{}
-		var _functions interface{}
+		var _code interface{}
+		var _contracts interface{}
+		var _signatures interface{}
 		var _fold interface{}
 		var _foldr interface{}
 		var _map interface{}
@@ -2655,8 +2449,6 @@ func init() {
 		var _sort interface{}
 		var _flat interface{}
 		var _flats interface{}
-		var _concat interface{}
-		var _concats interface{}
 		var _uniq interface{}
 		var _every interface{}
 		var _some interface{}
@@ -2683,3585 +2475,3310 @@ func init() {
 		var _and interface{}
 		var _not interface{}
 		var _predicate interface{}
-		var _predicates interface{}
 		var _is interface{}
-		mml.Nop(_assortComments, _create, _functionFact, _rangeExpression, _indexer, _application, _unary, _binary, _chaining, _ternary, _ifStatement, _parseCase, _defaultStatements, _switchStatement, _sendStatement, _receiveExpression, _selectStatement, _rangeOver, _loop, _assign, _valueCapture, _mutableCapture, _valueDefinition, _definitionGroup, _mutableDefinitionGroup, _functionCapture, _effectCapture, _functionDefinition, _effectDefinitionGroup, _exportStatement, _useFact, _parse, _parserError, _knownOrError, _parsePrimitive, _ast, _commentLine, _lineComment, _blockCommentContent, _blockComment, _intCode, _floatCode, _stringCode, _boolCode, _symbol, _spread, _list, _mutableList, _expressionKey, _entry, _struct, _mutableStruct, _ret, _checkRet, _statementListOf, _statementList, _collectParameter, _functionLiteral, _effect, _symbolIndex, _expressionIndex, _rangeIndex, _goStatement, _deferStatement, _useEffect, _useList, _module, _do, _validateast, _structs, _lists, _code, _errors, _codetree, _strings, _functions, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _flats, _concat, _concats, _uniq, _every, _some, _join, _joins, _formats, _enum, _log, _fatal, _bind, _identity, _eq, _any, _function, _channel, _natural, _type, _listOf, _structOf, _range, _rangeMin, _listLength, _or, _and, _not, _predicate, _predicates, _is)
-		var __lang = mml.Modules.Use("lang")
-		_fold = __lang.Values["fold"]
-		_foldr = __lang.Values["foldr"]
-		_map = __lang.Values["map"]
-		_filter = __lang.Values["filter"]
-		_contains = __lang.Values["contains"]
-		_sort = __lang.Values["sort"]
-		_flat = __lang.Values["flat"]
-		_flats = __lang.Values["flats"]
-		_concat = __lang.Values["concat"]
-		_concats = __lang.Values["concats"]
-		_uniq = __lang.Values["uniq"]
-		_every = __lang.Values["every"]
-		_some = __lang.Values["some"]
-		_join = __lang.Values["join"]
-		_joins = __lang.Values["joins"]
-		_formats = __lang.Values["formats"]
-		_enum = __lang.Values["enum"]
-		_log = __lang.Values["log"]
-		_fatal = __lang.Values["fatal"]
-		_bind = __lang.Values["bind"]
-		_identity = __lang.Values["identity"]
-		_eq = __lang.Values["eq"]
-		_any = __lang.Values["any"]
-		_function = __lang.Values["function"]
-		_channel = __lang.Values["channel"]
-		_natural = __lang.Values["natural"]
-		_type = __lang.Values["type"]
-		_listOf = __lang.Values["listOf"]
-		_structOf = __lang.Values["structOf"]
-		_range = __lang.Values["range"]
-		_rangeMin = __lang.Values["rangeMin"]
-		_listLength = __lang.Values["listLength"]
-		_or = __lang.Values["or"]
-		_and = __lang.Values["and"]
-		_not = __lang.Values["not"]
-		_predicate = __lang.Values["predicate"]
-		_predicates = __lang.Values["predicates"]
-		_is = __lang.Values["is"]
-		_validateast = mml.Modules.Use("validateast")
-		_structs = mml.Modules.Use("structs")
-		_lists = mml.Modules.Use("lists")
-		_code = mml.Modules.Use("code")
-		_errors = mml.Modules.Use("errors")
-		_codetree = mml.Modules.Use("codetree")
-		_strings = mml.Modules.Use("strings")
-		_functions = mml.Modules.Use("functions")
-		_assortComments = &mml.Function{
+		var direct_exists func(interface{}) interface{}
+		mml.Nop(direct_exists)
+		var direct_usePosition func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_usePosition)
+		var direct_cycleError func(interface{}, interface{}) interface{}
+		mml.Nop(direct_cycleError)
+		var direct_parseModule func(interface{}, interface{}) interface{}
+		mml.Nop(direct_parseModule)
+		var direct_reexportedUse func(interface{}) interface{}
+		mml.Nop(direct_reexportedUse)
+		var direct_exportedNames func(interface{}) interface{}
+		mml.Nop(direct_exportedNames)
+		var direct_reexportDefinitions func(interface{}) interface{}
+		mml.Nop(direct_reexportDefinitions)
+		var direct_link func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_link)
+		var direct_uses func(interface{}) interface{}
+		mml.Nop(direct_uses)
+		var direct_usePositions func(interface{}) interface{}
+		mml.Nop(direct_usePositions)
+		var direct_fileName func(interface{}) interface{}
+		mml.Nop(direct_fileName)
+		var direct_interfaceFile func(interface{}) interface{}
+		mml.Nop(direct_interfaceFile)
+		var direct_isStdlib func(interface{}) interface{}
+		mml.Nop(direct_isStdlib)
+		var direct_readSource func(interface{}) interface{}
+		mml.Nop(direct_readSource)
+		var direct_searchPath func(interface{}) interface{}
+		mml.Nop(direct_searchPath)
+		var direct_locate func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_locate)
+		var direct_locateAll func(interface{}, interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_locateAll)
+		var direct_locateInterface func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_locateInterface)
+		var direct_readInterfaces func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_readInterfaces)
+		var direct_setPaths func(interface{}, interface{}) interface{}
+		mml.Nop(direct_setPaths)
+		var direct_loadAll func(interface{}, interface{}) interface{}
+		mml.Nop(direct_loadAll)
+		var direct_checkCycles func(interface{}, interface{}) interface{}
+		mml.Nop(direct_checkCycles)
+		var direct_verifyInterfaces func(interface{}, interface{}) interface{}
+		mml.Nop(direct_verifyInterfaces)
+		var direct_reexports func(interface{}) interface{}
+		mml.Nop(direct_reexports)
+		var direct_checkReexports func(interface{}) interface{}
+		mml.Nop(direct_checkReexports)
+		var direct_expandReexports func(interface{}) interface{}
+		mml.Nop(direct_expandReexports)
+		var direct_do func(interface{}) interface{}
+		mml.Nop(direct_do)
+		mml.Nop(_exists, _usePosition, _cycleError, _parseModule, _reexportedUse, _exportedNames, _reexportDefinitions, _link, _standardPath, _stdlibRoot, _uses, _usePositions, _fileName, _interfaceFile, _isStdlib, _readSource, _searchPath, _locate, _locateAll, _locateInterface, _readInterfaces, _setPaths, _loadAll, _checkCycles, _verifyInterfaces, _reexports, _checkReexports, _expandReexports, _do, _parse, _errors, _io, _paths, _lists, _structs, _codetree, _tasks, _strings, _code, _contracts, _signatures, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _flats, _uniq, _every, _some, _join, _joins, _formats, _enum, _log, _fatal, _bind, _identity, _eq, _any, _function, _channel, _natural, _type, _listOf, _structOf, _range, _rangeMin, _listLength, _or, _and, _not, _predicate, _is)
+		var __lang = use("lang")
+		_fold = __lang.Get("fold")
+		_foldr = __lang.Get("foldr")
+		_map = __lang.Get("map")
+		_filter = __lang.Get("filter")
+		_contains = __lang.Get("contains")
+		_sort = __lang.Get("sort")
+		_flat = __lang.Get("flat")
+		_flats = __lang.Get("flats")
+		_uniq = __lang.Get("uniq")
+		_every = __lang.Get("every")
+		_some = __lang.Get("some")
+		_join = __lang.Get("join")
+		_joins = __lang.Get("joins")
+		_formats = __lang.Get("formats")
+		_enum = __lang.Get("enum")
+		_log = __lang.Get("log")
+		_fatal = __lang.Get("fatal")
+		_bind = __lang.Get("bind")
+		_identity = __lang.Get("identity")
+		_eq = __lang.Get("eq")
+		_any = __lang.Get("any")
+		_function = __lang.Get("function")
+		_channel = __lang.Get("channel")
+		_natural = __lang.Get("natural")
+		_type = __lang.Get("type")
+		_listOf = __lang.Get("listOf")
+		_structOf = __lang.Get("structOf")
+		_range = __lang.Get("range")
+		_rangeMin = __lang.Get("rangeMin")
+		_listLength = __lang.Get("listLength")
+		_or = __lang.Get("or")
+		_and = __lang.Get("and")
+		_not = __lang.Get("not")
+		_predicate = __lang.Get("predicate")
+		_is = __lang.Get("is")
+		_parse = use("parse")
+		_errors = use("errors")
+		_io = use("io")
+		_paths = use("paths")
+		_lists = use("lists")
+		_structs = use("structs")
+		_codetree = use("codetree")
+		_tasks = use("tasks")
+		_strings = use("strings")
+		_code = use("code")
+		_contracts = use("contracts")
+		_signatures = use("signatures")
+		_standardPath = (&mml.List{}).Append("/usr/local/share/mml", "/usr/share/mml")
+		_stdlibRoot = "mml:"
+		direct_uses = func(_moduleCode interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _map.(*mml.Function).Call([]interface{}{mml.Ref(_structs, "get").(*mml.Function).Call([]interface{}{"value"})}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{mml.Ref(_structs, "get").(*mml.Function).Call([]interface{}{"path"})}).(*mml.Function).Call([]interface{}{mml.Ref(_codetree, "filter").(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "use"); ; return s }()})}).(*mml.Function).Call([]interface{}{_moduleCode})})})
+		}
+		_uses = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				var _isComment interface{}
-				var _astStripped interface{}
-				var _comments interface{}
-				mml.Nop(_isComment, _astStripped, _comments)
-				_isComment = _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["name"] = _or.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "line-comment", "block-comment")}).Values)
-					return s
-				}())}).Values)
-				_astStripped = func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					func() {
-						sp := _ast.(*mml.Struct)
-						for k, v := range sp.Values {
-							s.Values[k] = v
-						}
-					}()
-					s.Values["nodes"] = _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_functions, "not").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _isComment)}).Values), mml.Ref(_ast, "nodes"))}).Values)
-					return s
-				}()
-				_comments = func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["nodes"] = _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _isComment, mml.Ref(_ast, "nodes"))}).Values)
-					s.Values["indexes"] = _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-						F: func(a []interface{}) interface{} {
-							var c interface{}
-							mml.Nop(c)
-							var _i = a[0]
-							var _ interface{}
-							_ = &mml.List{a[1:]}
-							mml.Nop(_i)
-							return _isComment.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), _i))}).Values)
-						},
-						FixedArgs: 1,
-					})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_lists, "indexes").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values))}).Values)
-					return s
-				}()
-				return func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["ast"] = _astStripped
-					s.Values["comments"] = _comments
-					return s
-				}()
-				return nil
+				return direct_uses(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_create = &mml.Function{
+		exports["uses"] = _uses
+		direct_usePositions = func(_moduleCode interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _u = a[0]
+					var _p = a[1]
+					mml.Nop(_u, _p)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_p.(*mml.Struct))
+						s.Set(mml.Ref(mml.Ref(_u, "path"), "value").(string), _formats.(*mml.Function).Call([]interface{}{"%s:%d:%d", mml.Ref(mml.Ref(_u, "ast"), "file"), mml.Ref(mml.Ref(_u, "ast"), "line"), mml.Ref(mml.Ref(_u, "ast"), "column")}))
+						return s
+					}()
+				},
+				FixedArgs: 2,
+			}, func() interface{} { s := &mml.Struct{}; ; return s }()}).(*mml.Function).Call([]interface{}{mml.Ref(_codetree, "filter").(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "use"); ; return s }()})}).(*mml.Function).Call([]interface{}{_moduleCode})})
+		}
+		_usePositions = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _type = a[0]
-				var _ast = a[1]
-				var _props interface{}
-				_props = &mml.List{a[2:]}
-				mml.Nop(_type, _ast, _props)
-				return mml.Ref(_structs, "merges").(*mml.Function).Call((&mml.List{Values: append(append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["type"] = _type
-					s.Values["ast"] = _ast
-					return s
-				}()), _props.(*mml.List).Values...)}).Values)
+				return direct_usePositions(a[0])
 			},
-			FixedArgs: 2,
+			FixedArgs: 1,
 		}
-		_commentLine = &mml.Function{
+		exports["usePositions"] = _usePositions
+		direct_fileName = func(_path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _formats.(*mml.Function).Call([]interface{}{"%s.mml", _path})
+		}
+		_fileName = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "comment-line", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["text"] = mml.Ref(_ast, "text")
-					return s
-				}())}).Values)
+				return direct_fileName(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_lineComment = &mml.Function{
+		exports["fileName"] = _fileName
+		direct_interfaceFile = func(_path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _formats.(*mml.Function).Call([]interface{}{"%s.mmli", _path})
+		}
+		_interfaceFile = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "line-comment", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["lines"] = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parse, mml.Ref(_ast, "nodes"))}).Values)
-					return s
-				}())}).Values)
+				return direct_interfaceFile(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_blockCommentContent = &mml.Function{
+		exports["interfaceFile"] = _interfaceFile
+		direct_isStdlib = func(_path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return (mml.BinaryOp(15, _len.(*mml.Function).Call([]interface{}{_path}), _len.(*mml.Function).Call([]interface{}{_stdlibRoot})).(bool) && mml.BinaryOp(11, mml.RefRange(_path, nil, _len.(*mml.Function).Call([]interface{}{_stdlibRoot})), _stdlibRoot).(bool))
+		}
+		_isStdlib = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "block-comment-content", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["text"] = mml.Ref(_ast, "text")
-					return s
-				}())}).Values)
+				return direct_isStdlib(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_blockComment = &mml.Function{
+		exports["isStdlib"] = _isStdlib
+		direct_readSource = func(_path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				c = direct_isStdlib(_path)
+				if c.(bool) {
+					return _stdlibSource.(*mml.Function).Call([]interface{}{mml.RefRange(_path, mml.BinaryOp(9, _len.(*mml.Function).Call([]interface{}{_stdlibRoot}), 1), nil)})
+				} else {
+					return mml.Ref(_io, "readFile").(*mml.Function).Call([]interface{}{direct_fileName(_path)})
+				}
+			}()
+		}
+		_readSource = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "block-comment", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["content"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
-					return s
-				}())}).Values)
+				return direct_readSource(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_intCode = _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "int")}).Values)
-		_floatCode = _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "float")}).Values)
-		_stringCode = _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "string")}).Values)
-		_boolCode = _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "bool")}).Values)
-		_symbol = &mml.Function{
+		exports["readSource"] = _readSource
+		direct_searchPath = func(_mainPath interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _fromEnv interface{}
+			var _envPath interface{}
+			mml.Nop(_fromEnv, _envPath)
+			_fromEnv = _getEnv.(*mml.Function).Call([]interface{}{"MMLPATH"})
+			_envPath = func() interface{} {
+				c = _isError.(*mml.Function).Call([]interface{}{_fromEnv})
+				if c.(bool) {
+					return (&mml.List{})
+				} else {
+					return _filter.(*mml.Function).Call([]interface{}{&mml.Function{
+						F: func(a []interface{}) interface{} {
+							var c interface{}
+							mml.Nop(c)
+							var _p = a[0]
+							mml.Nop(_p)
+							return mml.BinaryOp(12, _p, "")
+						},
+						FixedArgs: 1,
+					}, mml.Ref(_strings, "split").(*mml.Function).Call([]interface{}{":", _fromEnv})})
+				}
+			}()
+			return (&mml.List{}).Append(mml.Ref(_paths, "dir").(*mml.Function).Call([]interface{}{_mainPath})).Concat(_envPath.(*mml.List)).Concat(_standardPath.(*mml.List))
+		}
+		_searchPath = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "symbol", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["name"] = mml.Ref(_ast, "text")
-					return s
-				}())}).Values)
+				return direct_searchPath(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_spread = &mml.Function{
+		exports["searchPath"] = _searchPath
+		direct_exists = func(_file interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _f interface{}
+			mml.Nop(_f)
+			_f = _open.(*mml.Function).Call([]interface{}{_file})
+			c = _isError.(*mml.Function).Call([]interface{}{_f})
+			if c.(bool) {
+				mml.Nop()
+				return false
+			}
+			_close.(*mml.Function).Call([]interface{}{_f})
+			return true
+		}
+		_exists = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "spread", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["value"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
-					return s
-				}())}).Values)
+				return direct_exists(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_list = &mml.Function{
+		direct_locate = func(_searchPath, _path, _usePath interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _trimmed interface{}
+			mml.Nop(_trimmed)
+			_trimmed = mml.Ref(_paths, "trimExtension").(*mml.Function).Call([]interface{}{_usePath})
+			c = mml.Ref(_paths, "isAbsolute").(*mml.Function).Call([]interface{}{_trimmed})
+			if c.(bool) {
+				mml.Nop()
+				return mml.Ref(_paths, "normalize").(*mml.Function).Call([]interface{}{_trimmed})
+			}
+			c = mml.Ref(_paths, "isRelative").(*mml.Function).Call([]interface{}{_trimmed})
+			if c.(bool) {
+				mml.Nop()
+				return mml.Ref(_paths, "resolve").(*mml.Function).Call([]interface{}{mml.Ref(_paths, "dir").(*mml.Function).Call([]interface{}{_path}), _trimmed})
+			}
+			if !direct_isStdlib(_path).(bool) {
+				mml.Nop()
+				for _, _dir := range _searchPath.(*mml.List).Values() {
+					var _candidate interface{}
+					mml.Nop(_candidate)
+					_candidate = mml.Ref(_paths, "resolve").(*mml.Function).Call([]interface{}{_dir, _trimmed})
+					c = direct_exists(direct_fileName(_candidate))
+					if c.(bool) {
+						mml.Nop()
+						return _candidate
+					}
+				}
+			}
+			if !_isError.(*mml.Function).Call([]interface{}{_stdlibSource.(*mml.Function).Call([]interface{}{_trimmed})}).(bool) {
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"%s/%s", _stdlibRoot, _trimmed})
+			}
+			return _error.(*mml.Function).Call([]interface{}{_formats.(*mml.Function).Call([]interface{}{"module not found: %s", _usePath})})
+		}
+		_locate = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "list", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["values"] = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parse, mml.Ref(_ast, "nodes"))}).Values)
-					s.Values["mutable"] = false
-					return s
-				}())}).Values)
+				return direct_locate(a[0], a[1], a[2])
 			},
-			FixedArgs: 1,
+			FixedArgs: 3,
 		}
-		_mutableList = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					func() {
-						sp := _list.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values).(*mml.Struct)
-						for k, v := range sp.Values {
-							s.Values[k] = v
+		exports["locate"] = _locate
+		direct_locateAll = func(_searchPath, _path, _usePaths, _positions interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _located interface{}
+			mml.Nop(_located)
+			_located = func() interface{} { s := &mml.Struct{}; ; return s }()
+			for _, _u := range _usePaths.(*mml.List).Values() {
+				var _p interface{}
+				mml.Nop(_p)
+				_p = direct_locate(_searchPath, _path, _u)
+				c = _isError.(*mml.Function).Call([]interface{}{_p})
+				if c.(bool) {
+					mml.Nop()
+					return _error.(*mml.Function).Call([]interface{}{_formats.(*mml.Function).Call([]interface{}{"%s: %v", func() interface{} {
+						c = _has.(*mml.Function).Call([]interface{}{_u, _positions})
+						if c.(bool) {
+							return mml.Ref(_positions, _u)
+						} else {
+							return direct_fileName(_path)
 						}
-					}()
-					s.Values["mutable"] = true
+					}(), _p})})
+				}
+				_located = func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(_located.(*mml.Struct))
+					s.Set(_u.(string), _p)
 					return s
 				}()
-			},
-			FixedArgs: 1,
+			}
+			return _located
 		}
-		_expressionKey = &mml.Function{
+		_locateAll = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "expression-key", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["value"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
-					return s
-				}())}).Values)
+				return direct_locateAll(a[0], a[1], a[2], a[3])
 			},
-			FixedArgs: 1,
+			FixedArgs: 4,
 		}
-		_entry = &mml.Function{
+		exports["locateAll"] = _locateAll
+		direct_locateInterface = func(_searchPath, _path, _usePath interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _trimmed interface{}
+			var _candidates interface{}
+			mml.Nop(_trimmed, _candidates)
+			_trimmed = mml.Ref(_paths, "trimExtension").(*mml.Function).Call([]interface{}{_usePath})
+			_candidates = func() interface{} {
+				if mml.Ref(_paths, "isAbsolute").(*mml.Function).Call([]interface{}{_trimmed}).(bool) || mml.Ref(_paths, "isRelative").(*mml.Function).Call([]interface{}{_trimmed}).(bool) {
+					return (&mml.List{}).Append(direct_locate(_searchPath, _path, _trimmed))
+				} else {
+					return _map.(*mml.Function).Call([]interface{}{&mml.Function{
+						F: func(a []interface{}) interface{} {
+							var c interface{}
+							mml.Nop(c)
+							var _dir = a[0]
+							mml.Nop(_dir)
+							return mml.Ref(_paths, "resolve").(*mml.Function).Call([]interface{}{_dir, _trimmed})
+						},
+						FixedArgs: 1,
+					}, _searchPath})
+				}
+			}()
+			for _, _c := range _candidates.(*mml.List).Values() {
+
+				mml.Nop()
+				c = direct_exists(direct_interfaceFile(_c))
+				if c.(bool) {
+					mml.Nop()
+					return direct_interfaceFile(_c)
+				}
+			}
+			return ""
+		}
+		_locateInterface = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "entry", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["key"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
-					s.Values["value"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 1))}).Values)
-					return s
-				}())}).Values)
+				return direct_locateInterface(a[0], a[1], a[2])
 			},
-			FixedArgs: 1,
+			FixedArgs: 3,
 		}
-		_struct = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "struct", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["entries"] = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parse, mml.Ref(_ast, "nodes"))}).Values)
-					s.Values["mutable"] = false
+		exports["locateInterface"] = _locateInterface
+		direct_readInterfaces = func(_searchPath, _path, _located interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _interfaces interface{}
+			mml.Nop(_interfaces)
+			_interfaces = func() interface{} { s := &mml.Struct{}; ; return s }()
+			for _, _u := range _keys.(*mml.Function).Call([]interface{}{_located}).(*mml.List).Values() {
+				var _file interface{}
+				var _interfaceCode interface{}
+				mml.Nop(_file, _interfaceCode)
+				_file = direct_locateInterface(_searchPath, _path, _u)
+				c = mml.BinaryOp(11, _file, "")
+				if c.(bool) {
+					mml.Nop()
+					continue
+				}
+				_interfaceCode = mml.Ref(_errors, "pass").(*mml.Function).Call([]interface{}{mml.Ref(_parse, "do").(*mml.Function).Call([]interface{}{_file})}).(*mml.Function).Call([]interface{}{mml.Ref(_io, "readFile").(*mml.Function).Call([]interface{}{_file})})
+				if v := _interfaceCode; mml.IsError.F([]interface{}{v}).(bool) {
+					return v
+				}
+				_interfaces = func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(_interfaces.(*mml.Struct))
+					s.Set(mml.Ref(_located, _u).(string), func() interface{} {
+						s := &mml.Struct{}
+						s.Set("file", _file)
+						s.Set("code", _interfaceCode)
+						return s
+					}())
 					return s
-				}())}).Values)
-			},
-			FixedArgs: 1,
+				}()
+			}
+			return _interfaces
 		}
-		_mutableStruct = &mml.Function{
+		_readInterfaces = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					func() {
-						sp := _struct.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values).(*mml.Struct)
-						for k, v := range sp.Values {
-							s.Values[k] = v
+				return direct_readInterfaces(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		exports["readInterfaces"] = _readInterfaces
+		direct_setPaths = func(_located, _moduleCode interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return mml.Ref(_codetree, "edit").(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _c = a[0]
+					mml.Nop(_c)
+					return func() interface{} {
+						c = _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "use"); ; return s }(), _c})
+						if c.(bool) {
+							return func() interface{} {
+								s := &mml.Struct{}
+								s.Merge(_c.(*mml.Struct))
+								s.Set("path", func() interface{} {
+									s := &mml.Struct{}
+									s.Merge(mml.Ref(_c, "path").(*mml.Struct))
+									s.Set("value", mml.Ref(_located, mml.Ref(mml.Ref(_c, "path"), "value")))
+									return s
+								}())
+								return s
+							}()
+						} else {
+							return _c
 						}
 					}()
-					s.Values["mutable"] = true
-					return s
-				}()
-			},
-			FixedArgs: 1,
+				},
+				FixedArgs: 1,
+			}, _moduleCode})
 		}
-		_ret = &mml.Function{
+		_setPaths = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "ret", _ast, func() interface{} {
-					c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values), 0)
-					if c.(bool) {
-						return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; ; return s }()
-					} else {
+				return direct_setPaths(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		exports["setPaths"] = _setPaths
+		direct_loadAll = func(_load, _modulePaths interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _loaded interface{}
+			var _next interface{}
+			mml.Nop(_loaded, _next)
+			_loaded = func() interface{} { s := &mml.Struct{}; ; return s }()
+			_next = _modulePaths
+			for mml.BinaryOp(15, _len.(*mml.Function).Call([]interface{}{_next}), 0).(bool) {
+				var _results interface{}
+				mml.Nop(_results)
+				_results = mml.Ref(_tasks, "map").(*mml.Function).Call([]interface{}{_load, _next})
+				if v := _results; mml.IsError.F([]interface{}{v}).(bool) {
+					return v
+				}
+				_loaded = _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _i = a[0]
+						var _l = a[1]
+						mml.Nop(_i, _l)
 						return func() interface{} {
-							s := &mml.Struct{Values: make(map[string]interface{})}
-							s.Values["value"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
+							s := &mml.Struct{}
+							s.Merge(_l.(*mml.Struct))
+							s.Set(mml.Ref(_next, _i).(string), mml.Ref(_results, _i))
 							return s
 						}()
-					}
-				}())}).Values)
-			},
-			FixedArgs: 1,
-		}
-		_checkRet = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "check-ret", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["value"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
-					return s
-				}())}).Values)
-			},
-			FixedArgs: 1,
+					},
+					FixedArgs: 2,
+				}, _loaded, mml.Ref(_lists, "indexes").(*mml.Function).Call([]interface{}{_next})})
+				_next = _filter.(*mml.Function).Call([]interface{}{&mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _p = a[0]
+						mml.Nop(_p)
+						return !_has.(*mml.Function).Call([]interface{}{_p, _loaded}).(bool)
+					},
+					FixedArgs: 1,
+				}}).(*mml.Function).Call([]interface{}{_uniq.(*mml.Function).Call([]interface{}{&mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _left = a[0]
+						var _right = a[1]
+						mml.Nop(_left, _right)
+						return mml.BinaryOp(11, _left, _right)
+					},
+					FixedArgs: 2,
+				}}).(*mml.Function).Call([]interface{}{_flat.(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{mml.Ref(_structs, "get").(*mml.Function).Call([]interface{}{"uses"})}).(*mml.Function).Call([]interface{}{_results})})})})
+			}
+			return _loaded
 		}
-		_statementListOf = &mml.Function{
+		_loadAll = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _nodes = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_ast, _nodes)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "statement-list", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["statements"] = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parse, _nodes)}).Values)
-					return s
-				}())}).Values)
+				return direct_loadAll(a[0], a[1])
 			},
 			FixedArgs: 2,
 		}
-		_statementList = &mml.Function{
+		exports["loadAll"] = _loadAll
+		direct_usePosition = func(_loaded, _from, _to interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				c = _has.(*mml.Function).Call([]interface{}{_to, mml.Ref(mml.Ref(_loaded, _from), "positions")})
+				if c.(bool) {
+					return mml.Ref(mml.Ref(mml.Ref(_loaded, _from), "positions"), _to)
+				} else {
+					return direct_fileName(_from)
+				}
+			}()
+		}
+		_usePosition = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _statementListOf.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast, mml.Ref(_ast, "nodes"))}).Values)
+				return direct_usePosition(a[0], a[1], a[2])
 			},
-			FixedArgs: 1,
+			FixedArgs: 3,
 		}
-		_collectParameter = &mml.Function{
+		direct_cycleError = func(_loaded, _cycle interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _steps interface{}
+			mml.Nop(_steps)
+			_steps = _map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _i = a[0]
+					mml.Nop(_i)
+					return _formats.(*mml.Function).Call([]interface{}{"\n\t%s: %s uses %s", direct_usePosition(_loaded, mml.Ref(_cycle, _i), mml.Ref(_cycle, mml.BinaryOp(9, _i, 1))), mml.Ref(_cycle, _i), mml.Ref(_cycle, mml.BinaryOp(9, _i, 1))})
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{mml.Ref(_lists, "indexes").(*mml.Function).Call([]interface{}{mml.RefRange(_cycle, 1, nil)})})
+			return _error.(*mml.Function).Call([]interface{}{_formats.(*mml.Function).Call([]interface{}{"circular module reference: %s%s", _join.(*mml.Function).Call([]interface{}{" -> ", _cycle}), _join.(*mml.Function).Call([]interface{}{"", _steps})})})
+		}
+		_cycleError = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
+				return direct_cycleError(a[0], a[1])
 			},
-			FixedArgs: 1,
+			FixedArgs: 2,
 		}
-		_functionFact = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _offset = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_ast, _offset)
-				var _nodes interface{}
-				var _last interface{}
-				var _params interface{}
-				var _lastParam interface{}
-				var _hasCollectParam interface{}
-				var _fixedParams interface{}
-				mml.Nop(_nodes, _last, _params, _lastParam, _hasCollectParam, _fixedParams)
-				_nodes = mml.RefRange(mml.Ref(_ast, "nodes"), _offset, nil)
-				_last = mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _nodes)}).Values), 1)
-				_params = mml.RefRange(_nodes, nil, _last)
-				_lastParam = mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _params)}).Values), 1)
-				_hasCollectParam = (mml.BinaryOp(16, _lastParam, 0).(bool) && mml.BinaryOp(11, mml.Ref(mml.Ref(_params, _lastParam), "name"), "collect-parameter").(bool))
-				_fixedParams = func() interface{} {
-					c = _hasCollectParam
+		direct_checkCycles = func(_loaded, _path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _checked interface{}
+			var _visit interface{}
+			mml.Nop(_checked, _visit)
+			_checked = func() interface{} { s := &mml.Struct{}; ; return s }()
+			_visit = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _chain = a[0]
+					var _path = a[1]
+					mml.Nop(_chain, _path)
+					var _found interface{}
+					mml.Nop(_found)
+					_found = _filter.(*mml.Function).Call([]interface{}{&mml.Function{
+						F: func(a []interface{}) interface{} {
+							var c interface{}
+							mml.Nop(c)
+							var _i = a[0]
+							mml.Nop(_i)
+							return mml.BinaryOp(11, mml.Ref(_chain, _i), _path)
+						},
+						FixedArgs: 1,
+					}, mml.Ref(_lists, "indexes").(*mml.Function).Call([]interface{}{_chain})})
+					c = mml.BinaryOp(15, _len.(*mml.Function).Call([]interface{}{_found}), 0)
 					if c.(bool) {
-						return mml.RefRange(_params, nil, _lastParam)
-					} else {
-						return _params
+						mml.Nop()
+						return direct_cycleError(_loaded, (&mml.List{}).Concat(mml.RefRange(_chain, mml.Ref(_found, 0), nil).(*mml.List)).Append(_path))
 					}
-				}()
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "function", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["params"] = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_structs, "get").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "name")}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parse)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _fixedParams)}).Values))}).Values)
-					s.Values["collectParam"] = func() interface{} {
-						c = _hasCollectParam
-						if c.(bool) {
-							return mml.Ref(_parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_params, _lastParam))}).Values), "name")
-						} else {
-							return ""
+					c = _has.(*mml.Function).Call([]interface{}{_path, _checked})
+					if c.(bool) {
+						mml.Nop()
+						return true
+					}
+					for _, _u := range mml.Ref(mml.Ref(_loaded, _path), "uses").(*mml.List).Values() {
+						var _result interface{}
+						mml.Nop(_result)
+						_result = _visit.(*mml.Function).Call([]interface{}{(&mml.List{}).Concat(_chain.(*mml.List)).Append(_path), _u})
+						if v := _result; mml.IsError.F([]interface{}{v}).(bool) {
+							return v
 						}
-					}()
-					s.Values["body"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_nodes, _last))}).Values)
-					s.Values["effect"] = false
-					return s
-				}())}).Values)
-				return nil
+					}
+					mml.SetRef(_checked, _path, true)
+					return true
+				},
+				FixedArgs: 2,
+			}
+			return _visit.(*mml.Function).Call([]interface{}{(&mml.List{}), _path})
+		}
+		_checkCycles = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_checkCycles(a[0], a[1])
 			},
 			FixedArgs: 2,
 		}
-		_functionLiteral = &mml.Function{
+		exports["checkCycles"] = _checkCycles
+		direct_verifyInterfaces = func(_loaded, _implementation interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _failed interface{}
+			mml.Nop(_failed)
+			_failed = (&mml.List{})
+			for _, _path := range _sort.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _left = a[0]
+					var _right = a[1]
+					mml.Nop(_left, _right)
+					return mml.BinaryOp(13, _left, _right)
+				},
+				FixedArgs: 2,
+			}, _keys.(*mml.Function).Call([]interface{}{_loaded})}).(*mml.List).Values() {
+				var _interfaces interface{}
+				mml.Nop(_interfaces)
+				_interfaces = mml.Ref(mml.Ref(_loaded, _path), "interfaces")
+				for _, _used := range _sort.(*mml.Function).Call([]interface{}{&mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _left = a[0]
+						var _right = a[1]
+						mml.Nop(_left, _right)
+						return mml.BinaryOp(13, _left, _right)
+					},
+					FixedArgs: 2,
+				}, _keys.(*mml.Function).Call([]interface{}{_interfaces})}).(*mml.List).Values() {
+					var _i interface{}
+					var _verified interface{}
+					mml.Nop(_i, _verified)
+					_i = _implementation.(*mml.Function).Call([]interface{}{_used})
+					if v := _i; mml.IsError.F([]interface{}{v}).(bool) {
+						return v
+					}
+					_verified = mml.Ref(_contracts, "verify").(*mml.Function).Call([]interface{}{_formats.(*mml.Function).Call([]interface{}{"%s: %s", direct_usePosition(_loaded, _path, _used), _used}), mml.Ref(mml.Ref(_interfaces, _used), "file"), mml.Ref(mml.Ref(_interfaces, _used), "code"), _i})
+					c = _isError.(*mml.Function).Call([]interface{}{_verified})
+					if c.(bool) {
+						mml.Nop()
+						_failed = (&mml.List{}).Concat(_failed.(*mml.List)).Append(_verified)
+					}
+				}
+			}
+			return func() interface{} {
+				c = mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_failed}), 0)
+				if c.(bool) {
+					return true
+				} else {
+					return _error.(*mml.Function).Call([]interface{}{_join.(*mml.Function).Call([]interface{}{"\n", _map.(*mml.Function).Call([]interface{}{&mml.Function{
+						F: func(a []interface{}) interface{} {
+							var c interface{}
+							mml.Nop(c)
+							var _e = a[0]
+							mml.Nop(_e)
+							return _formats.(*mml.Function).Call([]interface{}{"%v", _e})
+						},
+						FixedArgs: 1,
+					}, _failed})})})
+				}
+			}()
+		}
+		_verifyInterfaces = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _functionFact.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast, 0)}).Values)
+				return direct_verifyInterfaces(a[0], a[1])
 			},
-			FixedArgs: 1,
+			FixedArgs: 2,
 		}
-		_effect = &mml.Function{
+		exports["verifyInterfaces"] = _verifyInterfaces
+		direct_parseModule = func(_searchPath, _path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _file interface{}
+			var _moduleCode interface{}
+			var _located interface{}
+			var _interfaces interface{}
+			var _withPaths interface{}
+			mml.Nop(_file, _moduleCode, _located, _interfaces, _withPaths)
+			_file = direct_fileName(_path)
+			_moduleCode = mml.Ref(_errors, "pass").(*mml.Function).Call([]interface{}{mml.Ref(_parse, "do").(*mml.Function).Call([]interface{}{_file})}).(*mml.Function).Call([]interface{}{direct_readSource(_path)})
+			if v := _moduleCode; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_located = direct_locateAll(_searchPath, _path, direct_uses(_moduleCode), direct_usePositions(_moduleCode))
+			if v := _located; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_interfaces = direct_readInterfaces(_searchPath, _path, _located)
+			if v := _interfaces; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_withPaths = direct_setPaths(_located, _moduleCode)
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Set("code", _withPaths)
+				s.Set("uses", direct_uses(_withPaths))
+				s.Set("positions", direct_usePositions(_withPaths))
+				s.Set("interfaces", _interfaces)
+				return s
+			}()
+		}
+		_parseModule = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					func() {
-						sp := _functionFact.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast, 0)}).Values).(*mml.Struct)
-						for k, v := range sp.Values {
-							s.Values[k] = v
-						}
-					}()
-					s.Values["effect"] = true
-					return s
-				}()
+				return direct_parseModule(a[0], a[1])
 			},
-			FixedArgs: 1,
+			FixedArgs: 2,
 		}
-		_rangeExpression = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "range", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values[func() interface{} {
-						c = mml.BinaryOp(11, mml.Ref(_ast, "name"), "range-from")
+		direct_reexports = func(_moduleCode interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _u = a[0]
+					mml.Nop(_u)
+					return func() interface{} {
+						c = _has.(*mml.Function).Call([]interface{}{"selection", _u})
 						if c.(bool) {
-							return "from"
+							return func() interface{} {
+								s := &mml.Struct{}
+								s.Set("path", mml.Ref(mml.Ref(_u, "path"), "value"))
+								s.Set("selection", mml.Ref(_u, "selection"))
+								return s
+							}()
 						} else {
-							return "to"
+							return func() interface{} {
+								s := &mml.Struct{}
+								s.Set("path", mml.Ref(mml.Ref(_u, "path"), "value"))
+								return s
+							}()
 						}
-					}().(string)] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
-					return s
-				}())}).Values)
-			},
-			FixedArgs: 1,
+					}()
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{mml.Ref(_codetree, "filter").(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "use"); s.Set("exported", true); ; return s }()})}).(*mml.Function).Call([]interface{}{_moduleCode})})
 		}
-		_symbolIndex = &mml.Function{
+		_reexports = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "symbol-index", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["symbol"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
-					return s
-				}())}).Values)
+				return direct_reexports(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_expressionIndex = &mml.Function{
+		exports["reexports"] = _reexports
+		direct_reexportedUse = func(_u interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				c = _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("capture", "."); ; return s }(), _u})
+				if c.(bool) {
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_u.(*mml.Struct))
+						s.Set("capture", mml.Ref(_code, "getModuleName").(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_u, "path"), "value")}))
+						return s
+					}()
+				} else {
+					return _u
+				}
+			}()
+		}
+		_reexportedUse = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
+				return direct_reexportedUse(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_rangeIndex = &mml.Function{
+		direct_exportedNames = func(_m interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _map.(*mml.Function).Call([]interface{}{mml.Ref(_structs, "get").(*mml.Function).Call([]interface{}{"symbol"})}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("exported", true); ; return s }()})}).(*mml.Function).Call([]interface{}{mml.Ref(_code, "getDefinitions").(*mml.Function).Call([]interface{}{mml.Ref(_m, "body")})})})
+		}
+		_exportedNames = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _create.(*mml.Function).Call((&mml.List{Values: append(append([]interface{}{}, "range", _ast), _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parse, mml.Ref(_ast, "nodes"))}).Values).(*mml.List).Values...)}).Values)
+				return direct_exportedNames(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_indexer = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				var _indexerNodes interface{}
-				mml.Nop(_indexerNodes)
-				_indexerNodes = &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _nodes = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_nodes)
-						return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "indexer", _ast, func() interface{} {
-							s := &mml.Struct{Values: make(map[string]interface{})}
-							s.Values["expression"] = func() interface{} {
-								c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _nodes)}).Values), 2)
-								if c.(bool) {
-									return _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_nodes, 0))}).Values)
-								} else {
-									return _indexerNodes.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.RefRange(_nodes, nil, mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _nodes)}).Values), 1)))}).Values)
-								}
-							}()
-							s.Values["index"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_nodes, mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _nodes)}).Values), 1)))}).Values)
-							return s
-						}())}).Values)
-					},
-					FixedArgs: 1,
+		direct_checkReexports = func(_moduleCode interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _missing interface{}
+			var _missingNames interface{}
+			mml.Nop(_missing, _missingNames)
+			_missing = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _u = a[0]
+					mml.Nop(_u)
+					return _map.(*mml.Function).Call([]interface{}{&mml.Function{
+						F: func(a []interface{}) interface{} {
+							var c interface{}
+							mml.Nop(c)
+							var _name = a[0]
+							mml.Nop(_name)
+							return _formats.(*mml.Function).Call([]interface{}{"%s:%d:%d: not exported by %s: %s", mml.Ref(mml.Ref(_u, "ast"), "file"), mml.Ref(mml.Ref(_u, "ast"), "line"), mml.Ref(mml.Ref(_u, "ast"), "column"), mml.Ref(mml.Ref(_u, "path"), "value"), _name})
+						},
+						FixedArgs: 1,
+					}}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{&mml.Function{
+						F: func(a []interface{}) interface{} {
+							var c interface{}
+							mml.Nop(c)
+							var _name = a[0]
+							mml.Nop(_name)
+							return !_contains.(*mml.Function).Call([]interface{}{_name, direct_exportedNames(mml.Ref(_u, "module"))}).(bool)
+						},
+						FixedArgs: 1,
+					}}).(*mml.Function).Call([]interface{}{mml.Ref(_u, "selection")})})
+				},
+				FixedArgs: 1,
+			}
+			_missingNames = _flat.(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_missing}).(*mml.Function).Call([]interface{}{mml.Ref(_codetree, "filter").(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("type", "use")
+				s.Set("exported", true)
+				s.Set("selection", _any)
+				return s
+			}()})}).(*mml.Function).Call([]interface{}{_moduleCode})})})
+			return func() interface{} {
+				c = mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_missingNames}), 0)
+				if c.(bool) {
+					return _moduleCode
+				} else {
+					return _error.(*mml.Function).Call([]interface{}{_join.(*mml.Function).Call([]interface{}{"\n", _missingNames})})
 				}
-				return _indexerNodes.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values)
-				return nil
-			},
-			FixedArgs: 1,
-		}
-		_application = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "application", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["function"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
-					s.Values["args"] = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parse, mml.RefRange(mml.Ref(_ast, "nodes"), 1, nil))}).Values)
-					return s
-				}())}).Values)
-			},
-			FixedArgs: 1,
+			}()
 		}
-		_unary = &mml.Function{
+		_checkReexports = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				var _ops interface{}
-				mml.Nop(_ops)
-				_ops = func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["binary-not"] = mml.Ref(_code, "binaryNot")
-					s.Values["plus"] = mml.Ref(_code, "plus")
-					s.Values["minus"] = mml.Ref(_code, "minus")
-					s.Values["logical-not"] = mml.Ref(_code, "logicalNot")
-					return s
-				}()
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "unary", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["op"] = mml.Ref(_ops, mml.Ref(mml.Ref(mml.Ref(_ast, "nodes"), 0), "name"))
-					s.Values["arg"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 1))}).Values)
-					return s
-				}())}).Values)
-				return nil
+				return direct_checkReexports(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_binary = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				var _ops interface{}
-				mml.Nop(_ops)
-				_ops = func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["binary-and"] = mml.Ref(_code, "binaryAnd")
-					s.Values["xor"] = mml.Ref(_code, "xor")
-					s.Values["and-not"] = mml.Ref(_code, "andNot")
-					s.Values["lshift"] = mml.Ref(_code, "lshift")
-					s.Values["rshift"] = mml.Ref(_code, "rshift")
-					s.Values["mul"] = mml.Ref(_code, "mul")
-					s.Values["div"] = mml.Ref(_code, "div")
-					s.Values["mod"] = mml.Ref(_code, "mod")
-					s.Values["add"] = mml.Ref(_code, "add")
-					s.Values["sub"] = mml.Ref(_code, "sub")
-					s.Values["eq"] = mml.Ref(_code, "equals")
-					s.Values["not-eq"] = mml.Ref(_code, "notEq")
-					s.Values["less"] = mml.Ref(_code, "less")
-					s.Values["less-or-eq"] = mml.Ref(_code, "lessOrEq")
-					s.Values["greater"] = mml.Ref(_code, "greater")
-					s.Values["greater-or-eq"] = mml.Ref(_code, "greaterOrEq")
-					s.Values["logical-and"] = mml.Ref(_code, "logicalAnd")
-					s.Values["logical-or"] = mml.Ref(_code, "logicalOr")
-					return s
-				}()
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "binary", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["op"] = mml.Ref(_ops, mml.Ref(mml.Ref(mml.Ref(_ast, "nodes"), mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values), 2)), "name"))
-					s.Values["left"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-						c = mml.BinaryOp(15, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values), 3)
-						if c.(bool) {
-							return func() interface{} {
-								s := &mml.Struct{Values: make(map[string]interface{})}
-								func() {
-									sp := _ast.(*mml.Struct)
-									for k, v := range sp.Values {
-										s.Values[k] = v
-									}
-								}()
-								s.Values["nodes"] = mml.RefRange(mml.Ref(_ast, "nodes"), nil, mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values), 2))
+		exports["checkReexports"] = _checkReexports
+		direct_reexportDefinitions = func(_u interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _names interface{}
+			var _moduleSymbol interface{}
+			var _symbol interface{}
+			var _definition interface{}
+			mml.Nop(_names, _moduleSymbol, _symbol, _definition)
+			_names = func() interface{} {
+				c = _has.(*mml.Function).Call([]interface{}{"selection", _u})
+				if c.(bool) {
+					return mml.Ref(_u, "selection")
+				} else {
+					return direct_exportedNames(mml.Ref(_u, "module"))
+				}
+			}()
+			_moduleSymbol = func() interface{} {
+				c = _has.(*mml.Function).Call([]interface{}{"capture", _u})
+				if c.(bool) {
+					return mml.Ref(_u, "capture")
+				} else {
+					return mml.Ref(_code, "getModuleName").(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_u, "path"), "value")})
+				}
+			}()
+			_symbol = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _name = a[0]
+					mml.Nop(_name)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Set("type", "symbol")
+						s.Set("ast", mml.Ref(_u, "ast"))
+						s.Set("name", _name)
+						return s
+					}()
+				},
+				FixedArgs: 1,
+			}
+			_definition = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _name = a[0]
+					mml.Nop(_name)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Set("type", "definition")
+						s.Set("ast", mml.Ref(_u, "ast"))
+						s.Set("symbol", _name)
+						s.Set("exported", true)
+						s.Set("mutable", false)
+						s.Set("expression", func() interface{} {
+							s := &mml.Struct{}
+							s.Set("type", "indexer")
+							s.Set("ast", mml.Ref(_u, "ast"))
+							s.Set("expression", _symbol.(*mml.Function).Call([]interface{}{_moduleSymbol}))
+							s.Set("index", func() interface{} {
+								s := &mml.Struct{}
+								s.Set("type", "symbol-index")
+								s.Set("ast", mml.Ref(_u, "ast"))
+								s.Set("symbol", _symbol.(*mml.Function).Call([]interface{}{_name}))
 								return s
-							}()
-						} else {
-							return mml.Ref(mml.Ref(_ast, "nodes"), 0)
-						}
-					}())}).Values)
-					s.Values["right"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values), 1)))}).Values)
-					return s
-				}())}).Values)
-				return nil
-			},
-			FixedArgs: 1,
-		}
-		_chaining = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _fold.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _f = a[0]
-						var _a = a[1]
-						var _ interface{}
-						_ = &mml.List{a[2:]}
-						mml.Nop(_f, _a)
-						return func() interface{} {
-							s := &mml.Struct{Values: make(map[string]interface{})}
-							func() {
-								sp := _ast.(*mml.Struct)
-								for k, v := range sp.Values {
-									s.Values[k] = v
-								}
-							}()
-							s.Values["name"] = "application"
-							s.Values["nodes"] = &mml.List{Values: append([]interface{}{}, _f, _a)}
+							}())
 							return s
-						}()
-					},
-					FixedArgs: 2,
-				}, mml.Ref(mml.Ref(_ast, "nodes"), 0), mml.RefRange(mml.Ref(_ast, "nodes"), 1, nil))}).Values))}).Values)
-			},
-			FixedArgs: 1,
-		}
-		_ternary = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "cond", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["condition"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
-					s.Values["consequent"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 1))}).Values)
-					s.Values["alternative"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 2))}).Values)
-					s.Values["ternary"] = true
-					return s
-				}())}).Values)
-			},
-			FixedArgs: 1,
-		}
-		_ifStatement = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				var _constructCond interface{}
-				mml.Nop(_constructCond)
-				_constructCond = &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _cond = a[0]
-						var _cons = a[1]
-						var _alt = a[2]
-						var _ interface{}
-						_ = &mml.List{a[3:]}
-						mml.Nop(_cond, _cons, _alt)
-						return func() interface{} {
-							c = mml.BinaryOp(11, _alt, false)
-							if c.(bool) {
-								return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "cond", _ast, func() interface{} {
-									s := &mml.Struct{Values: make(map[string]interface{})}
-									s.Values["condition"] = _cond
-									s.Values["consequent"] = _cons
-									s.Values["ternary"] = false
-									return s
-								}())}).Values)
-							} else {
-								return func() interface{} {
-									s := &mml.Struct{Values: make(map[string]interface{})}
-									func() {
-										sp := _constructCond.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _cond, _cons, false)}).Values).(*mml.Struct)
-										for k, v := range sp.Values {
-											s.Values[k] = v
-										}
-									}()
-									s.Values["alternative"] = _alt
-									return s
-								}()
-							}
-						}()
-					},
-					FixedArgs: 3,
-				}
-				return _foldr.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _g = a[0]
-						var _i = a[1]
-						var _ interface{}
-						_ = &mml.List{a[2:]}
-						mml.Nop(_g, _i)
-						return func() interface{} {
-							c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _g)}).Values), 1)
-							if c.(bool) {
-								return mml.Ref(_g, 0)
-							} else {
-								return _constructCond.(*mml.Function).Call((&mml.List{Values: append(append([]interface{}{}, _g.(*mml.List).Values...), _i)}).Values)
-							}
-						}()
-					},
-					FixedArgs: 2,
-				}, false)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_lists, "group").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, 2)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parse)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values))}).Values))}).Values)
-				return nil
-			},
-			FixedArgs: 1,
-		}
-		_parseCase = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _name = a[0]
-				var _ast = a[1]
-				var _c = a[2]
-				var _ interface{}
-				_ = &mml.List{a[3:]}
-				mml.Nop(_name, _ast, _c)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _name, _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["expression"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_c, "nodes"), 0))}).Values)
-					s.Values["body"] = _statementListOf.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast, mml.RefRange(mml.Ref(_c, "nodes"), 1, nil))}).Values)
-					return s
-				}())}).Values)
-			},
-			FixedArgs: 3,
+						}())
+						return s
+					}()
+				},
+				FixedArgs: 1,
+			}
+			return _map.(*mml.Function).Call([]interface{}{_definition, _names})
 		}
-		_defaultStatements = &mml.Function{
+		_reexportDefinitions = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _statementListOf.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _flat.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_structs, "get").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "nodes")}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["name"] = "default-block"
-					return s
-				}())}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values))}).Values))}).Values))}).Values)
+				return direct_reexportDefinitions(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_switchStatement = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				var _hasExpression interface{}
-				var _cases interface{}
-				var _expression interface{}
-				var _defaults interface{}
-				mml.Nop(_hasExpression, _cases, _expression, _defaults)
-				_hasExpression = (mml.BinaryOp(15, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values), 0).(bool) && !_is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["name"] = _or.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "case-block", "default-block")}).Values)
-					return s
-				}(), mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values).(bool))
-				_expression = func() interface{} {
-					c = _hasExpression
+		direct_expandReexports = func(_moduleCode interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _expand interface{}
+			mml.Nop(_expand)
+			_expand = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _s = a[0]
+					mml.Nop(_s)
+					var _exported interface{}
+					mml.Nop(_exported)
+					if !_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "use-list"); ; return s }(), _s}).(bool) {
+						mml.Nop()
+						return (&mml.List{}).Append(_s)
+					}
+					_exported = _filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("exported", true); ; return s }()})}).(*mml.Function).Call([]interface{}{mml.Ref(_s, "uses")})
+					c = mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_exported}), 0)
 					if c.(bool) {
-						return func() interface{} {
-							s := &mml.Struct{Values: make(map[string]interface{})}
-							s.Values["expression"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
-							return s
-						}()
-					} else {
-						return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; ; return s }()
+						mml.Nop()
+						return (&mml.List{}).Append(_s)
 					}
-				}()
-				_defaults = _defaultStatements.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-				_cases = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parseCase.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "switch-case", _ast)}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["name"] = "case-block"
-					return s
-				}())}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values))}).Values)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "switch-statement", _ast, _expression, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["cases"] = _cases
-					return s
-				}(), func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["defaultStatements"] = _defaults
+					return (&mml.List{}).Append(func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_s.(*mml.Struct))
+						s.Set("uses", _map.(*mml.Function).Call([]interface{}{&mml.Function{
+							F: func(a []interface{}) interface{} {
+								var c interface{}
+								mml.Nop(c)
+								var _u = a[0]
+								mml.Nop(_u)
+								return func() interface{} {
+									c = _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("exported", true); ; return s }(), _u})
+									if c.(bool) {
+										return direct_reexportedUse(_u)
+									} else {
+										return _u
+									}
+								}()
+							},
+							FixedArgs: 1,
+						}, mml.Ref(_s, "uses")}))
+						return s
+					}(), func() interface{} {
+						s := &mml.Struct{}
+						s.Set("type", "definition-group")
+						s.Set("ast", mml.Ref(_s, "ast"))
+						s.Set("definitions", _flat.(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_reexportDefinitions}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_reexportedUse}).(*mml.Function).Call([]interface{}{_exported})})}))
+						return s
+					}())
+				},
+				FixedArgs: 1,
+			}
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Merge(_moduleCode.(*mml.Struct))
+				s.Set("body", func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(mml.Ref(_moduleCode, "body").(*mml.Struct))
+					s.Set("statements", _flat.(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_expand}).(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_moduleCode, "body"), "statements")})}))
 					return s
-				}())}).Values)
-				return nil
-			},
-			FixedArgs: 1,
+				}())
+				return s
+			}()
 		}
-		_sendStatement = &mml.Function{
+		_expandReexports = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "send-statement", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["channel"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
-					s.Values["value"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 1))}).Values)
-					return s
-				}())}).Values)
+				return direct_expandReexports(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_receiveExpression = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "receive-expression", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["channel"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
+		exports["expandReexports"] = _expandReexports
+		direct_link = func(_parsed, _modules, _path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _nextModules interface{}
+			var _setUsedModule interface{}
+			var _withUsedModules interface{}
+			mml.Nop(_nextModules, _setUsedModule, _withUsedModules)
+			c = _has.(*mml.Function).Call([]interface{}{_path, _modules})
+			if c.(bool) {
+				mml.Nop()
+				return _modules
+			}
+			_nextModules = _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _path = a[0]
+					var _modules = a[1]
+					mml.Nop(_path, _modules)
+					return direct_link(_parsed, _modules, _path)
+				},
+				FixedArgs: 2,
+			}, _modules, mml.Ref(mml.Ref(_parsed, _path), "uses")})
+			_setUsedModule = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _code = a[0]
+					mml.Nop(_code)
+					return func() interface{} {
+						c = _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "use"); ; return s }(), _code})
+						if c.(bool) {
+							return func() interface{} {
+								s := &mml.Struct{}
+								s.Merge(_code.(*mml.Struct))
+								s.Set("module", mml.Ref(_nextModules, mml.Ref(mml.Ref(_code, "path"), "value")))
+								return s
+							}()
+						} else {
+							return _code
+						}
+					}()
+				},
+				FixedArgs: 1,
+			}
+			_withUsedModules = mml.Ref(_signatures, "replaceUse").(*mml.Function).Call([]interface{}{direct_expandReexports(mml.Ref(_codetree, "edit").(*mml.Function).Call([]interface{}{_setUsedModule}).(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_parsed, _path), "code")}))})
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Merge(_nextModules.(*mml.Struct))
+				s.Set(_path.(string), func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(_withUsedModules.(*mml.Struct))
+					s.Set("path", _path)
 					return s
-				}())}).Values)
-			},
-			FixedArgs: 1,
+				}())
+				return s
+			}()
 		}
-		_selectStatement = &mml.Function{
+		_link = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				var _cases interface{}
-				var _hasDefault interface{}
-				var _defaults interface{}
-				mml.Nop(_cases, _hasDefault, _defaults)
-				_hasDefault = _some.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["name"] = "default-block"
-					return s
-				}())}).Values), mml.Ref(_ast, "nodes"))}).Values)
-				_defaults = func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["hasDefault"] = _hasDefault
-					s.Values["defaultStatements"] = _defaultStatements.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-					return s
-				}()
-				_cases = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parseCase.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "select-case", _ast)}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["name"] = "select-case-block"
-					return s
-				}())}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values))}).Values)
-				return _create.(*mml.Function).Call((&mml.List{Values: append(append(append([]interface{}{}, "select-statement", _ast), _cases.(*mml.List).Values...), _defaults)}).Values)
-				return nil
+				return direct_link(a[0], a[1], a[2])
 			},
-			FixedArgs: 1,
+			FixedArgs: 3,
 		}
-		_goStatement = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "go-statement", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["application"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
-					return s
-				}())}).Values)
-			},
-			FixedArgs: 1,
+		direct_do = func(_path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _modulePath interface{}
+			var _parsed interface{}
+			var _noCycles interface{}
+			var _linked interface{}
+			var _verified interface{}
+			mml.Nop(_modulePath, _parsed, _noCycles, _linked, _verified)
+			_modulePath = mml.Ref(_errors, "pass").(*mml.Function).Call([]interface{}{mml.Ref(_paths, "normalize"), mml.Ref(_paths, "trimExtension")}).(*mml.Function).Call([]interface{}{_path})
+			if v := _modulePath; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_parsed = direct_loadAll(_parseModule.(*mml.Function).Call([]interface{}{direct_searchPath(_modulePath)}), (&mml.List{}).Append(_modulePath))
+			if v := _parsed; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_noCycles = direct_checkCycles(_parsed, _modulePath)
+			if v := _noCycles; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_linked = direct_link(_parsed, func() interface{} { s := &mml.Struct{}; ; return s }(), _modulePath)
+			for _, _p := range _keys.(*mml.Function).Call([]interface{}{_linked}).(*mml.List).Values() {
+				var _reexported interface{}
+				mml.Nop(_reexported)
+				_reexported = direct_checkReexports(mml.Ref(_linked, _p))
+				if v := _reexported; mml.IsError.F([]interface{}{v}).(bool) {
+					return v
+				}
+			}
+			_verified = direct_verifyInterfaces(_parsed, &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _p = a[0]
+					mml.Nop(_p)
+					return mml.Ref(_contracts, "implementation").(*mml.Function).Call([]interface{}{mml.Ref(_linked, _p)})
+				},
+				FixedArgs: 1,
+			})
+			if v := _verified; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			return mml.Ref(_linked, _modulePath)
 		}
-		_deferStatement = &mml.Function{
+		_do = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "defer-statement", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["application"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
-					return s
-				}())}).Values)
+				return direct_do(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_rangeOver = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				var _createRangeOver interface{}
-				var _parseExpression interface{}
-				mml.Nop(_createRangeOver, _parseExpression)
-				_createRangeOver = &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _props interface{}
-						_props = &mml.List{a[0:]}
-						mml.Nop(_props)
-						return _create.(*mml.Function).Call((&mml.List{Values: append(append([]interface{}{}, "range-over", _ast), _props.(*mml.List).Values...)}).Values)
-					},
-					FixedArgs: 0,
-				}
-				_parseExpression = &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _nodes = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_nodes)
-						return mml.Ref(_structs, "merge").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parse, mml.Ref(_ast, "nodes"))}).Values))}).Values)
-					},
-					FixedArgs: 1,
-				}
-				switch {
-				case mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values), 0):
+		exports["do"] = _do
 
-					mml.Nop()
-					return _createRangeOver.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-				case (mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values), 1).(bool) && mml.BinaryOp(11, mml.Ref(mml.Ref(mml.Ref(_ast, "nodes"), 0), "name"), "symbol").(bool)):
+		return exports
+	})
 
-					mml.Nop()
-					return _createRangeOver.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-						s := &mml.Struct{Values: make(map[string]interface{})}
-						s.Values["symbol"] = mml.Ref(_parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values), "name")
-						return s
-					}())}).Values)
-				case mml.BinaryOp(12, mml.Ref(mml.Ref(mml.Ref(_ast, "nodes"), 0), "name"), "symbol"):
+	modulePath = "parse"
 
-					mml.Nop()
-					return _createRangeOver.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-						s := &mml.Struct{Values: make(map[string]interface{})}
-						s.Values["expression"] = _parseExpression.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values)
-						return s
-					}())}).Values)
-				default:
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
+		exports := make(map[string]interface{})
 
-					mml.Nop()
-					return _createRangeOver.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-						s := &mml.Struct{Values: make(map[string]interface{})}
-						s.Values["symbol"] = mml.Ref(_parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values), "name")
-						s.Values["expression"] = _parseExpression.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.RefRange(mml.Ref(_ast, "nodes"), 1, nil))}).Values)
-						return s
-					}())}).Values)
-				}
-				return nil
-			},
-			FixedArgs: 1,
-		}
-		_loop = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				var _createLoop interface{}
-				var _emptyRange interface{}
-				var _expression interface{}
-				var _loop interface{}
-				mml.Nop(_createLoop, _emptyRange, _expression, _loop)
-				_createLoop = &mml.Function{
+		var c interface{}
+		mml.Nop(c)
+
+		var _assortComments interface{}
+		var _create interface{}
+		var _functionFact interface{}
+		var _rangeExpression interface{}
+		var _useSymbol interface{}
+		var _indexer interface{}
+		var _application interface{}
+		var _unary interface{}
+		var _binary interface{}
+		var _chaining interface{}
+		var _ternary interface{}
+		var _ifStatement interface{}
+		var _parseCase interface{}
+		var _defaultStatements interface{}
+		var _switchStatement interface{}
+		var _sendStatement interface{}
+		var _receiveExpression interface{}
+		var _selectStatement interface{}
+		var _rangeOver interface{}
+		var _loop interface{}
+		var _assign interface{}
+		var _valueCapture interface{}
+		var _mutableCapture interface{}
+		var _valueDefinition interface{}
+		var _definitionGroup interface{}
+		var _mutableDefinitionGroup interface{}
+		var _functionCapture interface{}
+		var _effectCapture interface{}
+		var _functionDefinition interface{}
+		var _effectDefinitionGroup interface{}
+		var _exportDefinition interface{}
+		var _exportUse interface{}
+		var _exportSelectedUse interface{}
+		var _exportStatement interface{}
+		var _useFact interface{}
+		var _parse interface{}
+		var _parserError interface{}
+		var _knownOrError interface{}
+		var _parsePrimitive interface{}
+		var _ast interface{}
+		var _commentLine interface{}
+		var _lineComment interface{}
+		var _blockCommentContent interface{}
+		var _blockComment interface{}
+		var _intCode interface{}
+		var _floatCode interface{}
+		var _stringCode interface{}
+		var _boolCode interface{}
+		var _symbol interface{}
+		var _spread interface{}
+		var _list interface{}
+		var _mutableList interface{}
+		var _expressionKey interface{}
+		var _entry interface{}
+		var _struct interface{}
+		var _mutableStruct interface{}
+		var _ret interface{}
+		var _checkRet interface{}
+		var _statementListOf interface{}
+		var _statementList interface{}
+		var _collectParameter interface{}
+		var _functionLiteral interface{}
+		var _effect interface{}
+		var _symbolIndex interface{}
+		var _expressionIndex interface{}
+		var _rangeIndex interface{}
+		var _goStatement interface{}
+		var _deferStatement interface{}
+		var _useEffect interface{}
+		var _useList interface{}
+		var _module interface{}
+		var _do interface{}
+		var _validateast interface{}
+		var _structs interface{}
+		var _lists interface{}
+		var _code interface{}
+		var _errors interface{}
+		var _codetree interface{}
+		var _strings interface{}
+		var _functions interface{}
+		var _fold interface{}
+		var _foldr interface{}
+		var _map interface{}
+		var _filter interface{}
+		var _contains interface{}
+		var _sort interface{}
+		var _flat interface{}
+		var _flats interface{}
+		var _uniq interface{}
+		var _every interface{}
+		var _some interface{}
+		var _join interface{}
+		var _joins interface{}
+		var _formats interface{}
+		var _enum interface{}
+		var _log interface{}
+		var _fatal interface{}
+		var _bind interface{}
+		var _identity interface{}
+		var _eq interface{}
+		var _any interface{}
+		var _function interface{}
+		var _channel interface{}
+		var _natural interface{}
+		var _type interface{}
+		var _listOf interface{}
+		var _structOf interface{}
+		var _range interface{}
+		var _rangeMin interface{}
+		var _listLength interface{}
+		var _or interface{}
+		var _and interface{}
+		var _not interface{}
+		var _predicate interface{}
+		var _is interface{}
+		var direct_assortComments func(interface{}) interface{}
+		mml.Nop(direct_assortComments)
+		var direct_functionFact func(interface{}, interface{}) interface{}
+		mml.Nop(direct_functionFact)
+		var direct_rangeExpression func(interface{}) interface{}
+		mml.Nop(direct_rangeExpression)
+		var direct_useSymbol func(interface{}) interface{}
+		mml.Nop(direct_useSymbol)
+		var direct_indexer func(interface{}) interface{}
+		mml.Nop(direct_indexer)
+		var direct_application func(interface{}) interface{}
+		mml.Nop(direct_application)
+		var direct_unary func(interface{}) interface{}
+		mml.Nop(direct_unary)
+		var direct_binary func(interface{}) interface{}
+		mml.Nop(direct_binary)
+		var direct_chaining func(interface{}) interface{}
+		mml.Nop(direct_chaining)
+		var direct_ternary func(interface{}) interface{}
+		mml.Nop(direct_ternary)
+		var direct_ifStatement func(interface{}) interface{}
+		mml.Nop(direct_ifStatement)
+		var direct_parseCase func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_parseCase)
+		var direct_defaultStatements func(interface{}) interface{}
+		mml.Nop(direct_defaultStatements)
+		var direct_switchStatement func(interface{}) interface{}
+		mml.Nop(direct_switchStatement)
+		var direct_sendStatement func(interface{}) interface{}
+		mml.Nop(direct_sendStatement)
+		var direct_receiveExpression func(interface{}) interface{}
+		mml.Nop(direct_receiveExpression)
+		var direct_selectStatement func(interface{}) interface{}
+		mml.Nop(direct_selectStatement)
+		var direct_rangeOver func(interface{}) interface{}
+		mml.Nop(direct_rangeOver)
+		var direct_loop func(interface{}) interface{}
+		mml.Nop(direct_loop)
+		var direct_assign func(interface{}) interface{}
+		mml.Nop(direct_assign)
+		var direct_valueCapture func(interface{}) interface{}
+		mml.Nop(direct_valueCapture)
+		var direct_mutableCapture func(interface{}) interface{}
+		mml.Nop(direct_mutableCapture)
+		var direct_valueDefinition func(interface{}) interface{}
+		mml.Nop(direct_valueDefinition)
+		var direct_definitionGroup func(interface{}) interface{}
+		mml.Nop(direct_definitionGroup)
+		var direct_mutableDefinitionGroup func(interface{}) interface{}
+		mml.Nop(direct_mutableDefinitionGroup)
+		var direct_functionCapture func(interface{}) interface{}
+		mml.Nop(direct_functionCapture)
+		var direct_effectCapture func(interface{}) interface{}
+		mml.Nop(direct_effectCapture)
+		var direct_functionDefinition func(interface{}) interface{}
+		mml.Nop(direct_functionDefinition)
+		var direct_effectDefinitionGroup func(interface{}) interface{}
+		mml.Nop(direct_effectDefinitionGroup)
+		var direct_exportDefinition func(interface{}) interface{}
+		mml.Nop(direct_exportDefinition)
+		var direct_exportUse func(interface{}) interface{}
+		mml.Nop(direct_exportUse)
+		var direct_exportSelectedUse func(interface{}) interface{}
+		mml.Nop(direct_exportSelectedUse)
+		var direct_exportStatement func(interface{}) interface{}
+		mml.Nop(direct_exportStatement)
+		var direct_useFact func(interface{}) interface{}
+		mml.Nop(direct_useFact)
+		var direct_parse func(interface{}) interface{}
+		mml.Nop(direct_parse)
+		var direct_parserError func(interface{}, interface{}) interface{}
+		mml.Nop(direct_parserError)
+		var direct_knownOrError func(interface{}) interface{}
+		mml.Nop(direct_knownOrError)
+		var direct_parsePrimitive func(interface{}) interface{}
+		mml.Nop(direct_parsePrimitive)
+		var direct_ast func(interface{}) interface{}
+		mml.Nop(direct_ast)
+		var direct_commentLine func(interface{}) interface{}
+		mml.Nop(direct_commentLine)
+		var direct_lineComment func(interface{}) interface{}
+		mml.Nop(direct_lineComment)
+		var direct_blockCommentContent func(interface{}) interface{}
+		mml.Nop(direct_blockCommentContent)
+		var direct_blockComment func(interface{}) interface{}
+		mml.Nop(direct_blockComment)
+		var direct_symbol func(interface{}) interface{}
+		mml.Nop(direct_symbol)
+		var direct_spread func(interface{}) interface{}
+		mml.Nop(direct_spread)
+		var direct_list func(interface{}) interface{}
+		mml.Nop(direct_list)
+		var direct_mutableList func(interface{}) interface{}
+		mml.Nop(direct_mutableList)
+		var direct_expressionKey func(interface{}) interface{}
+		mml.Nop(direct_expressionKey)
+		var direct_entry func(interface{}) interface{}
+		mml.Nop(direct_entry)
+		var direct_struct func(interface{}) interface{}
+		mml.Nop(direct_struct)
+		var direct_mutableStruct func(interface{}) interface{}
+		mml.Nop(direct_mutableStruct)
+		var direct_ret func(interface{}) interface{}
+		mml.Nop(direct_ret)
+		var direct_checkRet func(interface{}) interface{}
+		mml.Nop(direct_checkRet)
+		var direct_statementListOf func(interface{}, interface{}) interface{}
+		mml.Nop(direct_statementListOf)
+		var direct_statementList func(interface{}) interface{}
+		mml.Nop(direct_statementList)
+		var direct_collectParameter func(interface{}) interface{}
+		mml.Nop(direct_collectParameter)
+		var direct_functionLiteral func(interface{}) interface{}
+		mml.Nop(direct_functionLiteral)
+		var direct_effect func(interface{}) interface{}
+		mml.Nop(direct_effect)
+		var direct_symbolIndex func(interface{}) interface{}
+		mml.Nop(direct_symbolIndex)
+		var direct_expressionIndex func(interface{}) interface{}
+		mml.Nop(direct_expressionIndex)
+		var direct_rangeIndex func(interface{}) interface{}
+		mml.Nop(direct_rangeIndex)
+		var direct_goStatement func(interface{}) interface{}
+		mml.Nop(direct_goStatement)
+		var direct_deferStatement func(interface{}) interface{}
+		mml.Nop(direct_deferStatement)
+		var direct_useEffect func(interface{}) interface{}
+		mml.Nop(direct_useEffect)
+		var direct_useList func(interface{}) interface{}
+		mml.Nop(direct_useList)
+		var direct_module func(interface{}) interface{}
+		mml.Nop(direct_module)
+		var direct_do func(interface{}, interface{}) interface{}
+		mml.Nop(direct_do)
+		mml.Nop(_assortComments, _create, _functionFact, _rangeExpression, _useSymbol, _indexer, _application, _unary, _binary, _chaining, _ternary, _ifStatement, _parseCase, _defaultStatements, _switchStatement, _sendStatement, _receiveExpression, _selectStatement, _rangeOver, _loop, _assign, _valueCapture, _mutableCapture, _valueDefinition, _definitionGroup, _mutableDefinitionGroup, _functionCapture, _effectCapture, _functionDefinition, _effectDefinitionGroup, _exportDefinition, _exportUse, _exportSelectedUse, _exportStatement, _useFact, _parse, _parserError, _knownOrError, _parsePrimitive, _ast, _commentLine, _lineComment, _blockCommentContent, _blockComment, _intCode, _floatCode, _stringCode, _boolCode, _symbol, _spread, _list, _mutableList, _expressionKey, _entry, _struct, _mutableStruct, _ret, _checkRet, _statementListOf, _statementList, _collectParameter, _functionLiteral, _effect, _symbolIndex, _expressionIndex, _rangeIndex, _goStatement, _deferStatement, _useEffect, _useList, _module, _do, _validateast, _structs, _lists, _code, _errors, _codetree, _strings, _functions, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _flats, _uniq, _every, _some, _join, _joins, _formats, _enum, _log, _fatal, _bind, _identity, _eq, _any, _function, _channel, _natural, _type, _listOf, _structOf, _range, _rangeMin, _listLength, _or, _and, _not, _predicate, _is)
+		var __lang = use("lang")
+		_fold = __lang.Get("fold")
+		_foldr = __lang.Get("foldr")
+		_map = __lang.Get("map")
+		_filter = __lang.Get("filter")
+		_contains = __lang.Get("contains")
+		_sort = __lang.Get("sort")
+		_flat = __lang.Get("flat")
+		_flats = __lang.Get("flats")
+		_uniq = __lang.Get("uniq")
+		_every = __lang.Get("every")
+		_some = __lang.Get("some")
+		_join = __lang.Get("join")
+		_joins = __lang.Get("joins")
+		_formats = __lang.Get("formats")
+		_enum = __lang.Get("enum")
+		_log = __lang.Get("log")
+		_fatal = __lang.Get("fatal")
+		_bind = __lang.Get("bind")
+		_identity = __lang.Get("identity")
+		_eq = __lang.Get("eq")
+		_any = __lang.Get("any")
+		_function = __lang.Get("function")
+		_channel = __lang.Get("channel")
+		_natural = __lang.Get("natural")
+		_type = __lang.Get("type")
+		_listOf = __lang.Get("listOf")
+		_structOf = __lang.Get("structOf")
+		_range = __lang.Get("range")
+		_rangeMin = __lang.Get("rangeMin")
+		_listLength = __lang.Get("listLength")
+		_or = __lang.Get("or")
+		_and = __lang.Get("and")
+		_not = __lang.Get("not")
+		_predicate = __lang.Get("predicate")
+		_is = __lang.Get("is")
+		_validateast = use("validateast")
+		_structs = use("structs")
+		_lists = use("lists")
+		_code = use("code")
+		_errors = use("errors")
+		_codetree = use("codetree")
+		_strings = use("strings")
+		_functions = use("functions")
+		direct_assortComments = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _isComment interface{}
+			var _astStripped interface{}
+			var _comments interface{}
+			mml.Nop(_isComment, _astStripped, _comments)
+			_isComment = _is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("name", _or.(*mml.Function).Call([]interface{}{"line-comment", "block-comment"}))
+				return s
+			}()})
+			_astStripped = func() interface{} {
+				s := &mml.Struct{}
+				s.Merge(_ast.(*mml.Struct))
+				s.Set("nodes", _filter.(*mml.Function).Call([]interface{}{mml.Ref(_functions, "not").(*mml.Function).Call([]interface{}{_isComment}), mml.Ref(_ast, "nodes")}))
+				return s
+			}()
+			_comments = func() interface{} {
+				s := &mml.Struct{}
+				s.Set("nodes", _filter.(*mml.Function).Call([]interface{}{_isComment, mml.Ref(_ast, "nodes")}))
+				s.Set("indexes", _filter.(*mml.Function).Call([]interface{}{&mml.Function{
 					F: func(a []interface{}) interface{} {
 						var c interface{}
 						mml.Nop(c)
-						var _body = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_body)
-						return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "loop", _ast, func() interface{} {
-							s := &mml.Struct{Values: make(map[string]interface{})}
-							s.Values["body"] = _statementList.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _body)}).Values)
-							return s
-						}())}).Values)
+						var _i = a[0]
+						mml.Nop(_i)
+						return _isComment.(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_ast, "nodes"), _i)})
 					},
 					FixedArgs: 1,
-				}
-				c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values), 1)
-				if c.(bool) {
-					mml.Nop()
-					return _createLoop.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
-				}
-				_emptyRange = _and.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["type"] = "range-over"
-					return s
-				}(), _not.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _or.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["symbol"] = _any
-					return s
-				}(), func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["expression"] = _any
-					return s
-				}())}).Values))}).Values))}).Values)
-				_expression = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
-				_loop = _createLoop.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 1))}).Values)
-				return func() interface{} {
-					c = _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _emptyRange, _expression)}).Values)
-					if c.(bool) {
-						return _loop
-					} else {
-						return func() interface{} {
-							s := &mml.Struct{Values: make(map[string]interface{})}
-							func() {
-								sp := _loop.(*mml.Struct)
-								for k, v := range sp.Values {
-									s.Values[k] = v
-								}
-							}()
-							s.Values["expression"] = _expression
-							return s
-						}()
-					}
-				}()
-				return nil
-			},
-			FixedArgs: 1,
+				}}).(*mml.Function).Call([]interface{}{mml.Ref(_lists, "indexes").(*mml.Function).Call([]interface{}{mml.Ref(_ast, "nodes")})}))
+				return s
+			}()
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Set("ast", _astStripped)
+				s.Set("comments", _comments)
+				return s
+			}()
 		}
-		_assign = &mml.Function{
+		_assortComments = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "assign", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["capture"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
-					s.Values["value"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 1))}).Values)
-					return s
-				}())}).Values)
+				return direct_assortComments(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_valueCapture = &mml.Function{
+		_create = &mml.Function{
 			F: func(a []interface{}) interface{} {
 				var c interface{}
 				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "definition", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["symbol"] = mml.Ref(_parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values), "name")
-					s.Values["expression"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 1))}).Values)
-					s.Values["mutable"] = false
-					s.Values["exported"] = false
-					return s
-				}())}).Values)
+				var _type = a[0]
+				var _ast = a[1]
+				var _props interface{}
+				_props = mml.NewList(a[2:])
+				mml.Nop(_type, _ast, _props)
+				return mml.Ref(_structs, "merges").(*mml.Function).Call(append([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", _type); s.Set("ast", _ast); ; return s }()}, _props.(*mml.List).Values()...))
 			},
-			FixedArgs: 1,
+			FixedArgs: 2,
 		}
-		_mutableCapture = &mml.Function{
+		direct_commentLine = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"comment-line", _ast, func() interface{} { s := &mml.Struct{}; s.Set("text", mml.Ref(_ast, "text")); ; return s }()})
+		}
+		_commentLine = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					func() {
-						sp := _valueCapture.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values).(*mml.Struct)
-						for k, v := range sp.Values {
-							s.Values[k] = v
-						}
-					}()
-					s.Values["mutable"] = true
-					return s
-				}()
+				return direct_commentLine(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_valueDefinition = &mml.Function{
+		direct_lineComment = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"line-comment", _ast, func() interface{} {
+				s := &mml.Struct{}
+				s.Set("lines", _map.(*mml.Function).Call([]interface{}{_parse, mml.Ref(_ast, "nodes")}))
+				return s
+			}()})
+		}
+		_lineComment = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return func() interface{} {
-					c = mml.BinaryOp(15, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values), 1)
-					if c.(bool) {
-						return func() interface{} {
-							s := &mml.Struct{Values: make(map[string]interface{})}
-							s.Values["docs"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
-							func() {
-								sp := _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 1))}).Values).(*mml.Struct)
-								for k, v := range sp.Values {
-									s.Values[k] = v
-								}
-							}()
-							return s
-						}()
-					} else {
-						return _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
-					}
-				}()
+				return direct_lineComment(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_definitionGroup = &mml.Function{
+		direct_blockCommentContent = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"block-comment-content", _ast, func() interface{} { s := &mml.Struct{}; s.Set("text", mml.Ref(_ast, "text")); ; return s }()})
+		}
+		_blockCommentContent = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "definition-group", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["definitions"] = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parse, mml.Ref(_ast, "nodes"))}).Values)
-					return s
-				}())}).Values)
+				return direct_blockCommentContent(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_mutableDefinitionGroup = &mml.Function{
+		direct_blockComment = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"block-comment", _ast, func() interface{} {
+				s := &mml.Struct{}
+				s.Set("content", direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0)))
+				return s
+			}()})
+		}
+		_blockComment = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				var _d interface{}
-				mml.Nop(_d)
-				_d = _definitionGroup.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-				return func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					func() {
-						sp := _d.(*mml.Struct)
-						for k, v := range sp.Values {
-							s.Values[k] = v
-						}
-					}()
-					s.Values["definitions"] = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-						F: func(a []interface{}) interface{} {
-							var c interface{}
-							mml.Nop(c)
-							var _d = a[0]
-							var _ interface{}
-							_ = &mml.List{a[1:]}
-							mml.Nop(_d)
-							return func() interface{} {
-								s := &mml.Struct{Values: make(map[string]interface{})}
-								func() {
-									sp := _d.(*mml.Struct)
-									for k, v := range sp.Values {
-										s.Values[k] = v
-									}
-								}()
-								s.Values["mutable"] = true
-								return s
-							}()
-						},
-						FixedArgs: 1,
-					}, mml.Ref(_d, "definitions"))}).Values)
-					return s
-				}()
-				return nil
+				return direct_blockComment(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_functionCapture = &mml.Function{
+		_intCode = _create.(*mml.Function).Call([]interface{}{"int"})
+		_floatCode = _create.(*mml.Function).Call([]interface{}{"float"})
+		_stringCode = _create.(*mml.Function).Call([]interface{}{"string"})
+		_boolCode = _create.(*mml.Function).Call([]interface{}{"bool"})
+		direct_symbol = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"symbol", _ast, func() interface{} { s := &mml.Struct{}; s.Set("name", mml.Ref(_ast, "text")); ; return s }()})
+		}
+		_symbol = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "definition", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["symbol"] = mml.Ref(_parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values), "name")
-					s.Values["expression"] = _functionFact.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast, 1)}).Values)
-					s.Values["mutable"] = false
-					s.Values["exported"] = false
-					return s
-				}())}).Values)
+				return direct_symbol(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_effectCapture = &mml.Function{
+		direct_spread = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"spread", _ast, func() interface{} {
+				s := &mml.Struct{}
+				s.Set("value", direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0)))
+				return s
+			}()})
+		}
+		_spread = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				var _f interface{}
-				mml.Nop(_f)
-				_f = _functionCapture.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-				return func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					func() {
-						sp := _f.(*mml.Struct)
-						for k, v := range sp.Values {
-							s.Values[k] = v
-						}
-					}()
-					s.Values["expression"] = func() interface{} {
-						s := &mml.Struct{Values: make(map[string]interface{})}
-						func() {
-							sp := mml.Ref(_f, "expression").(*mml.Struct)
-							for k, v := range sp.Values {
-								s.Values[k] = v
-							}
-						}()
-						s.Values["effect"] = true
-						return s
-					}()
-					return s
-				}()
-				return nil
+				return direct_spread(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_functionDefinition = &mml.Function{
+		direct_list = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"list", _ast, func() interface{} {
+				s := &mml.Struct{}
+				s.Set("values", _map.(*mml.Function).Call([]interface{}{_parse, mml.Ref(_ast, "nodes")}))
+				s.Set("mutable", false)
+				return s
+			}()})
+		}
+		_list = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return func() interface{} {
-					c = mml.BinaryOp(15, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values), 1)
-					if c.(bool) {
-						return func() interface{} {
-							s := &mml.Struct{Values: make(map[string]interface{})}
-							s.Values["docs"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
-							func() {
-								sp := _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 1))}).Values).(*mml.Struct)
-								for k, v := range sp.Values {
-									s.Values[k] = v
-								}
-							}()
-							return s
-						}()
-					} else {
-						return _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
-					}
-				}()
+				return direct_list(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_effectDefinitionGroup = &mml.Function{
+		direct_mutableList = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Merge(direct_list(_ast).(*mml.Struct))
+				s.Set("mutable", true)
+				return s
+			}()
+		}
+		_mutableList = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				var _d interface{}
-				mml.Nop(_d)
-				_d = _definitionGroup.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-				return func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					func() {
-						sp := _d.(*mml.Struct)
-						for k, v := range sp.Values {
-							s.Values[k] = v
-						}
+				return direct_mutableList(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_expressionKey = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"expression-key", _ast, func() interface{} {
+				s := &mml.Struct{}
+				s.Set("value", direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0)))
+				return s
+			}()})
+		}
+		_expressionKey = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_expressionKey(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_entry = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"entry", _ast, func() interface{} {
+				s := &mml.Struct{}
+				s.Set("key", direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0)))
+				s.Set("value", direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 1)))
+				return s
+			}()})
+		}
+		_entry = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_entry(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_struct = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"struct", _ast, func() interface{} {
+				s := &mml.Struct{}
+				s.Set("entries", _map.(*mml.Function).Call([]interface{}{_parse, mml.Ref(_ast, "nodes")}))
+				s.Set("mutable", false)
+				return s
+			}()})
+		}
+		_struct = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_struct(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_mutableStruct = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Merge(direct_struct(_ast).(*mml.Struct))
+				s.Set("mutable", true)
+				return s
+			}()
+		}
+		_mutableStruct = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_mutableStruct(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_ret = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"ret", _ast, func() interface{} {
+				c = mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{mml.Ref(_ast, "nodes")}), 0)
+				if c.(bool) {
+					return func() interface{} { s := &mml.Struct{}; ; return s }()
+				} else {
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Set("value", direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0)))
+						return s
 					}()
-					s.Values["definitions"] = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-						F: func(a []interface{}) interface{} {
-							var c interface{}
-							mml.Nop(c)
-							var _d = a[0]
-							var _ interface{}
-							_ = &mml.List{a[1:]}
-							mml.Nop(_d)
-							return func() interface{} {
-								s := &mml.Struct{Values: make(map[string]interface{})}
-								func() {
-									sp := _d.(*mml.Struct)
-									for k, v := range sp.Values {
-										s.Values[k] = v
-									}
-								}()
-								s.Values["expression"] = func() interface{} {
-									s := &mml.Struct{Values: make(map[string]interface{})}
-									func() {
-										sp := mml.Ref(_d, "expression").(*mml.Struct)
-										for k, v := range sp.Values {
-											s.Values[k] = v
-										}
-									}()
-									s.Values["effect"] = true
-									return s
-								}()
-								return s
-							}()
-						},
-						FixedArgs: 1,
-					}, mml.Ref(_d, "definitions"))}).Values)
-					return s
-				}()
-				return nil
+				}
+			}()})
+		}
+		_ret = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_ret(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_exportStatement = &mml.Function{
+		direct_checkRet = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"check-ret", _ast, func() interface{} {
+				s := &mml.Struct{}
+				s.Set("value", direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0)))
+				return s
+			}()})
+		}
+		_checkRet = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				var _d interface{}
-				var _dl interface{}
-				var _edl interface{}
-				mml.Nop(_d, _dl, _edl)
-				_d = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
-				_dl = func() interface{} {
-					c = mml.BinaryOp(11, mml.Ref(_d, "type"), "definition")
+				return direct_checkRet(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_statementListOf = func(_ast, _nodes interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"statement-list", _ast, func() interface{} {
+				s := &mml.Struct{}
+				s.Set("statements", _map.(*mml.Function).Call([]interface{}{_parse, _nodes}))
+				return s
+			}()})
+		}
+		_statementListOf = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_statementListOf(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_statementList = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return direct_statementListOf(_ast, mml.Ref(_ast, "nodes"))
+		}
+		_statementList = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_statementList(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_collectParameter = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0))
+		}
+		_collectParameter = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_collectParameter(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_functionFact = func(_ast, _offset interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _nodes interface{}
+			var _last interface{}
+			var _params interface{}
+			var _lastParam interface{}
+			var _hasCollectParam bool
+			var _fixedParams interface{}
+			mml.Nop(_nodes, _last, _params, _lastParam, _hasCollectParam, _fixedParams)
+			_nodes = mml.RefRange(mml.Ref(_ast, "nodes"), _offset, nil)
+			_last = mml.BinaryOp(10, _len.(*mml.Function).Call([]interface{}{_nodes}), 1)
+			_params = mml.RefRange(_nodes, nil, _last)
+			_lastParam = mml.BinaryOp(10, _len.(*mml.Function).Call([]interface{}{_params}), 1)
+			_hasCollectParam = (mml.BinaryOp(16, _lastParam, 0).(bool) && mml.BinaryOp(11, mml.Ref(mml.Ref(_params, _lastParam), "name"), "collect-parameter").(bool))
+			_fixedParams = func() interface{} {
+				if _hasCollectParam {
+					return mml.RefRange(_params, nil, _lastParam)
+				} else {
+					return _params
+				}
+			}()
+			return _create.(*mml.Function).Call([]interface{}{"function", _ast, func() interface{} {
+				s := &mml.Struct{}
+				s.Set("params", _map.(*mml.Function).Call([]interface{}{mml.Ref(_structs, "get").(*mml.Function).Call([]interface{}{"name"})}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_parse}).(*mml.Function).Call([]interface{}{_fixedParams})}))
+				s.Set("collectParam", func() interface{} {
+					if _hasCollectParam {
+						return mml.Ref(direct_parse(mml.Ref(_params, _lastParam)), "name")
+					} else {
+						return ""
+					}
+				}())
+				s.Set("body", direct_parse(mml.Ref(_nodes, _last)))
+				s.Set("effect", false)
+				return s
+			}()})
+		}
+		_functionFact = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_functionFact(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_functionLiteral = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return direct_functionFact(_ast, 0)
+		}
+		_functionLiteral = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_functionLiteral(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_effect = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Merge(direct_functionFact(_ast, 0).(*mml.Struct))
+				s.Set("effect", true)
+				return s
+			}()
+		}
+		_effect = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_effect(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_rangeExpression = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"range", _ast, func() interface{} {
+				s := &mml.Struct{}
+				s.Set(func() string {
+					c = mml.BinaryOp(11, mml.Ref(_ast, "name"), "range-from")
 					if c.(bool) {
-						return &mml.List{Values: append([]interface{}{}, _d)}
+						return "from"
 					} else {
-						return mml.Ref(_d, "definitions")
+						return "to"
 					}
-				}()
-				_edl = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _d = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_d)
-						return func() interface{} {
-							s := &mml.Struct{Values: make(map[string]interface{})}
-							func() {
-								sp := _d.(*mml.Struct)
-								for k, v := range sp.Values {
-									s.Values[k] = v
-								}
-							}()
-							s.Values["exported"] = true
-							return s
-						}()
-					},
-					FixedArgs: 1,
-				}, _dl)}).Values)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "definition-group", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["definitions"] = _edl
-					return s
-				}())}).Values)
-				return nil
+				}(), direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0)))
+				return s
+			}()})
+		}
+		_rangeExpression = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_rangeExpression(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_useFact = &mml.Function{
+		direct_useSymbol = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"symbol", _ast, func() interface{} { s := &mml.Struct{}; s.Set("name", "use"); ; return s }()})
+		}
+		_useSymbol = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				var _createUse interface{}
-				mml.Nop(_createUse)
-				_createUse = &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _props interface{}
-						_props = &mml.List{a[0:]}
-						mml.Nop(_props)
-						return _create.(*mml.Function).Call((&mml.List{Values: append(append([]interface{}{}, "use", _ast, func() interface{} {
-							s := &mml.Struct{Values: make(map[string]interface{})}
-							s.Values["effect"] = false
-							return s
-						}()), _props.(*mml.List).Values...)}).Values)
-					},
-					FixedArgs: 0,
-				}
-				switch mml.Ref(mml.Ref(mml.Ref(_ast, "nodes"), 0), "name") {
-				case "use-inline":
-
-					mml.Nop()
-					return _createUse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-						s := &mml.Struct{Values: make(map[string]interface{})}
-						s.Values["capture"] = "."
-						s.Values["path"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 1))}).Values)
-						return s
-					}())}).Values)
-				case "symbol":
-
-					mml.Nop()
-					return _createUse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-						s := &mml.Struct{Values: make(map[string]interface{})}
-						s.Values["capture"] = mml.Ref(_parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values), "name")
-						s.Values["path"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 1))}).Values)
-						return s
-					}())}).Values)
-				default:
-
-					mml.Nop()
-					return _createUse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-						s := &mml.Struct{Values: make(map[string]interface{})}
-						s.Values["path"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
+				return direct_useSymbol(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_symbolIndex = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"symbol-index", _ast, func() interface{} {
+				s := &mml.Struct{}
+				s.Set("symbol", func() interface{} {
+					c = mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{mml.Ref(_ast, "nodes")}), 0)
+					if c.(bool) {
+						return direct_useSymbol(_ast)
+					} else {
+						return direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0))
+					}
+				}())
+				return s
+			}()})
+		}
+		_symbolIndex = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_symbolIndex(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_expressionIndex = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0))
+		}
+		_expressionIndex = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_expressionIndex(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_rangeIndex = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call(append([]interface{}{"range", _ast}, _map.(*mml.Function).Call([]interface{}{_parse, mml.Ref(_ast, "nodes")}).(*mml.List).Values()...))
+		}
+		_rangeIndex = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_rangeIndex(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_indexer = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _indexerNodes interface{}
+			mml.Nop(_indexerNodes)
+			_indexerNodes = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _nodes = a[0]
+					mml.Nop(_nodes)
+					return _create.(*mml.Function).Call([]interface{}{"indexer", _ast, func() interface{} {
+						s := &mml.Struct{}
+						s.Set("expression", func() interface{} {
+							c = mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_nodes}), 2)
+							if c.(bool) {
+								return direct_parse(mml.Ref(_nodes, 0))
+							} else {
+								return _indexerNodes.(*mml.Function).Call([]interface{}{mml.RefRange(_nodes, nil, mml.BinaryOp(10, _len.(*mml.Function).Call([]interface{}{_nodes}), 1))})
+							}
+						}())
+						s.Set("index", direct_parse(mml.Ref(_nodes, mml.BinaryOp(10, _len.(*mml.Function).Call([]interface{}{_nodes}), 1))))
 						return s
-					}())}).Values)
-				}
-				return nil
+					}()})
+				},
+				FixedArgs: 1,
+			}
+			return _indexerNodes.(*mml.Function).Call([]interface{}{mml.Ref(_ast, "nodes")})
+		}
+		_indexer = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_indexer(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_useEffect = &mml.Function{
+		direct_application = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"application", _ast, func() interface{} {
+				s := &mml.Struct{}
+				s.Set("function", direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0)))
+				s.Set("args", _map.(*mml.Function).Call([]interface{}{_parse, mml.RefRange(mml.Ref(_ast, "nodes"), 1, nil)}))
+				return s
+			}()})
+		}
+		_application = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					func() {
-						sp := _useFact.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values).(*mml.Struct)
-						for k, v := range sp.Values {
-							s.Values[k] = v
-						}
-					}()
-					s.Values["effect"] = true
-					return s
-				}()
+				return direct_application(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_useList = &mml.Function{
+		direct_unary = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _ops interface{}
+			mml.Nop(_ops)
+			_ops = func() interface{} {
+				s := &mml.Struct{}
+				s.Set("binary-not", mml.Ref(_code, "binaryNot"))
+				s.Set("plus", mml.Ref(_code, "plus"))
+				s.Set("minus", mml.Ref(_code, "minus"))
+				s.Set("logical-not", mml.Ref(_code, "logicalNot"))
+				return s
+			}()
+			return _create.(*mml.Function).Call([]interface{}{"unary", _ast, func() interface{} {
+				s := &mml.Struct{}
+				s.Set("op", mml.Ref(_ops, mml.Ref(mml.Ref(mml.Ref(_ast, "nodes"), 0), "name")))
+				s.Set("arg", direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 1)))
+				return s
+			}()})
+		}
+		_unary = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "use-list", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["uses"] = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parse, mml.Ref(_ast, "nodes"))}).Values)
-					return s
-				}())}).Values)
+				return direct_unary(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_module = &mml.Function{
+		direct_binary = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _ops interface{}
+			mml.Nop(_ops)
+			_ops = func() interface{} {
+				s := &mml.Struct{}
+				s.Set("binary-and", mml.Ref(_code, "binaryAnd"))
+				s.Set("xor", mml.Ref(_code, "xor"))
+				s.Set("and-not", mml.Ref(_code, "andNot"))
+				s.Set("lshift", mml.Ref(_code, "lshift"))
+				s.Set("rshift", mml.Ref(_code, "rshift"))
+				s.Set("mul", mml.Ref(_code, "mul"))
+				s.Set("div", mml.Ref(_code, "div"))
+				s.Set("mod", mml.Ref(_code, "mod"))
+				s.Set("add", mml.Ref(_code, "add"))
+				s.Set("sub", mml.Ref(_code, "sub"))
+				s.Set("eq", mml.Ref(_code, "equals"))
+				s.Set("not-eq", mml.Ref(_code, "notEq"))
+				s.Set("less", mml.Ref(_code, "less"))
+				s.Set("less-or-eq", mml.Ref(_code, "lessOrEq"))
+				s.Set("greater", mml.Ref(_code, "greater"))
+				s.Set("greater-or-eq", mml.Ref(_code, "greaterOrEq"))
+				s.Set("logical-and", mml.Ref(_code, "logicalAnd"))
+				s.Set("logical-or", mml.Ref(_code, "logicalOr"))
+				return s
+			}()
+			return _create.(*mml.Function).Call([]interface{}{"binary", _ast, func() interface{} {
+				s := &mml.Struct{}
+				s.Set("op", mml.Ref(_ops, mml.Ref(mml.Ref(mml.Ref(_ast, "nodes"), mml.BinaryOp(10, _len.(*mml.Function).Call([]interface{}{mml.Ref(_ast, "nodes")}), 2)), "name")))
+				s.Set("left", direct_parse(func() interface{} {
+					c = mml.BinaryOp(15, _len.(*mml.Function).Call([]interface{}{mml.Ref(_ast, "nodes")}), 3)
+					if c.(bool) {
+						return func() interface{} {
+							s := &mml.Struct{}
+							s.Merge(_ast.(*mml.Struct))
+							s.Set("nodes", mml.RefRange(mml.Ref(_ast, "nodes"), nil, mml.BinaryOp(10, _len.(*mml.Function).Call([]interface{}{mml.Ref(_ast, "nodes")}), 2)))
+							return s
+						}()
+					} else {
+						return mml.Ref(mml.Ref(_ast, "nodes"), 0)
+					}
+				}()))
+				s.Set("right", direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), mml.BinaryOp(10, _len.(*mml.Function).Call([]interface{}{mml.Ref(_ast, "nodes")}), 1))))
+				return s
+			}()})
+		}
+		_binary = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				return _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "module", _ast, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["body"] = _statementList.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-					return s
-				}())}).Values)
+				return direct_binary(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_parse = &mml.Function{
+		direct_chaining = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return direct_parse(_fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _f = a[0]
+					var _a = a[1]
+					mml.Nop(_f, _a)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_ast.(*mml.Struct))
+						s.Set("name", "application")
+						s.Set("nodes", (&mml.List{}).Append(_f, _a))
+						return s
+					}()
+				},
+				FixedArgs: 2,
+			}, mml.Ref(mml.Ref(_ast, "nodes"), 0), mml.RefRange(mml.Ref(_ast, "nodes"), 1, nil)}))
+		}
+		_chaining = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_ast)
-				var _a interface{}
-				var _code interface{}
-				mml.Nop(_a, _code)
-				switch mml.Ref(_ast, "name") {
-				case "line-comment-content":
-
-					mml.Nop()
-					return _commentLine.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-				case "line-comment":
-
-					mml.Nop()
-					return _lineComment.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-				case "block-comment-content":
-
-					mml.Nop()
-					return _blockCommentContent.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-				case "block-comment":
-
-					mml.Nop()
-					return _blockComment.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-				case "int":
-
-					mml.Nop()
-					return _intCode.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-				case "float":
-
-					mml.Nop()
-					return _floatCode.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-				case "string":
-
-					mml.Nop()
-					return _stringCode.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-				case "true":
-
-					mml.Nop()
-					return _boolCode.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-				case "false":
-
-					mml.Nop()
-					return _boolCode.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-				case "symbol":
-
-					mml.Nop()
-					return _symbol.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-				}
-				_a = _assortComments.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-				_code = _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "unknown", mml.Ref(_a, "ast"))}).Values)
-				switch mml.Ref(mml.Ref(_a, "ast"), "name") {
-				case "spread":
-
-					mml.Nop()
-					_code = _spread.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "list":
-
-					mml.Nop()
-					_code = _list.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "mutable-list":
-
-					mml.Nop()
-					_code = _mutableList.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "expression-key":
-
-					mml.Nop()
-					_code = _expressionKey.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "entry":
-
-					mml.Nop()
-					_code = _entry.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "struct":
-
-					mml.Nop()
-					_code = _struct.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "mutable-struct":
-
-					mml.Nop()
-					_code = _mutableStruct.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "ret":
-
-					mml.Nop()
-					_code = _ret.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "check-ret":
-
-					mml.Nop()
-					_code = _checkRet.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "block":
-
-					mml.Nop()
-					_code = _statementList.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "collect-parameter":
-
-					mml.Nop()
-					_code = _collectParameter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "function":
-
-					mml.Nop()
-					_code = _functionLiteral.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "effect":
-
-					mml.Nop()
-					_code = _effect.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "range-from":
-
-					mml.Nop()
-					_code = _rangeExpression.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "range-to":
-
-					mml.Nop()
-					_code = _rangeExpression.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "symbol-index":
-
-					mml.Nop()
-					_code = _symbolIndex.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "expression-index":
-
-					mml.Nop()
-					_code = _expressionIndex.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "range-index":
-
-					mml.Nop()
-					_code = _rangeIndex.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "indexer":
-
-					mml.Nop()
-					_code = _indexer.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "application":
-
-					mml.Nop()
-					_code = _application.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "unary":
-
-					mml.Nop()
-					_code = _unary.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "binary0":
-
-					mml.Nop()
-					_code = _binary.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "binary1":
-
-					mml.Nop()
-					_code = _binary.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "binary2":
-
-					mml.Nop()
-					_code = _binary.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "binary3":
-
-					mml.Nop()
-					_code = _binary.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "binary4":
-
-					mml.Nop()
-					_code = _binary.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "chaining":
-
-					mml.Nop()
-					_code = _chaining.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "ternary":
-
-					mml.Nop()
-					_code = _ternary.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "if-statement":
-
-					mml.Nop()
-					_code = _ifStatement.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "switch-statement":
-
-					mml.Nop()
-					_code = _switchStatement.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "send-statement":
-
-					mml.Nop()
-					_code = _sendStatement.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "receive-expression":
-
-					mml.Nop()
-					_code = _receiveExpression.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "receive-definition":
-
-					mml.Nop()
-					_code = _valueCapture.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "select-statement":
-
-					mml.Nop()
-					_code = _selectStatement.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "go-statement":
-
-					mml.Nop()
-					_code = _goStatement.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "defer-statement":
-
-					mml.Nop()
-					_code = _deferStatement.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "range-over":
-
-					mml.Nop()
-					_code = _rangeOver.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "break":
-
-					mml.Nop()
-					_code = _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "break", mml.Ref(_a, "ast"))}).Values)
-				case "continue":
-
-					mml.Nop()
-					_code = _create.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "continue", mml.Ref(_a, "ast"))}).Values)
-				case "loop":
-
-					mml.Nop()
-					_code = _loop.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "assign":
-
-					mml.Nop()
-					_code = _assign.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "value-capture":
-
-					mml.Nop()
-					_code = _valueCapture.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "mutable-capture":
-
-					mml.Nop()
-					_code = _mutableCapture.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "value-definition":
-
-					mml.Nop()
-					_code = _valueDefinition.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "docs-value-capture":
-
-					mml.Nop()
-					_code = _valueDefinition.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "docs-mixed-capture":
-
-					mml.Nop()
-					_code = _valueDefinition.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "value-definition-group":
-
-					mml.Nop()
-					_code = _definitionGroup.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "mutable-definition-group":
-
-					mml.Nop()
-					_code = _mutableDefinitionGroup.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "function-capture":
-
-					mml.Nop()
-					_code = _functionCapture.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "effect-capture":
-
-					mml.Nop()
-					_code = _effectCapture.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "function-definition":
-
-					mml.Nop()
-					_code = _functionDefinition.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "docs-function-capture":
-
-					mml.Nop()
-					_code = _functionDefinition.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "docs-mixed-function-capture":
-
-					mml.Nop()
-					_code = _functionDefinition.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "function-definition-group":
-
-					mml.Nop()
-					_code = _definitionGroup.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "effect-definition-group":
-
-					mml.Nop()
-					_code = _effectDefinitionGroup.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "export-statement":
-
-					mml.Nop()
-					_code = _exportStatement.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "use-fact":
-
-					mml.Nop()
-					_code = _useFact.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "use-effect":
-
-					mml.Nop()
-					_code = _useEffect.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "use-modules":
-
-					mml.Nop()
-					_code = _useList.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				case "mml":
-
-					mml.Nop()
-					_code = _module.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "ast"))}).Values)
-				}
-				return func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					func() {
-						sp := _code.(*mml.Struct)
-						for k, v := range sp.Values {
-							s.Values[k] = v
-						}
-					}()
-					s.Values["comments"] = func() interface{} {
-						s := &mml.Struct{Values: make(map[string]interface{})}
-						s.Values["code"] = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parse, mml.Ref(mml.Ref(_a, "comments"), "nodes"))}).Values)
-						s.Values["indexes"] = mml.Ref(mml.Ref(_a, "comments"), "indexes")
-						return s
-					}()
-					return s
-				}()
-				return nil
+				return direct_chaining(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_parserError = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _msg = a[0]
-				var _ast = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_msg, _ast)
-				return _error.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "%s:%d:%d:%v", mml.Ref(_ast, "file"), mml.Ref(_ast, "line"), mml.Ref(_ast, "column"), _msg)}).Values))}).Values)
-			},
-			FixedArgs: 2,
+		direct_ternary = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"cond", _ast, func() interface{} {
+				s := &mml.Struct{}
+				s.Set("condition", direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0)))
+				s.Set("consequent", direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 1)))
+				s.Set("alternative", direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 2)))
+				s.Set("ternary", true)
+				return s
+			}()})
 		}
-		_knownOrError = &mml.Function{
+		_ternary = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _code = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_code)
-				return func() interface{} {
-					c = _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-						s := &mml.Struct{Values: make(map[string]interface{})}
-						s.Values["type"] = _not.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "unknown")}).Values)
-						return s
-					}(), _code)}).Values)
-					if c.(bool) {
-						return _code
-					} else {
-						return _parserError.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "unknown code", mml.Ref(_code, "ast"))}).Values)
-					}
-				}()
+				return direct_ternary(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_parsePrimitive = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _code = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_code)
-
-				mml.Nop()
-				switch mml.Ref(_code, "type") {
-				case "int":
-					var _v interface{}
-					mml.Nop(_v)
-					_v = _parseInt.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_code, "ast"), "text"))}).Values)
+		direct_ifStatement = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _constructCond interface{}
+			mml.Nop(_constructCond)
+			_constructCond = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _cond = a[0]
+					var _cons = a[1]
+					var _alt = a[2]
+					mml.Nop(_cond, _cons, _alt)
 					return func() interface{} {
-						c = _isError.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _v)}).Values)
+						c = mml.BinaryOp(11, _alt, false)
 						if c.(bool) {
-							return _parserError.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _v, mml.Ref(_code, "ast"))}).Values)
+							return _create.(*mml.Function).Call([]interface{}{"cond", _ast, func() interface{} {
+								s := &mml.Struct{}
+								s.Set("condition", _cond)
+								s.Set("consequent", _cons)
+								s.Set("ternary", false)
+								return s
+							}()})
 						} else {
 							return func() interface{} {
-								s := &mml.Struct{Values: make(map[string]interface{})}
-								func() {
-									sp := _code.(*mml.Struct)
-									for k, v := range sp.Values {
-										s.Values[k] = v
-									}
-								}()
-								s.Values["value"] = _v
+								s := &mml.Struct{}
+								s.Merge(_constructCond.(*mml.Function).Call([]interface{}{_cond, _cons, false}).(*mml.Struct))
+								s.Set("alternative", _alt)
 								return s
 							}()
 						}
 					}()
-				case "float":
-					var _v interface{}
-					mml.Nop(_v)
-					_v = _parseFloat.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_code, "ast"), "text"))}).Values)
+				},
+				FixedArgs: 3,
+			}
+			return _foldr.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _g = a[0]
+					var _i = a[1]
+					mml.Nop(_g, _i)
 					return func() interface{} {
-						c = _isError.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _v)}).Values)
+						c = mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_g}), 1)
 						if c.(bool) {
-							return _parserError.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _v, mml.Ref(_code, "ast"))}).Values)
+							return mml.Ref(_g, 0)
 						} else {
-							return func() interface{} {
-								s := &mml.Struct{Values: make(map[string]interface{})}
-								func() {
-									sp := _code.(*mml.Struct)
-									for k, v := range sp.Values {
-										s.Values[k] = v
-									}
-								}()
-								s.Values["value"] = _v
-								return s
-							}()
+							return _constructCond.(*mml.Function).Call(append(append([]interface{}{}, _g.(*mml.List).Values()...), _i))
 						}
 					}()
-				case "string":
-
-					mml.Nop()
-					return func() interface{} {
-						s := &mml.Struct{Values: make(map[string]interface{})}
-						func() {
-							sp := _code.(*mml.Struct)
-							for k, v := range sp.Values {
-								s.Values[k] = v
-							}
-						}()
-						s.Values["value"] = mml.Ref(_strings, "unescape").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.RefRange(mml.Ref(mml.Ref(_code, "ast"), "text"), 1, mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_code, "ast"), "text"))}).Values), 1)))}).Values)
-						return s
-					}()
-				case "bool":
-
-					mml.Nop()
+				},
+				FixedArgs: 2,
+			}, false}).(*mml.Function).Call([]interface{}{mml.Ref(_lists, "group").(*mml.Function).Call([]interface{}{2}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_parse}).(*mml.Function).Call([]interface{}{mml.Ref(_ast, "nodes")})})})
+		}
+		_ifStatement = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_ifStatement(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_parseCase = func(_name, _ast, _c interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{_name, _ast, func() interface{} {
+				s := &mml.Struct{}
+				s.Set("expression", direct_parse(mml.Ref(mml.Ref(_c, "nodes"), 0)))
+				s.Set("body", direct_statementListOf(_ast, mml.RefRange(mml.Ref(_c, "nodes"), 1, nil)))
+				return s
+			}()})
+		}
+		_parseCase = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_parseCase(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_defaultStatements = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _statementListOf.(*mml.Function).Call([]interface{}{_ast}).(*mml.Function).Call([]interface{}{_flat.(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{mml.Ref(_structs, "get").(*mml.Function).Call([]interface{}{"nodes"})}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("name", "default-block"); ; return s }()})}).(*mml.Function).Call([]interface{}{mml.Ref(_ast, "nodes")})})})})
+		}
+		_defaultStatements = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_defaultStatements(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_switchStatement = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _hasExpression bool
+			var _cases interface{}
+			var _expression interface{}
+			var _defaults interface{}
+			mml.Nop(_hasExpression, _cases, _expression, _defaults)
+			_hasExpression = (mml.BinaryOp(15, _len.(*mml.Function).Call([]interface{}{mml.Ref(_ast, "nodes")}), 0).(bool) && !_is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("name", _or.(*mml.Function).Call([]interface{}{"case-block", "default-block"}))
+				return s
+			}(), mml.Ref(mml.Ref(_ast, "nodes"), 0)}).(bool))
+			_expression = func() interface{} {
+				if _hasExpression {
 					return func() interface{} {
-						s := &mml.Struct{Values: make(map[string]interface{})}
-						func() {
-							sp := _code.(*mml.Struct)
-							for k, v := range sp.Values {
-								s.Values[k] = v
-							}
-						}()
-						s.Values["value"] = mml.BinaryOp(11, mml.Ref(mml.Ref(_code, "ast"), "text"), "true")
+						s := &mml.Struct{}
+						s.Set("expression", direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0)))
 						return s
 					}()
-				default:
-
-					mml.Nop()
-					return _code
+				} else {
+					return func() interface{} { s := &mml.Struct{}; ; return s }()
 				}
-				return nil
+			}()
+			_defaults = direct_defaultStatements(_ast)
+			_cases = _map.(*mml.Function).Call([]interface{}{_parseCase.(*mml.Function).Call([]interface{}{"switch-case", _ast})}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("name", "case-block"); ; return s }()})}).(*mml.Function).Call([]interface{}{mml.Ref(_ast, "nodes")})})
+			return _create.(*mml.Function).Call([]interface{}{"switch-statement", _ast, _expression, func() interface{} { s := &mml.Struct{}; s.Set("cases", _cases); ; return s }(), func() interface{} { s := &mml.Struct{}; s.Set("defaultStatements", _defaults); ; return s }()})
+		}
+		_switchStatement = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_switchStatement(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_ast = &mml.Function{
+		direct_sendStatement = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"send-statement", _ast, func() interface{} {
+				s := &mml.Struct{}
+				s.Set("channel", direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0)))
+				s.Set("value", direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 1)))
+				return s
+			}()})
+		}
+		_sendStatement = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _node = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_node)
-				return mml.Ref(_errors, "pass").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_validateast, "do"), _parse, mml.Ref(_codetree, "edit").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parsePrimitive)}).Values), mml.Ref(_codetree, "edit").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _knownOrError)}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _node)}).Values)
+				return direct_sendStatement(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_do = &mml.Function{
+		direct_receiveExpression = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"receive-expression", _ast, func() interface{} {
+				s := &mml.Struct{}
+				s.Set("channel", direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0)))
+				return s
+			}()})
+		}
+		_receiveExpression = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _text = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_text)
-				return mml.Ref(_errors, "pass").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parseAST, _ast)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _text)}).Values)
+				return direct_receiveExpression(a[0])
 			},
 			FixedArgs: 1,
 		}
-		exports["do"] = _do
+		direct_selectStatement = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _cases interface{}
+			var _hasDefault interface{}
+			var _defaults interface{}
+			mml.Nop(_cases, _hasDefault, _defaults)
+			_hasDefault = _some.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("name", "default-block"); ; return s }()}), mml.Ref(_ast, "nodes")})
+			_defaults = func() interface{} {
+				s := &mml.Struct{}
+				s.Set("hasDefault", _hasDefault)
+				s.Set("defaultStatements", direct_defaultStatements(_ast))
+				return s
+			}()
+			_cases = _map.(*mml.Function).Call([]interface{}{_parseCase.(*mml.Function).Call([]interface{}{"select-case", _ast})}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("name", "select-case-block"); ; return s }()})}).(*mml.Function).Call([]interface{}{mml.Ref(_ast, "nodes")})})
+			return _create.(*mml.Function).Call([]interface{}{"select-statement", _ast, func() interface{} { s := &mml.Struct{}; s.Set("cases", _cases); ; return s }(), _defaults})
+		}
+		_selectStatement = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_selectStatement(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_goStatement = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"go-statement", _ast, func() interface{} {
+				s := &mml.Struct{}
+				s.Set("application", direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0)))
+				return s
+			}()})
+		}
+		_goStatement = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_goStatement(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_deferStatement = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"defer-statement", _ast, func() interface{} {
+				s := &mml.Struct{}
+				s.Set("application", direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0)))
+				return s
+			}()})
+		}
+		_deferStatement = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_deferStatement(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_rangeOver = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _createRangeOver interface{}
+			var _parseExpression interface{}
+			mml.Nop(_createRangeOver, _parseExpression)
+			_createRangeOver = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _props interface{}
+					_props = mml.NewList(a[0:])
+					mml.Nop(_props)
+					return _create.(*mml.Function).Call(append([]interface{}{"range-over", _ast}, _props.(*mml.List).Values()...))
+				},
+				FixedArgs: 0,
+			}
+			_parseExpression = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _nodes = a[0]
+					mml.Nop(_nodes)
+					return mml.Ref(_structs, "merge").(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_parse, mml.Ref(_ast, "nodes")})})
+				},
+				FixedArgs: 1,
+			}
+			switch {
+			case mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{mml.Ref(_ast, "nodes")}), 0):
 
-		return exports
-	})
-
-	modulePath = "validateast"
+				mml.Nop()
+				return _createRangeOver.(*mml.Function).Call([]interface{}{})
+			case (mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{mml.Ref(_ast, "nodes")}), 1).(bool) && mml.BinaryOp(11, mml.Ref(mml.Ref(mml.Ref(_ast, "nodes"), 0), "name"), "symbol").(bool)):
 
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
-		exports := make(map[string]interface{})
+				mml.Nop()
+				return _createRangeOver.(*mml.Function).Call([]interface{}{func() interface{} {
+					s := &mml.Struct{}
+					s.Set("symbol", mml.Ref(direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0)), "name"))
+					return s
+				}()})
+			case mml.BinaryOp(12, mml.Ref(mml.Ref(mml.Ref(_ast, "nodes"), 0), "name"), "symbol"):
 
-		var c interface{}
-		mml.Nop(c)
+				mml.Nop()
+				return _createRangeOver.(*mml.Function).Call([]interface{}{func() interface{} {
+					s := &mml.Struct{}
+					s.Set("expression", _parseExpression.(*mml.Function).Call([]interface{}{mml.Ref(_ast, "nodes")}))
+					return s
+				}()})
+			default:
 
-		var _validateComments interface{}
-		var _dropComments interface{}
-		var _rangeExpression interface{}
-		var _functionParamsAndBody interface{}
-		var _rangeOver interface{}
-		var _startsWithCaseOrDefault interface{}
-		var _functionCapture interface{}
-		var _definitionChild interface{}
-		var _stringOrNamedStringOrInline interface{}
-		var _customValidators interface{}
-		var _validateCustom interface{}
-		var _node interface{}
-		var _minTextLength interface{}
-		var _childCount interface{}
-		var _minChildCount interface{}
-		var _paramsAreSymbols interface{}
-		var _onlyLastParamIsCollect interface{}
-		var _textLengthMin2 interface{}
-		var _oneChild interface{}
-		var _twoChildren interface{}
-		var _threeChildren interface{}
-		var _minOneChild interface{}
-		var _minTwoChildren interface{}
-		var _minThreeChildren interface{}
-		var _symbol interface{}
-		var _stringNode interface{}
-		var _useInline interface{}
-		var _symbolChild interface{}
-		var _collectParameter interface{}
-		var _rangeFrom interface{}
-		var _rangeTo interface{}
-		var _symbolAndAny interface{}
-		var _comment interface{}
-		var _do interface{}
-		var _code interface{}
-		var _fold interface{}
-		var _foldr interface{}
-		var _map interface{}
-		var _filter interface{}
-		var _contains interface{}
-		var _sort interface{}
-		var _flat interface{}
-		var _flats interface{}
-		var _concat interface{}
-		var _concats interface{}
-		var _uniq interface{}
-		var _every interface{}
-		var _some interface{}
-		var _join interface{}
-		var _joins interface{}
-		var _formats interface{}
-		var _enum interface{}
-		var _log interface{}
-		var _fatal interface{}
-		var _bind interface{}
-		var _identity interface{}
-		var _eq interface{}
-		var _any interface{}
-		var _function interface{}
-		var _channel interface{}
-		var _natural interface{}
-		var _type interface{}
-		var _listOf interface{}
-		var _structOf interface{}
-		var _range interface{}
-		var _rangeMin interface{}
-		var _listLength interface{}
-		var _or interface{}
-		var _and interface{}
-		var _not interface{}
-		var _predicate interface{}
-		var _predicates interface{}
-		var _is interface{}
-		mml.Nop(_validateComments, _dropComments, _rangeExpression, _functionParamsAndBody, _rangeOver, _startsWithCaseOrDefault, _functionCapture, _definitionChild, _stringOrNamedStringOrInline, _customValidators, _validateCustom, _node, _minTextLength, _childCount, _minChildCount, _paramsAreSymbols, _onlyLastParamIsCollect, _textLengthMin2, _oneChild, _twoChildren, _threeChildren, _minOneChild, _minTwoChildren, _minThreeChildren, _symbol, _stringNode, _useInline, _symbolChild, _collectParameter, _rangeFrom, _rangeTo, _symbolAndAny, _comment, _do, _code, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _flats, _concat, _concats, _uniq, _every, _some, _join, _joins, _formats, _enum, _log, _fatal, _bind, _identity, _eq, _any, _function, _channel, _natural, _type, _listOf, _structOf, _range, _rangeMin, _listLength, _or, _and, _not, _predicate, _predicates, _is)
-		var __lang = mml.Modules.Use("lang")
-		_fold = __lang.Values["fold"]
-		_foldr = __lang.Values["foldr"]
-		_map = __lang.Values["map"]
-		_filter = __lang.Values["filter"]
-		_contains = __lang.Values["contains"]
-		_sort = __lang.Values["sort"]
-		_flat = __lang.Values["flat"]
-		_flats = __lang.Values["flats"]
-		_concat = __lang.Values["concat"]
-		_concats = __lang.Values["concats"]
-		_uniq = __lang.Values["uniq"]
-		_every = __lang.Values["every"]
-		_some = __lang.Values["some"]
-		_join = __lang.Values["join"]
-		_joins = __lang.Values["joins"]
-		_formats = __lang.Values["formats"]
-		_enum = __lang.Values["enum"]
-		_log = __lang.Values["log"]
-		_fatal = __lang.Values["fatal"]
-		_bind = __lang.Values["bind"]
-		_identity = __lang.Values["identity"]
-		_eq = __lang.Values["eq"]
-		_any = __lang.Values["any"]
-		_function = __lang.Values["function"]
-		_channel = __lang.Values["channel"]
-		_natural = __lang.Values["natural"]
-		_type = __lang.Values["type"]
-		_listOf = __lang.Values["listOf"]
-		_structOf = __lang.Values["structOf"]
-		_range = __lang.Values["range"]
-		_rangeMin = __lang.Values["rangeMin"]
-		_listLength = __lang.Values["listLength"]
-		_or = __lang.Values["or"]
-		_and = __lang.Values["and"]
-		_not = __lang.Values["not"]
-		_predicate = __lang.Values["predicate"]
-		_predicates = __lang.Values["predicates"]
-		_is = __lang.Values["is"]
-		_code = mml.Modules.Use("code")
-		_minTextLength = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _n = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_n)
-				return func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["text"] = _rangeMin.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _string, _n)}).Values)
+				mml.Nop()
+				return _createRangeOver.(*mml.Function).Call([]interface{}{func() interface{} {
+					s := &mml.Struct{}
+					s.Set("symbol", mml.Ref(direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0)), "name"))
+					s.Set("expression", _parseExpression.(*mml.Function).Call([]interface{}{mml.RefRange(mml.Ref(_ast, "nodes"), 1, nil)}))
 					return s
-				}()
+				}()})
+			}
+		}
+		_rangeOver = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_rangeOver(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_childCount = &mml.Function{
+		direct_loop = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _createLoop interface{}
+			var _emptyRange interface{}
+			var _expression interface{}
+			var _loop interface{}
+			mml.Nop(_createLoop, _emptyRange, _expression, _loop)
+			_createLoop = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _body = a[0]
+					mml.Nop(_body)
+					return _create.(*mml.Function).Call([]interface{}{"loop", _ast, func() interface{} { s := &mml.Struct{}; s.Set("body", direct_statementList(_body)); ; return s }()})
+				},
+				FixedArgs: 1,
+			}
+			c = mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{mml.Ref(_ast, "nodes")}), 1)
+			if c.(bool) {
+				mml.Nop()
+				return _createLoop.(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_ast, "nodes"), 0)})
+			}
+			_emptyRange = _and.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "range-over"); ; return s }(), _not.(*mml.Function).Call([]interface{}{_or.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("symbol", _any); ; return s }(), func() interface{} { s := &mml.Struct{}; s.Set("expression", _any); ; return s }()})})})
+			_expression = direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0))
+			_loop = _createLoop.(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_ast, "nodes"), 1)})
+			return func() interface{} {
+				c = _is.(*mml.Function).Call([]interface{}{_emptyRange, _expression})
+				if c.(bool) {
+					return _loop
+				} else {
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_loop.(*mml.Struct))
+						s.Set("expression", _expression)
+						return s
+					}()
+				}
+			}()
+		}
+		_loop = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _n = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_n)
-				return func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["nodes"] = _range.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _listOf.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _any)}).Values), _n, _n)}).Values)
-					return s
-				}()
+				return direct_loop(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_minChildCount = &mml.Function{
+		direct_assign = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"assign", _ast, func() interface{} {
+				s := &mml.Struct{}
+				s.Set("capture", direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0)))
+				s.Set("value", direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 1)))
+				return s
+			}()})
+		}
+		_assign = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _n = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_n)
-				return func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["nodes"] = _rangeMin.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _listOf.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _any)}).Values), _n)}).Values)
-					return s
-				}()
+				return direct_assign(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_paramsAreSymbols = &mml.Function{
+		direct_valueCapture = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"definition", _ast, func() interface{} {
+				s := &mml.Struct{}
+				s.Set("symbol", mml.Ref(direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0)), "name"))
+				s.Set("expression", direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 1)))
+				s.Set("mutable", false)
+				s.Set("exported", false)
+				return s
+			}()})
+		}
+		_valueCapture = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _n = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_n)
-				return _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _listOf.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _or.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _symbol, _collectParameter)}).Values))}).Values), mml.RefRange(mml.Ref(_n, "nodes"), nil, mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_n, "nodes"))}).Values), 1)))}).Values)
+				return direct_valueCapture(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_onlyLastParamIsCollect = &mml.Function{
+		direct_mutableCapture = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Merge(direct_valueCapture(_ast).(*mml.Struct))
+				s.Set("mutable", true)
+				return s
+			}()
+		}
+		_mutableCapture = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _n = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_n)
-				return (mml.BinaryOp(13, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_n, "nodes"))}).Values), 2).(bool) || _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _listOf.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _symbol)}).Values), mml.RefRange(mml.Ref(_n, "nodes"), nil, mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_n, "nodes"))}).Values), 2)))}).Values).(bool))
-			},
-			FixedArgs: 1,
-		}
-		_textLengthMin2 = _minTextLength.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, 2)}).Values)
-		_oneChild = _childCount.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, 1)}).Values)
-		_twoChildren = _childCount.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, 2)}).Values)
-		_threeChildren = _childCount.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, 3)}).Values)
-		_minOneChild = _minChildCount.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, 1)}).Values)
-		_minTwoChildren = _minChildCount.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, 2)}).Values)
-		_minThreeChildren = _minChildCount.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, 3)}).Values)
-		_symbol = func() interface{} {
-			s := &mml.Struct{Values: make(map[string]interface{})}
-			s.Values["name"] = "symbol"
-			return s
-		}()
-		_stringNode = func() interface{} {
-			s := &mml.Struct{Values: make(map[string]interface{})}
-			s.Values["name"] = "string"
-			return s
-		}()
-		_useInline = func() interface{} {
-			s := &mml.Struct{Values: make(map[string]interface{})}
-			s.Values["name"] = "use-inline"
-			return s
-		}()
-		_symbolChild = func() interface{} {
-			s := &mml.Struct{Values: make(map[string]interface{})}
-			s.Values["nodes"] = &mml.List{Values: append([]interface{}{}, _symbol)}
-			return s
-		}()
-		_collectParameter = func() interface{} {
-			s := &mml.Struct{Values: make(map[string]interface{})}
-			s.Values["name"] = "collect-parameter"
-			func() {
-				sp := _symbolChild.(*mml.Struct)
-				for k, v := range sp.Values {
-					s.Values[k] = v
+				return direct_mutableCapture(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_valueDefinition = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				c = mml.BinaryOp(15, _len.(*mml.Function).Call([]interface{}{mml.Ref(_ast, "nodes")}), 1)
+				if c.(bool) {
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Set("docs", direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0)))
+						s.Merge(direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 1)).(*mml.Struct))
+						return s
+					}()
+				} else {
+					return direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0))
 				}
 			}()
-			return s
-		}()
-		_rangeFrom = func() interface{} {
-			s := &mml.Struct{Values: make(map[string]interface{})}
-			s.Values["name"] = "range-from"
-			return s
-		}()
-		_rangeTo = func() interface{} {
-			s := &mml.Struct{Values: make(map[string]interface{})}
-			s.Values["name"] = "range-to"
-			return s
-		}()
-		_symbolAndAny = func() interface{} {
-			s := &mml.Struct{Values: make(map[string]interface{})}
-			s.Values["nodes"] = &mml.List{Values: append([]interface{}{}, _symbol, _any)}
-			return s
-		}()
-		_comment = _predicate.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-			s := &mml.Struct{Values: make(map[string]interface{})}
-			s.Values["name"] = _or.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "line-comment", "block-comment")}).Values)
-			return s
-		}())}).Values))}).Values)
-		_validateComments = &mml.Function{
+		}
+		_valueDefinition = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _node = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_node)
-				return _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _and.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _or.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _and.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["name"] = "block-comment"
-					return s
-				}(), _oneChild)}).Values), _not.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["name"] = "block-comment"
-					return s
-				}())}).Values))}).Values), func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["nodes"] = _listOf.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _predicate.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _validateComments)}).Values))}).Values)
-					return s
-				}())}).Values), _node)}).Values)
+				return direct_valueDefinition(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_dropComments = &mml.Function{
+		direct_definitionGroup = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"definition-group", _ast, func() interface{} {
+				s := &mml.Struct{}
+				s.Set("definitions", _map.(*mml.Function).Call([]interface{}{_parse, mml.Ref(_ast, "nodes")}))
+				return s
+			}()})
+		}
+		_definitionGroup = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _nodes = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_nodes)
-				return _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
+				return direct_definitionGroup(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_mutableDefinitionGroup = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _d interface{}
+			mml.Nop(_d)
+			_d = direct_definitionGroup(_ast)
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Merge(_d.(*mml.Struct))
+				s.Set("definitions", _map.(*mml.Function).Call([]interface{}{&mml.Function{
 					F: func(a []interface{}) interface{} {
 						var c interface{}
 						mml.Nop(c)
-						var _n = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_n)
+						var _d = a[0]
+						mml.Nop(_d)
 						return func() interface{} {
-							s := &mml.Struct{Values: make(map[string]interface{})}
-							func() {
-								sp := _n.(*mml.Struct)
-								for k, v := range sp.Values {
-									s.Values[k] = v
-								}
-							}()
-							s.Values["nodes"] = _dropComments.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_n, "nodes"))}).Values)
+							s := &mml.Struct{}
+							s.Merge(_d.(*mml.Struct))
+							s.Set("mutable", true)
 							return s
 						}()
 					},
 					FixedArgs: 1,
-				})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _not.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _comment)}).Values))}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _nodes)}).Values))}).Values)
+				}, mml.Ref(_d, "definitions")}))
+				return s
+			}()
+		}
+		_mutableDefinitionGroup = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_mutableDefinitionGroup(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_rangeExpression = func() interface{} {
-			s := &mml.Struct{Values: make(map[string]interface{})}
-			s.Values["nodes"] = _or.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _listLength.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, 0)}).Values), _and.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _listLength.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, 1)}).Values), &mml.List{Values: append([]interface{}{}, _or.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _rangeFrom, _rangeTo)}).Values))})}).Values), _and.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _listLength.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, 2)}).Values), &mml.List{Values: append([]interface{}{}, _rangeFrom, _rangeTo)})}).Values))}).Values)
-			return s
-		}()
-		_functionParamsAndBody = _and.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _minOneChild, _predicate.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _paramsAreSymbols)}).Values), _predicate.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _onlyLastParamIsCollect)}).Values))}).Values)
-		_rangeOver = func() interface{} {
-			s := &mml.Struct{Values: make(map[string]interface{})}
-			s.Values["nodes"] = _or.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _listLength.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, 0)}).Values), _and.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _listLength.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, 1)}).Values), &mml.List{Values: append([]interface{}{}, _or.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _rangeFrom, _rangeTo)}).Values))})}).Values), _and.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _listLength.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, 2)}).Values), &mml.List{Values: append([]interface{}{}, _rangeFrom, _rangeTo)})}).Values), _and.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _listLength.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, 1)}).Values), &mml.List{Values: append([]interface{}{}, _symbol)})}).Values), _and.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _listLength.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, 2)}).Values), &mml.List{Values: append([]interface{}{}, _symbol, _any)})}).Values), _and.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _listLength.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, 3)}).Values), &mml.List{Values: append([]interface{}{}, _symbol, _rangeFrom, _rangeTo)})}).Values))}).Values)
-			return s
-		}()
-		_startsWithCaseOrDefault = _or.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.List{Values: append([]interface{}{}, func() interface{} {
-			s := &mml.Struct{Values: make(map[string]interface{})}
-			s.Values["name"] = _or.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "case-line", "default-line")}).Values)
-			return s
-		}())}, &mml.List{Values: append([]interface{}{}, _any, func() interface{} {
-			s := &mml.Struct{Values: make(map[string]interface{})}
-			s.Values["name"] = _or.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "case-line", "default-line")}).Values)
-			return s
-		}())})}).Values)
-		_functionCapture = _and.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-			s := &mml.Struct{Values: make(map[string]interface{})}
-			s.Values["nodes"] = &mml.List{Values: append([]interface{}{}, _symbol)}
-			return s
-		}(), _functionParamsAndBody)}).Values)
-		_definitionChild = _and.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _oneChild, func() interface{} {
-			s := &mml.Struct{Values: make(map[string]interface{})}
-			s.Values["nodes"] = &mml.List{Values: append([]interface{}{}, func() interface{} {
-				s := &mml.Struct{Values: make(map[string]interface{})}
-				s.Values["name"] = _or.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "value-definition", "value-definition-group", "mutable-definition-group", "function-definition", "function-definition-group", "effect-definition-group")}).Values)
-				return s
-			}())}
-			return s
-		}())}).Values)
-		_stringOrNamedStringOrInline = func() interface{} {
-			s := &mml.Struct{Values: make(map[string]interface{})}
-			s.Values["nodes"] = _or.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.List{Values: append([]interface{}{}, _stringNode)}, &mml.List{Values: append([]interface{}{}, _symbol, _stringNode)}, &mml.List{Values: append([]interface{}{}, _useInline, _stringNode)})}).Values)
-			return s
-		}()
-		_customValidators = func() interface{} {
-			s := &mml.Struct{Values: make(map[string]interface{})}
-			s.Values["block-comment"] = _oneChild
-			s.Values["string"] = _textLengthMin2
-			s.Values["symbol"] = _symbol
-			s.Values["spread"] = _oneChild
-			s.Values["expression-key"] = _oneChild
-			s.Values["entry"] = _twoChildren
-			s.Values["check-ret"] = _oneChild
-			s.Values["function"] = _functionParamsAndBody
-			s.Values["effect"] = _functionParamsAndBody
-			s.Values["range-from"] = _oneChild
-			s.Values["range-to"] = _oneChild
-			s.Values["symbol-index"] = _symbolChild
-			s.Values["range-index"] = _rangeExpression
-			s.Values["indexer"] = _minTwoChildren
-			s.Values["application"] = _minOneChild
-			s.Values["unary"] = _twoChildren
-			s.Values["binary"] = _minThreeChildren
-			s.Values["chaining"] = _minTwoChildren
-			s.Values["ternary"] = _threeChildren
-			s.Values["if-statement"] = _minTwoChildren
-			s.Values["case-block"] = _minOneChild
-			s.Values["select-case-block"] = _minOneChild
-			s.Values["range-over"] = _rangeOver
-			s.Values["value-capture"] = _symbolAndAny
-			s.Values["mutable-capture"] = _symbolAndAny
-			s.Values["function-capture"] = _functionCapture
-			s.Values["effect-capture"] = _functionCapture
-			s.Values["assign"] = _twoChildren
-			s.Values["send-statement"] = _twoChildren
-			s.Values["receive-statement"] = _oneChild
-			s.Values["go-statement"] = _oneChild
-			s.Values["defer-statement"] = _oneChild
-			s.Values["receive-definition"] = _symbolAndAny
-			s.Values["export-statement"] = _definitionChild
-			s.Values["use-fact"] = _stringOrNamedStringOrInline
-			return s
-		}()
-		_validateCustom = &mml.Function{
+		direct_functionCapture = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"definition", _ast, func() interface{} {
+				s := &mml.Struct{}
+				s.Set("symbol", mml.Ref(direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0)), "name"))
+				s.Set("expression", direct_functionFact(_ast, 1))
+				s.Set("mutable", false)
+				s.Set("exported", false)
+				return s
+			}()})
+		}
+		_functionCapture = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _n = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_n)
-				return (!_has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_n, "name"), _customValidators)}).Values).(bool) || _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_customValidators, mml.Ref(_n, "name")), _n)}).Values).(bool))
+				return direct_functionCapture(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_node = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _n = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_n)
-				return _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _and.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["name"] = _type.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _string)}).Values)
-					s.Values["nodes"] = _listOf.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _predicate.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _node)}).Values))}).Values)
-					s.Values["text"] = _type.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _string)}).Values)
-					s.Values["file"] = _type.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _string)}).Values)
-					s.Values["from"] = _natural
-					s.Values["to"] = _natural
-					s.Values["line"] = _natural
-					s.Values["column"] = _natural
+		direct_effectCapture = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _f interface{}
+			mml.Nop(_f)
+			_f = direct_functionCapture(_ast)
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Merge(_f.(*mml.Struct))
+				s.Set("expression", func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(mml.Ref(_f, "expression").(*mml.Struct))
+					s.Set("effect", true)
 					return s
-				}(), _predicate.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _validateCustom)}).Values))}).Values), _n)}).Values)
+				}())
+				return s
+			}()
+		}
+		_effectCapture = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_effectCapture(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_do = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _n = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_n)
-				var _nc interface{}
-				mml.Nop(_nc)
-				_nc = func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					func() {
-						sp := _n.(*mml.Struct)
-						for k, v := range sp.Values {
-							s.Values[k] = v
-						}
+		direct_functionDefinition = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				c = mml.BinaryOp(15, _len.(*mml.Function).Call([]interface{}{mml.Ref(_ast, "nodes")}), 1)
+				if c.(bool) {
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Set("docs", direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0)))
+						s.Merge(direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 1)).(*mml.Struct))
+						return s
 					}()
-					s.Values["nodes"] = _dropComments.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_n, "nodes"))}).Values)
-					return s
-				}()
-				return func() interface{} {
-					c = _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _predicate.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _node)}).Values), _nc)}).Values)
-					if c.(bool) {
-						return _n
-					} else {
-						return _error.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "invalid AST")}).Values)
-					}
-				}()
-				return nil
+				} else {
+					return direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0))
+				}
+			}()
+		}
+		_functionDefinition = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_functionDefinition(a[0])
 			},
 			FixedArgs: 1,
 		}
-		exports["do"] = _do
-
-		return exports
-	})
-
-	modulePath = "code"
-
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
-		exports := make(map[string]interface{})
-
-		var c interface{}
-		mml.Nop(c)
-
-		var _keywords interface{}
-		var _controlStatement interface{}
-		var _breakControl interface{}
-		var _continueControl interface{}
-		var _unaryOp interface{}
-		var _binaryNot interface{}
-		var _plus interface{}
-		var _minus interface{}
-		var _logicalNot interface{}
-		var _binaryOp interface{}
-		var _binaryAnd interface{}
-		var _binaryOr interface{}
-		var _xor interface{}
-		var _andNot interface{}
-		var _lshift interface{}
-		var _rshift interface{}
-		var _mul interface{}
-		var _div interface{}
-		var _mod interface{}
-		var _add interface{}
-		var _sub interface{}
-		var _equals interface{}
-		var _notEq interface{}
-		var _less interface{}
-		var _lessOrEq interface{}
-		var _greater interface{}
-		var _greaterOrEq interface{}
-		var _logicalAnd interface{}
-		var _logicalOr interface{}
-		var _builtin interface{}
-		var _flattenedStatements interface{}
-		var _getModuleName interface{}
-		var _fold interface{}
-		var _foldr interface{}
-		var _map interface{}
-		var _filter interface{}
-		var _contains interface{}
-		var _sort interface{}
-		var _flat interface{}
-		var _flats interface{}
-		var _concat interface{}
-		var _concats interface{}
-		var _uniq interface{}
-		var _every interface{}
-		var _some interface{}
-		var _join interface{}
-		var _joins interface{}
-		var _formats interface{}
-		var _enum interface{}
-		var _log interface{}
-		var _fatal interface{}
-		var _bind interface{}
-		var _identity interface{}
-		var _eq interface{}
-		var _any interface{}
-		var _function interface{}
-		var _channel interface{}
-		var _natural interface{}
-		var _type interface{}
-		var _listOf interface{}
-		var _structOf interface{}
-		var _range interface{}
-		var _rangeMin interface{}
-		var _listLength interface{}
-		var _or interface{}
-		var _and interface{}
-		var _not interface{}
-		var _predicate interface{}
-		var _predicates interface{}
-		var _is interface{}
-		mml.Nop(_keywords, _controlStatement, _breakControl, _continueControl, _unaryOp, _binaryNot, _plus, _minus, _logicalNot, _binaryOp, _binaryAnd, _binaryOr, _xor, _andNot, _lshift, _rshift, _mul, _div, _mod, _add, _sub, _equals, _notEq, _less, _lessOrEq, _greater, _greaterOrEq, _logicalAnd, _logicalOr, _builtin, _flattenedStatements, _getModuleName, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _flats, _concat, _concats, _uniq, _every, _some, _join, _joins, _formats, _enum, _log, _fatal, _bind, _identity, _eq, _any, _function, _channel, _natural, _type, _listOf, _structOf, _range, _rangeMin, _listLength, _or, _and, _not, _predicate, _predicates, _is)
-		var __lang = mml.Modules.Use("lang")
-		_fold = __lang.Values["fold"]
-		_foldr = __lang.Values["foldr"]
-		_map = __lang.Values["map"]
-		_filter = __lang.Values["filter"]
-		_contains = __lang.Values["contains"]
-		_sort = __lang.Values["sort"]
-		_flat = __lang.Values["flat"]
-		_flats = __lang.Values["flats"]
-		_concat = __lang.Values["concat"]
-		_concats = __lang.Values["concats"]
-		_uniq = __lang.Values["uniq"]
-		_every = __lang.Values["every"]
-		_some = __lang.Values["some"]
-		_join = __lang.Values["join"]
-		_joins = __lang.Values["joins"]
-		_formats = __lang.Values["formats"]
-		_enum = __lang.Values["enum"]
-		_log = __lang.Values["log"]
-		_fatal = __lang.Values["fatal"]
-		_bind = __lang.Values["bind"]
-		_identity = __lang.Values["identity"]
-		_eq = __lang.Values["eq"]
-		_any = __lang.Values["any"]
-		_function = __lang.Values["function"]
-		_channel = __lang.Values["channel"]
-		_natural = __lang.Values["natural"]
-		_type = __lang.Values["type"]
-		_listOf = __lang.Values["listOf"]
-		_structOf = __lang.Values["structOf"]
-		_range = __lang.Values["range"]
-		_rangeMin = __lang.Values["rangeMin"]
-		_listLength = __lang.Values["listLength"]
-		_or = __lang.Values["or"]
-		_and = __lang.Values["and"]
-		_not = __lang.Values["not"]
-		_predicate = __lang.Values["predicate"]
-		_predicates = __lang.Values["predicates"]
-		_is = __lang.Values["is"]
-		_keywords = &mml.List{Values: append([]interface{}{}, "true", "false", "return", "fn", "if", "else", "case", "switch", "default", "send", "receive", "select", "go", "defer", "in", "for", "let", "use", "export")}
-		exports["keywords"] = _keywords
-		_controlStatement = _enum.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["controlStatement"] = _controlStatement
-		_breakControl = _controlStatement.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["breakControl"] = _breakControl
-		_continueControl = _controlStatement.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["continueControl"] = _continueControl
-		_unaryOp = _enum.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["unaryOp"] = _unaryOp
-		_binaryNot = _unaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["binaryNot"] = _binaryNot
-		_plus = _unaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["plus"] = _plus
-		_minus = _unaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["minus"] = _minus
-		_logicalNot = _unaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["logicalNot"] = _logicalNot
-		_binaryOp = _enum.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["binaryOp"] = _binaryOp
-		_binaryAnd = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["binaryAnd"] = _binaryAnd
-		_binaryOr = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["binaryOr"] = _binaryOr
-		_xor = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["xor"] = _xor
-		_andNot = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["andNot"] = _andNot
-		_lshift = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["lshift"] = _lshift
-		_rshift = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["rshift"] = _rshift
-		_mul = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["mul"] = _mul
-		_div = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["div"] = _div
-		_mod = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["mod"] = _mod
-		_add = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["add"] = _add
-		_sub = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["sub"] = _sub
-		_equals = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["equals"] = _equals
-		_notEq = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["notEq"] = _notEq
-		_less = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["less"] = _less
-		_lessOrEq = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["lessOrEq"] = _lessOrEq
-		_greater = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["greater"] = _greater
-		_greaterOrEq = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["greaterOrEq"] = _greaterOrEq
-		_logicalAnd = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["logicalAnd"] = _logicalAnd
-		_logicalOr = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-		exports["logicalOr"] = _logicalOr
-		_builtin = func() interface{} {
-			s := &mml.Struct{Values: make(map[string]interface{})}
-			s.Values["len"] = "Len"
-			s.Values["isError"] = "IsError"
-			s.Values["keys"] = "Keys"
-			s.Values["format"] = "Format"
-			s.Values["stdin"] = "Stdin"
-			s.Values["stdout"] = "Stdout"
-			s.Values["stderr"] = "Stderr"
-			s.Values["int"] = "Int"
-			s.Values["float"] = "Float"
-			s.Values["string"] = "String"
-			s.Values["bool"] = "Bool"
-			s.Values["has"] = "Has"
-			s.Values["isBool"] = "IsBool"
-			s.Values["isInt"] = "IsInt"
-			s.Values["isFloat"] = "IsFloat"
-			s.Values["isString"] = "IsString"
-			s.Values["isList"] = "IsList"
-			s.Values["isStruct"] = "IsStruct"
-			s.Values["isFunction"] = "IsFunction"
-			s.Values["isChannel"] = "IsChannel"
-			s.Values["exit"] = "Exit"
-			s.Values["error"] = "Error"
-			s.Values["panic"] = "Panic"
-			s.Values["open"] = "Open"
-			s.Values["close"] = "Close"
-			s.Values["args"] = "Args"
-			s.Values["parseAST"] = "ParseAST"
-			s.Values["parseInt"] = "ParseInt"
-			s.Values["parseFloat"] = "ParseFloat"
-			return s
-		}()
-		exports["builtin"] = _builtin
-		_flattenedStatements = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _itemType = a[0]
-				var _listType = a[1]
-				var _listProp = a[2]
-				var _statements = a[3]
-				var _ interface{}
-				_ = &mml.List{a[4:]}
-				mml.Nop(_itemType, _listType, _listProp, _statements)
-				var _type interface{}
-				var _toList interface{}
-				mml.Nop(_type, _toList)
-				_type = &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _s = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_s)
-						return (_has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "type", _s)}).Values).(bool) && _contains.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_s, "type"), &mml.List{Values: append([]interface{}{}, _itemType, _listType)})}).Values).(bool))
-					},
-					FixedArgs: 1,
-				}
-				_toList = &mml.Function{
+		direct_effectDefinitionGroup = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _d interface{}
+			mml.Nop(_d)
+			_d = direct_definitionGroup(_ast)
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Merge(_d.(*mml.Struct))
+				s.Set("definitions", _map.(*mml.Function).Call([]interface{}{&mml.Function{
 					F: func(a []interface{}) interface{} {
 						var c interface{}
 						mml.Nop(c)
-						var _s = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_s)
+						var _d = a[0]
+						mml.Nop(_d)
 						return func() interface{} {
-							c = mml.BinaryOp(11, mml.Ref(_s, "type"), _itemType)
-							if c.(bool) {
-								return &mml.List{Values: append([]interface{}{}, _s)}
-							} else {
-								return mml.Ref(_s, _listProp)
-							}
+							s := &mml.Struct{}
+							s.Merge(_d.(*mml.Struct))
+							s.Set("expression", func() interface{} {
+								s := &mml.Struct{}
+								s.Merge(mml.Ref(_d, "expression").(*mml.Struct))
+								s.Set("effect", true)
+								return s
+							}())
+							return s
 						}()
 					},
 					FixedArgs: 1,
-				}
-				return _flat.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _toList)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _type)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _statements)}).Values))}).Values))}).Values)
-				return nil
-			},
-			FixedArgs: 4,
+				}, mml.Ref(_d, "definitions")}))
+				return s
+			}()
 		}
-		exports["flattenedStatements"] = _flattenedStatements
-		_getModuleName = &mml.Function{
+		_effectDefinitionGroup = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _path = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_path)
-				return _path
+				return direct_effectDefinitionGroup(a[0])
 			},
 			FixedArgs: 1,
 		}
-		exports["getModuleName"] = _getModuleName
-
-		return exports
-	})
-
-	modulePath = "structs"
-
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
-		exports := make(map[string]interface{})
-
-		var c interface{}
-		mml.Nop(c)
-
-		var _merge interface{}
-		var _merges interface{}
-		var _get interface{}
-		var _set interface{}
-		var _index interface{}
-		var _values interface{}
-		var _filterByKeys interface{}
-		var _map interface{}
-		var _lists interface{}
-		mml.Nop(_merge, _merges, _get, _set, _index, _values, _filterByKeys, _map, _lists)
-		_lists = mml.Modules.Use("lists")
-		_merge = &mml.Function{
+		direct_exportDefinition = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _d interface{}
+			var _dl interface{}
+			var _edl interface{}
+			mml.Nop(_d, _dl, _edl)
+			_d = direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0))
+			_dl = func() interface{} {
+				c = mml.BinaryOp(11, mml.Ref(_d, "type"), "definition")
+				if c.(bool) {
+					return (&mml.List{}).Append(_d)
+				} else {
+					return mml.Ref(_d, "definitions")
+				}
+			}()
+			_edl = _map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _d = a[0]
+					mml.Nop(_d)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_d.(*mml.Struct))
+						s.Set("exported", true)
+						return s
+					}()
+				},
+				FixedArgs: 1,
+			}, _dl})
+			return _create.(*mml.Function).Call([]interface{}{"definition-group", _ast, func() interface{} { s := &mml.Struct{}; s.Set("definitions", _edl); ; return s }()})
+		}
+		_exportDefinition = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_s)
-				return mml.Ref(_lists, "fold").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
+				return direct_exportDefinition(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_exportUse = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _l interface{}
+			mml.Nop(_l)
+			_l = direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0))
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Merge(_l.(*mml.Struct))
+				s.Set("uses", _map.(*mml.Function).Call([]interface{}{&mml.Function{
 					F: func(a []interface{}) interface{} {
 						var c interface{}
 						mml.Nop(c)
-						var _i = a[0]
-						var _s = a[1]
-						var _ interface{}
-						_ = &mml.List{a[2:]}
-						mml.Nop(_i, _s)
+						var _u = a[0]
+						mml.Nop(_u)
 						return func() interface{} {
-							s := &mml.Struct{Values: make(map[string]interface{})}
-							func() {
-								sp := _s.(*mml.Struct)
-								for k, v := range sp.Values {
-									s.Values[k] = v
-								}
-							}()
-							func() {
-								sp := _i.(*mml.Struct)
-								for k, v := range sp.Values {
-									s.Values[k] = v
-								}
-							}()
+							s := &mml.Struct{}
+							s.Merge(_u.(*mml.Struct))
+							s.Set("exported", true)
 							return s
 						}()
 					},
-					FixedArgs: 2,
-				}, func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; ; return s }(), _s)}).Values)
+					FixedArgs: 1,
+				}, mml.Ref(_l, "uses")}))
+				return s
+			}()
+		}
+		_exportUse = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_exportUse(a[0])
 			},
 			FixedArgs: 1,
 		}
-		exports["merge"] = _merge
-		_merges = &mml.Function{
+		direct_exportSelectedUse = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"use-list", _ast, func() interface{} {
+				s := &mml.Struct{}
+				s.Set("uses", (&mml.List{}).Append(func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 1)).(*mml.Struct))
+					s.Set("exported", true)
+					s.Set("selection", _map.(*mml.Function).Call([]interface{}{&mml.Function{
+						F: func(a []interface{}) interface{} {
+							var c interface{}
+							mml.Nop(c)
+							var _s = a[0]
+							mml.Nop(_s)
+							return mml.Ref(_s, "text")
+						},
+						FixedArgs: 1,
+					}, mml.Ref(mml.Ref(mml.Ref(_ast, "nodes"), 0), "nodes")}))
+					return s
+				}()))
+				return s
+			}()})
+		}
+		_exportSelectedUse = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s interface{}
-				_s = &mml.List{a[0:]}
-				mml.Nop(_s)
-				return _merge.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _s)}).Values)
+				return direct_exportSelectedUse(a[0])
 			},
-			FixedArgs: 0,
+			FixedArgs: 1,
 		}
-		exports["merges"] = _merges
-		_get = &mml.Function{
+		direct_exportStatement = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			switch mml.Ref(mml.Ref(mml.Ref(_ast, "nodes"), 0), "name") {
+			case "use-modules":
+
+				mml.Nop()
+				return direct_exportUse(_ast)
+			case "use-selection":
+
+				mml.Nop()
+				return direct_exportSelectedUse(_ast)
+			default:
+
+				mml.Nop()
+				return direct_exportDefinition(_ast)
+			}
+		}
+		_exportStatement = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _key = a[0]
-				var _s = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_key, _s)
-				return mml.Ref(_s, _key)
+				return direct_exportStatement(a[0])
 			},
-			FixedArgs: 2,
+			FixedArgs: 1,
 		}
-		exports["get"] = _get
-		_set = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _key = a[0]
-				var _value = a[1]
-				var _s = a[2]
-				var _ interface{}
-				_ = &mml.List{a[3:]}
-				mml.Nop(_key, _value, _s)
-				return func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					func() {
-						sp := _s.(*mml.Struct)
-						for k, v := range sp.Values {
-							s.Values[k] = v
-						}
-					}()
-					s.Values[_key.(string)] = _value
+		direct_useFact = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _createUse interface{}
+			mml.Nop(_createUse)
+			_createUse = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _props interface{}
+					_props = mml.NewList(a[0:])
+					mml.Nop(_props)
+					return _create.(*mml.Function).Call(append([]interface{}{"use", _ast, func() interface{} { s := &mml.Struct{}; s.Set("effect", false); ; return s }()}, _props.(*mml.List).Values()...))
+				},
+				FixedArgs: 0,
+			}
+			switch mml.Ref(mml.Ref(mml.Ref(_ast, "nodes"), 0), "name") {
+			case "use-inline":
+
+				mml.Nop()
+				return _createUse.(*mml.Function).Call([]interface{}{func() interface{} {
+					s := &mml.Struct{}
+					s.Set("capture", ".")
+					s.Set("path", direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 1)))
 					return s
-				}()
-			},
-			FixedArgs: 3,
+				}()})
+			case "symbol":
+
+				mml.Nop()
+				return _createUse.(*mml.Function).Call([]interface{}{func() interface{} {
+					s := &mml.Struct{}
+					s.Set("capture", mml.Ref(direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0)), "name"))
+					s.Set("path", direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 1)))
+					return s
+				}()})
+			default:
+
+				mml.Nop()
+				return _createUse.(*mml.Function).Call([]interface{}{func() interface{} {
+					s := &mml.Struct{}
+					s.Set("path", direct_parse(mml.Ref(mml.Ref(_ast, "nodes"), 0)))
+					return s
+				}()})
+			}
 		}
-		exports["set"] = _set
-		_index = &mml.Function{
+		_useFact = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _key = a[0]
-				var _s = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_key, _s)
-				return mml.Ref(_lists, "fold").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _i = a[0]
-						var _s = a[1]
-						var _ interface{}
-						_ = &mml.List{a[2:]}
-						mml.Nop(_i, _s)
-						return func() interface{} {
-							s := &mml.Struct{Values: make(map[string]interface{})}
-							func() {
-								sp := _s.(*mml.Struct)
-								for k, v := range sp.Values {
-									s.Values[k] = v
-								}
-							}()
-							s.Values[mml.Ref(_i, _key).(string)] = _i
-							return s
-						}()
-					},
-					FixedArgs: 2,
-				}, func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; ; return s }(), _s)}).Values)
+				return direct_useFact(a[0])
 			},
-			FixedArgs: 2,
+			FixedArgs: 1,
 		}
-		exports["index"] = _index
-		_values = &mml.Function{
+		direct_useEffect = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Merge(direct_useFact(_ast).(*mml.Struct))
+				s.Set("effect", true)
+				return s
+			}()
+		}
+		_useEffect = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_s)
-				return mml.Ref(_lists, "map").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _key = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_key)
-						return mml.Ref(_s, _key)
-					},
-					FixedArgs: 1,
-				}, _keys.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _s)}).Values))}).Values)
+				return direct_useEffect(a[0])
 			},
 			FixedArgs: 1,
 		}
-		exports["values"] = _values
-		_filterByKeys = &mml.Function{
+		direct_useList = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"use-list", _ast, func() interface{} {
+				s := &mml.Struct{}
+				s.Set("uses", _map.(*mml.Function).Call([]interface{}{_parse, mml.Ref(_ast, "nodes")}))
+				return s
+			}()})
+		}
+		_useList = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _k = a[0]
-				var _s = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_k, _s)
-				return mml.Ref(_lists, "fold").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _ki = a[0]
-						var _f = a[1]
-						var _ interface{}
-						_ = &mml.List{a[2:]}
-						mml.Nop(_ki, _f)
-						return func() interface{} {
-							s := &mml.Struct{Values: make(map[string]interface{})}
-							func() {
-								sp := _f.(*mml.Struct)
-								for k, v := range sp.Values {
-									s.Values[k] = v
-								}
-							}()
-							s.Values[_ki.(string)] = mml.Ref(_s, _ki)
-							return s
-						}()
-					},
-					FixedArgs: 2,
-				}, func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; ; return s }())}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_lists, "intersect").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _k)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _keys.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _s)}).Values))}).Values))}).Values)
+				return direct_useList(a[0])
 			},
-			FixedArgs: 2,
+			FixedArgs: 1,
 		}
-		exports["filterByKeys"] = _filterByKeys
-		_map = &mml.Function{
+		direct_module = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _create.(*mml.Function).Call([]interface{}{"module", _ast, func() interface{} { s := &mml.Struct{}; s.Set("body", direct_statementList(_ast)); ; return s }()})
+		}
+		_module = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _f = a[0]
-				var _s = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_f, _s)
-				return _merge.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_lists, "map").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _key = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_key)
-						return func() interface{} {
-							s := &mml.Struct{Values: make(map[string]interface{})}
-							s.Values["key"] = _f.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_s, _key))}).Values)
-							return s
-						}()
-					},
-					FixedArgs: 1,
-				})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _keys.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _s)}).Values))}).Values))}).Values)
+				return direct_module(a[0])
 			},
-			FixedArgs: 2,
+			FixedArgs: 1,
 		}
-		exports["map"] = _map
+		direct_parse = func(_ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _a interface{}
+			var _code interface{}
+			mml.Nop(_a, _code)
+			switch mml.Ref(_ast, "name") {
+			case "line-comment-content":
 
-		return exports
-	})
+				mml.Nop()
+				return direct_commentLine(_ast)
+			case "line-comment":
 
-	modulePath = "errors"
+				mml.Nop()
+				return direct_lineComment(_ast)
+			case "block-comment-content":
 
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
-		exports := make(map[string]interface{})
+				mml.Nop()
+				return direct_blockCommentContent(_ast)
+			case "block-comment":
 
-		var c interface{}
-		mml.Nop(c)
+				mml.Nop()
+				return direct_blockComment(_ast)
+			case "int":
 
-		var _only interface{}
-		var _pass interface{}
-		var _any interface{}
-		var _lists interface{}
-		var _functions interface{}
-		mml.Nop(_only, _pass, _any, _lists, _functions)
-		_lists = mml.Modules.Use("lists")
-		_functions = mml.Modules.Use("functions")
-		_only = mml.Ref(_functions, "bind").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_functions, "only"), _isError)}).Values)
-		exports["only"] = _only
-		_pass = mml.Ref(_functions, "bind").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_functions, "only"), mml.Ref(_functions, "not").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _isError)}).Values))}).Values)
-		exports["pass"] = _pass
-		_any = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _l = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_l)
-				var _first interface{}
-				mml.Nop(_first)
-				_first = mml.Ref(_lists, "first").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _isError, _l)}).Values)
-				return func() interface{} {
-					c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _first)}).Values), 0)
-					if c.(bool) {
-						return _l
-					} else {
-						return mml.Ref(_first, 0)
-					}
-				}()
-				return nil
-			},
-			FixedArgs: 1,
-		}
-		exports["any"] = _any
+				mml.Nop()
+				return _intCode.(*mml.Function).Call([]interface{}{_ast})
+			case "float":
 
-		return exports
-	})
+				mml.Nop()
+				return _floatCode.(*mml.Function).Call([]interface{}{_ast})
+			case "string":
 
-	modulePath = "codetree"
+				mml.Nop()
+				return _stringCode.(*mml.Function).Call([]interface{}{_ast})
+			case "true":
 
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
-		exports := make(map[string]interface{})
+				mml.Nop()
+				return _boolCode.(*mml.Function).Call([]interface{}{_ast})
+			case "false":
 
-		var c interface{}
-		mml.Nop(c)
+				mml.Nop()
+				return _boolCode.(*mml.Function).Call([]interface{}{_ast})
+			case "symbol":
 
-		var _removeToken interface{}
-		var _callTransform interface{}
-		var _do interface{}
-		var _edit interface{}
-		var _filter interface{}
-		var _trim interface{}
-		var _structs interface{}
-		var _lists interface{}
-		var _functions interface{}
-		var _errors interface{}
-		mml.Nop(_removeToken, _callTransform, _do, _edit, _filter, _trim, _structs, _lists, _functions, _errors)
-		_structs = mml.Modules.Use("structs")
-		_lists = mml.Modules.Use("lists")
-		_functions = mml.Modules.Use("functions")
-		_errors = mml.Modules.Use("errors")
-		_removeToken = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ interface{}
-				_ = &mml.List{a[0:]}
 				mml.Nop()
-				return _removeToken
-			},
-			FixedArgs: 0,
-		}
-		_callTransform = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _transform = a[0]
-				var _code = a[1]
-				var _fields = a[2]
-				var _listFields = a[3]
-				var _ interface{}
-				_ = &mml.List{a[4:]}
-				mml.Nop(_transform, _code, _fields, _listFields)
-				var _exists interface{}
-				var _fieldResults interface{}
-				var _fieldValues interface{}
-				var _notToRemove interface{}
-				var _listFieldValues interface{}
-				var _results interface{}
-				var _existingFields interface{}
-				var _existingListFields interface{}
-				mml.Nop(_exists, _fieldResults, _fieldValues, _notToRemove, _listFieldValues, _results, _existingFields, _existingListFields)
-				_exists = &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _f = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_f)
-						return _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _f, _code)}).Values)
-					},
-					FixedArgs: 1,
-				}
-				_fieldResults = &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _keys = a[0]
-						var _values = a[1]
-						var _ interface{}
-						_ = &mml.List{a[2:]}
-						mml.Nop(_keys, _values)
-						var _r interface{}
-						mml.Nop(_r)
-						_r = func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; ; return s }()
-						for _i := 0; _i < _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _keys)}).Values).(int); _i++ {
+				return direct_symbol(_ast)
+			}
+			_a = direct_assortComments(_ast)
+			_code = _create.(*mml.Function).Call([]interface{}{"unknown", mml.Ref(_a, "ast")})
+			switch mml.Ref(mml.Ref(_a, "ast"), "name") {
+			case "spread":
 
-							mml.Nop()
-							c = mml.BinaryOp(11, mml.Ref(_values, _i), _removeToken)
-							if c.(bool) {
-								mml.Nop()
-								continue
-							}
-							mml.SetRef(_r, mml.Ref(_keys, _i), mml.Ref(_values, _i))
-						}
-						return func() interface{} {
-							s := &mml.Struct{Values: make(map[string]interface{})}
-							func() {
-								sp := _r.(*mml.Struct)
-								for k, v := range sp.Values {
-									s.Values[k] = v
-								}
-							}()
-							return s
-						}()
-						return nil
-					},
-					FixedArgs: 2,
-				}
-				_existingFields = mml.Ref(_lists, "filter").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _exists, _fields)}).Values)
-				_existingListFields = mml.Ref(_lists, "filter").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _exists, _listFields)}).Values)
-				_fieldValues = mml.Ref(_lists, "map").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _f = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_f)
-						return _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _transform, mml.Ref(_code, _f))}).Values)
-					},
-					FixedArgs: 1,
-				}, _existingFields)}).Values)
-				if v := _fieldValues; mml.IsError.F([]interface{}{v}).(bool) {
-					return v
-				}
-				_notToRemove = mml.Ref(_functions, "not").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_functions, "bind").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_functions, "eq"), _removeToken)}).Values))}).Values)
-				_listFieldValues = mml.Ref(_lists, "map").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _f = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_f)
-						return mml.Ref(_errors, "any").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_lists, "filter").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _notToRemove)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_lists, "map").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _transform)}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_code, _f))}).Values))}).Values))}).Values)
-					},
-					FixedArgs: 1,
-				}, _existingListFields)}).Values)
-				if v := mml.Ref(_errors, "any").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _listFieldValues)}).Values); mml.IsError.F([]interface{}{v}).(bool) {
-					return v
-				}
-				_results = func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					func() {
-						sp := _fieldResults.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _existingFields, _fieldValues)}).Values).(*mml.Struct)
-						for k, v := range sp.Values {
-							s.Values[k] = v
-						}
-					}()
-					func() {
-						sp := _fieldResults.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _existingListFields, _listFieldValues)}).Values).(*mml.Struct)
-						for k, v := range sp.Values {
-							s.Values[k] = v
-						}
-					}()
-					return s
-				}()
-				return _transform.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code, _results)}).Values)
-				return nil
-			},
-			FixedArgs: 4,
-		}
-		_do = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _transform = a[0]
-				var _code = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_transform, _code)
-				var _withFieldsAndLists interface{}
-				var _withFields interface{}
-				var _withListFields interface{}
-				var _leaf interface{}
-				mml.Nop(_withFieldsAndLists, _withFields, _withListFields, _leaf)
-				_withFieldsAndLists = _callTransform.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _transform, _code)}).Values)
-				_withFields = &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _keys interface{}
-						_keys = &mml.List{a[0:]}
-						mml.Nop(_keys)
-						return _withFieldsAndLists.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _keys, &mml.List{Values: []interface{}{}})}).Values)
-					},
-					FixedArgs: 0,
-				}
-				_withListFields = &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _keys interface{}
-						_keys = &mml.List{a[0:]}
-						mml.Nop(_keys)
-						return _withFieldsAndLists.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.List{Values: []interface{}{}}, _keys)}).Values)
-					},
-					FixedArgs: 0,
-				}
-				_leaf = &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _ interface{}
-						_ = &mml.List{a[0:]}
-						mml.Nop()
-						return _withFieldsAndLists.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.List{Values: []interface{}{}}, &mml.List{Values: []interface{}{}})}).Values)
-					},
-					FixedArgs: 0,
-				}
-				switch mml.Ref(_code, "type") {
-				case "spread":
+				mml.Nop()
+				_code = direct_spread(mml.Ref(_a, "ast"))
+			case "list":
 
-					mml.Nop()
-					return _withFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "value")}).Values)
-				case "list":
+				mml.Nop()
+				_code = direct_list(mml.Ref(_a, "ast"))
+			case "mutable-list":
 
-					mml.Nop()
-					return _withListFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "values")}).Values)
-				case "mutable-list":
+				mml.Nop()
+				_code = direct_mutableList(mml.Ref(_a, "ast"))
+			case "expression-key":
 
-					mml.Nop()
-					return _withListFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "values")}).Values)
-				case "expression-key":
+				mml.Nop()
+				_code = direct_expressionKey(mml.Ref(_a, "ast"))
+			case "entry":
 
-					mml.Nop()
-					return _withFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "value")}).Values)
-				case "entry":
+				mml.Nop()
+				_code = direct_entry(mml.Ref(_a, "ast"))
+			case "struct":
 
-					mml.Nop()
-					return _withFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "key", "value")}).Values)
-				case "struct":
+				mml.Nop()
+				_code = direct_struct(mml.Ref(_a, "ast"))
+			case "mutable-struct":
 
-					mml.Nop()
-					return _withListFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "entries")}).Values)
-				case "mutable-struct":
+				mml.Nop()
+				_code = direct_mutableStruct(mml.Ref(_a, "ast"))
+			case "ret":
 
-					mml.Nop()
-					return _withListFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "entries")}).Values)
-				case "ret":
+				mml.Nop()
+				_code = direct_ret(mml.Ref(_a, "ast"))
+			case "check-ret":
 
-					mml.Nop()
-					return _withFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "value")}).Values)
-				case "check-ret":
+				mml.Nop()
+				_code = direct_checkRet(mml.Ref(_a, "ast"))
+			case "block":
 
-					mml.Nop()
-					return _withFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "value")}).Values)
-				case "statement-list":
+				mml.Nop()
+				_code = direct_statementList(mml.Ref(_a, "ast"))
+			case "collect-parameter":
 
-					mml.Nop()
-					return _withListFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "statements")}).Values)
-				case "function":
+				mml.Nop()
+				_code = direct_collectParameter(mml.Ref(_a, "ast"))
+			case "function":
 
-					mml.Nop()
-					return _withFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "body")}).Values)
-				case "range":
+				mml.Nop()
+				_code = direct_functionLiteral(mml.Ref(_a, "ast"))
+			case "effect":
 
-					mml.Nop()
-					return _withFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "from", "to")}).Values)
-				case "indexer":
+				mml.Nop()
+				_code = direct_effect(mml.Ref(_a, "ast"))
+			case "range-from":
 
-					mml.Nop()
-					return _withFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "expression", "index")}).Values)
-				case "application":
+				mml.Nop()
+				_code = direct_rangeExpression(mml.Ref(_a, "ast"))
+			case "range-to":
 
-					mml.Nop()
-					return _withFieldsAndLists.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.List{Values: append([]interface{}{}, "function")}, &mml.List{Values: append([]interface{}{}, "args")})}).Values)
-				case "unary":
+				mml.Nop()
+				_code = direct_rangeExpression(mml.Ref(_a, "ast"))
+			case "symbol-index":
 
-					mml.Nop()
-					return _withFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "arg")}).Values)
-				case "binary":
+				mml.Nop()
+				_code = direct_symbolIndex(mml.Ref(_a, "ast"))
+			case "expression-index":
 
-					mml.Nop()
-					return _withFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "left", "right")}).Values)
-				case "cond":
+				mml.Nop()
+				_code = direct_expressionIndex(mml.Ref(_a, "ast"))
+			case "range-index":
 
-					mml.Nop()
-					return _withFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "condition", "consequent", "alternative")}).Values)
-				case "switch-case":
+				mml.Nop()
+				_code = direct_rangeIndex(mml.Ref(_a, "ast"))
+			case "indexer":
 
-					mml.Nop()
-					return _withFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "expression", "body")}).Values)
-				case "switch-statement":
+				mml.Nop()
+				_code = direct_indexer(mml.Ref(_a, "ast"))
+			case "application":
 
-					mml.Nop()
-					return _withFieldsAndLists.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.List{Values: append([]interface{}{}, "expression", "defaultStatements")}, &mml.List{Values: append([]interface{}{}, "cases")})}).Values)
-				case "send-statement":
+				mml.Nop()
+				_code = direct_application(mml.Ref(_a, "ast"))
+			case "unary":
 
-					mml.Nop()
-					return _withFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "channel", "value")}).Values)
-				case "receive-expression":
+				mml.Nop()
+				_code = direct_unary(mml.Ref(_a, "ast"))
+			case "binary0":
 
-					mml.Nop()
-					return _withFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "channel")}).Values)
-				case "definition":
+				mml.Nop()
+				_code = direct_binary(mml.Ref(_a, "ast"))
+			case "binary1":
 
-					mml.Nop()
-					return _withFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "docs", "expression")}).Values)
-				case "select-case":
+				mml.Nop()
+				_code = direct_binary(mml.Ref(_a, "ast"))
+			case "binary2":
 
-					mml.Nop()
-					return _withFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "expression", "body")}).Values)
-				case "select-statement":
+				mml.Nop()
+				_code = direct_binary(mml.Ref(_a, "ast"))
+			case "binary3":
 
-					mml.Nop()
-					return _withFieldsAndLists.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.List{Values: append([]interface{}{}, "defaultStatements")}, &mml.List{Values: append([]interface{}{}, "cases")})}).Values)
-				case "go-statement":
+				mml.Nop()
+				_code = direct_binary(mml.Ref(_a, "ast"))
+			case "binary4":
 
-					mml.Nop()
-					return _withFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "application")}).Values)
-				case "defer-statement":
+				mml.Nop()
+				_code = direct_binary(mml.Ref(_a, "ast"))
+			case "chaining":
 
-					mml.Nop()
-					return _withFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "application")}).Values)
-				case "range-over":
+				mml.Nop()
+				_code = direct_chaining(mml.Ref(_a, "ast"))
+			case "ternary":
 
-					mml.Nop()
-					return _withFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "expression")}).Values)
-				case "loop":
+				mml.Nop()
+				_code = direct_ternary(mml.Ref(_a, "ast"))
+			case "if-statement":
 
-					mml.Nop()
-					return _withFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "expression", "body")}).Values)
-				case "assign":
+				mml.Nop()
+				_code = direct_ifStatement(mml.Ref(_a, "ast"))
+			case "switch-statement":
 
-					mml.Nop()
-					return _withFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "capture", "value")}).Values)
-				case "definition-group":
+				mml.Nop()
+				_code = direct_switchStatement(mml.Ref(_a, "ast"))
+			case "send-statement":
 
-					mml.Nop()
-					return _withListFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "definitions")}).Values)
-				case "use":
+				mml.Nop()
+				_code = direct_sendStatement(mml.Ref(_a, "ast"))
+			case "receive-expression":
 
-					mml.Nop()
-					return _withFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "path")}).Values)
-				case "use-list":
+				mml.Nop()
+				_code = direct_receiveExpression(mml.Ref(_a, "ast"))
+			case "receive-definition":
 
-					mml.Nop()
-					return _withListFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "uses")}).Values)
-				case "module":
+				mml.Nop()
+				_code = direct_valueCapture(mml.Ref(_a, "ast"))
+			case "select-statement":
 
-					mml.Nop()
-					return _withFields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "body")}).Values)
-				default:
+				mml.Nop()
+				_code = direct_selectStatement(mml.Ref(_a, "ast"))
+			case "go-statement":
 
-					mml.Nop()
-					return _leaf.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-				}
-				return nil
-			},
-			FixedArgs: 2,
-		}
-		_edit = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _transform = a[0]
-				var _code = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_transform, _code)
-				return _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _code = a[0]
-						var _fieldResults = a[1]
-						var _ interface{}
-						_ = &mml.List{a[2:]}
-						mml.Nop(_code, _fieldResults)
-						return _transform.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-							s := &mml.Struct{Values: make(map[string]interface{})}
-							func() {
-								sp := _code.(*mml.Struct)
-								for k, v := range sp.Values {
-									s.Values[k] = v
-								}
-							}()
-							func() {
-								sp := _fieldResults.(*mml.Struct)
-								for k, v := range sp.Values {
-									s.Values[k] = v
-								}
-							}()
-							return s
-						}())}).Values)
-					},
-					FixedArgs: 2,
-				}, _code)}).Values)
-			},
-			FixedArgs: 2,
-		}
-		exports["edit"] = _edit
-		_filter = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _predicate = a[0]
-				var _code = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_predicate, _code)
-				return _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _code = a[0]
-						var _fieldResults = a[1]
-						var _ interface{}
-						_ = &mml.List{a[2:]}
-						mml.Nop(_code, _fieldResults)
-						return func() interface{} {
-							c = _predicate.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-							if c.(bool) {
-								return &mml.List{Values: append(append([]interface{}{}, mml.Ref(_lists, "flatDepth").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.UnaryOp(2, 1), mml.Ref(_structs, "values").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _fieldResults)}).Values))}).Values).(*mml.List).Values...), _code)}
-							} else {
-								return mml.Ref(_lists, "flatDepth").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.UnaryOp(2, 1), mml.Ref(_structs, "values").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _fieldResults)}).Values))}).Values)
-							}
-						}()
-					},
-					FixedArgs: 2,
-				}, _code)}).Values)
-			},
-			FixedArgs: 2,
-		}
-		exports["filter"] = _filter
-		_trim = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _predicate = a[0]
-				var _code = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_predicate, _code)
-				var _result interface{}
-				mml.Nop(_result)
-				_result = _edit.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _code = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_code)
+				mml.Nop()
+				_code = direct_goStatement(mml.Ref(_a, "ast"))
+			case "defer-statement":
+
+				mml.Nop()
+				_code = direct_deferStatement(mml.Ref(_a, "ast"))
+			case "range-over":
+
+				mml.Nop()
+				_code = direct_rangeOver(mml.Ref(_a, "ast"))
+			case "break":
+
+				mml.Nop()
+				_code = _create.(*mml.Function).Call([]interface{}{"break", mml.Ref(_a, "ast")})
+			case "continue":
+
+				mml.Nop()
+				_code = _create.(*mml.Function).Call([]interface{}{"continue", mml.Ref(_a, "ast")})
+			case "loop":
+
+				mml.Nop()
+				_code = direct_loop(mml.Ref(_a, "ast"))
+			case "assign":
+
+				mml.Nop()
+				_code = direct_assign(mml.Ref(_a, "ast"))
+			case "value-capture":
+
+				mml.Nop()
+				_code = direct_valueCapture(mml.Ref(_a, "ast"))
+			case "mutable-capture":
+
+				mml.Nop()
+				_code = direct_mutableCapture(mml.Ref(_a, "ast"))
+			case "value-definition":
+
+				mml.Nop()
+				_code = direct_valueDefinition(mml.Ref(_a, "ast"))
+			case "docs-value-capture":
+
+				mml.Nop()
+				_code = direct_valueDefinition(mml.Ref(_a, "ast"))
+			case "docs-mixed-capture":
+
+				mml.Nop()
+				_code = direct_valueDefinition(mml.Ref(_a, "ast"))
+			case "value-definition-group":
+
+				mml.Nop()
+				_code = direct_definitionGroup(mml.Ref(_a, "ast"))
+			case "mutable-definition-group":
+
+				mml.Nop()
+				_code = direct_mutableDefinitionGroup(mml.Ref(_a, "ast"))
+			case "function-capture":
+
+				mml.Nop()
+				_code = direct_functionCapture(mml.Ref(_a, "ast"))
+			case "effect-capture":
+
+				mml.Nop()
+				_code = direct_effectCapture(mml.Ref(_a, "ast"))
+			case "function-definition":
+
+				mml.Nop()
+				_code = direct_functionDefinition(mml.Ref(_a, "ast"))
+			case "docs-function-capture":
+
+				mml.Nop()
+				_code = direct_functionDefinition(mml.Ref(_a, "ast"))
+			case "docs-mixed-function-capture":
+
+				mml.Nop()
+				_code = direct_functionDefinition(mml.Ref(_a, "ast"))
+			case "function-definition-group":
+
+				mml.Nop()
+				_code = direct_definitionGroup(mml.Ref(_a, "ast"))
+			case "effect-definition-group":
+
+				mml.Nop()
+				_code = direct_effectDefinitionGroup(mml.Ref(_a, "ast"))
+			case "export-statement":
+
+				mml.Nop()
+				_code = direct_exportStatement(mml.Ref(_a, "ast"))
+			case "use-fact":
+
+				mml.Nop()
+				_code = direct_useFact(mml.Ref(_a, "ast"))
+			case "use-effect":
+
+				mml.Nop()
+				_code = direct_useEffect(mml.Ref(_a, "ast"))
+			case "use-modules":
+
+				mml.Nop()
+				_code = direct_useList(mml.Ref(_a, "ast"))
+			case "mml":
+
+				mml.Nop()
+				_code = direct_module(mml.Ref(_a, "ast"))
+			}
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Merge(_code.(*mml.Struct))
+				s.Set("comments", func() interface{} {
+					s := &mml.Struct{}
+					s.Set("code", _map.(*mml.Function).Call([]interface{}{_parse, mml.Ref(mml.Ref(_a, "comments"), "nodes")}))
+					s.Set("indexes", mml.Ref(mml.Ref(_a, "comments"), "indexes"))
+					return s
+				}())
+				return s
+			}()
+		}
+		_parse = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_parse(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_parserError = func(_msg, _ast interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _error.(*mml.Function).Call([]interface{}{_formats.(*mml.Function).Call([]interface{}{"%s:%d:%d:%v", mml.Ref(_ast, "file"), mml.Ref(_ast, "line"), mml.Ref(_ast, "column"), _msg})})
+		}
+		_parserError = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_parserError(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_knownOrError = func(_code interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				c = _is.(*mml.Function).Call([]interface{}{func() interface{} {
+					s := &mml.Struct{}
+					s.Set("type", _not.(*mml.Function).Call([]interface{}{"unknown"}))
+					return s
+				}(), _code})
+				if c.(bool) {
+					return _code
+				} else {
+					return direct_parserError("unknown code", mml.Ref(_code, "ast"))
+				}
+			}()
+		}
+		_knownOrError = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_knownOrError(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_parsePrimitive = func(_code interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			switch mml.Ref(_code, "type") {
+			case "int":
+				var _v interface{}
+				mml.Nop(_v)
+				_v = _parseInt.(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_code, "ast"), "text")})
+				return func() interface{} {
+					c = _isError.(*mml.Function).Call([]interface{}{_v})
+					if c.(bool) {
+						return direct_parserError(_v, mml.Ref(_code, "ast"))
+					} else {
 						return func() interface{} {
-							c = _predicate.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-							if c.(bool) {
-								return _removeToken
-							} else {
-								return _code
-							}
+							s := &mml.Struct{}
+							s.Merge(_code.(*mml.Struct))
+							s.Set("value", _v)
+							return s
 						}()
-					},
-					FixedArgs: 1,
-				}, _code)}).Values)
+					}
+				}()
+			case "float":
+				var _v interface{}
+				mml.Nop(_v)
+				_v = _parseFloat.(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_code, "ast"), "text")})
 				return func() interface{} {
-					c = mml.BinaryOp(11, _result, _removeToken)
+					c = _isError.(*mml.Function).Call([]interface{}{_v})
 					if c.(bool) {
-						return _error.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "root node removed")}).Values)
+						return direct_parserError(_v, mml.Ref(_code, "ast"))
 					} else {
-						return _result
+						return func() interface{} {
+							s := &mml.Struct{}
+							s.Merge(_code.(*mml.Struct))
+							s.Set("value", _v)
+							return s
+						}()
 					}
 				}()
-				return nil
+			case "string":
+
+				mml.Nop()
+				return func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(_code.(*mml.Struct))
+					s.Set("value", mml.Ref(_strings, "unescape").(*mml.Function).Call([]interface{}{mml.RefRange(mml.Ref(mml.Ref(_code, "ast"), "text"), 1, mml.BinaryOp(10, _len.(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_code, "ast"), "text")}), 1))}))
+					return s
+				}()
+			case "bool":
+
+				mml.Nop()
+				return func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(_code.(*mml.Struct))
+					s.Set("value", mml.BinaryOp(11, mml.Ref(mml.Ref(_code, "ast"), "text"), "true"))
+					return s
+				}()
+			default:
+
+				mml.Nop()
+				return _code
+			}
+		}
+		_parsePrimitive = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_parsePrimitive(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_ast = func(_node interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return mml.Ref(_errors, "pass").(*mml.Function).Call([]interface{}{mml.Ref(_validateast, "do"), _parse, mml.Ref(_codetree, "edit").(*mml.Function).Call([]interface{}{_parsePrimitive}), mml.Ref(_codetree, "edit").(*mml.Function).Call([]interface{}{_knownOrError})}).(*mml.Function).Call([]interface{}{_node})
+		}
+		_ast = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_ast(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_do = func(_file, _text interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return mml.Ref(_errors, "pass").(*mml.Function).Call([]interface{}{_parseAST.(*mml.Function).Call([]interface{}{_file}), _ast}).(*mml.Function).Call([]interface{}{_text})
+		}
+		_do = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_do(a[0], a[1])
 			},
 			FixedArgs: 2,
 		}
-		exports["trim"] = _trim
+		exports["do"] = _do
 
 		return exports
 	})
 
-	modulePath = "io"
+	modulePath = "validateast"
+	// evaluated at compile time: symbolChild, collectParameter
 
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
 		exports := make(map[string]interface{})
 
 		var c interface{}
 		mml.Nop(c)
 
-		var _readFile interface{}
+		var _dropComments interface{}
+		var _rangeExpression interface{}
+		var _functionParamsAndBody interface{}
+		var _rangeOver interface{}
+		var _startsWithCaseOrDefault interface{}
+		var _functionCapture interface{}
+		var _definitionChild interface{}
+		var _exportedUse interface{}
+		var _stringOrNamedStringOrInline interface{}
+		var _customValidators interface{}
+		var _validateCustom interface{}
+		var _node interface{}
+		var _minTextLength interface{}
+		var _childCount interface{}
+		var _minChildCount interface{}
+		var _paramsAreSymbols interface{}
+		var _onlyLastParamIsCollect interface{}
+		var _textLengthMin2 interface{}
+		var _noChildren interface{}
+		var _oneChild interface{}
+		var _twoChildren interface{}
+		var _threeChildren interface{}
+		var _minOneChild interface{}
+		var _minTwoChildren interface{}
+		var _minThreeChildren interface{}
+		var _symbol interface{}
+		var _stringNode interface{}
+		var _useInline interface{}
+		var _symbolChild interface{}
+		var _collectParameter interface{}
+		var _rangeFrom interface{}
+		var _rangeTo interface{}
+		var _symbolAndAny interface{}
+		var _comment interface{}
+		var _offset interface{}
+		var _do interface{}
+		var _code interface{}
 		var _fold interface{}
 		var _foldr interface{}
 		var _map interface{}
@@ -6270,8 +5787,6 @@ func init() {
 		var _sort interface{}
 		var _flat interface{}
 		var _flats interface{}
-		var _concat interface{}
-		var _concats interface{}
 		var _uniq interface{}
 		var _every interface{}
 		var _some interface{}
@@ -6298,172 +5813,10938 @@ func init() {
 		var _and interface{}
 		var _not interface{}
 		var _predicate interface{}
-		var _predicates interface{}
 		var _is interface{}
-		mml.Nop(_readFile, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _flats, _concat, _concats, _uniq, _every, _some, _join, _joins, _formats, _enum, _log, _fatal, _bind, _identity, _eq, _any, _function, _channel, _natural, _type, _listOf, _structOf, _range, _rangeMin, _listLength, _or, _and, _not, _predicate, _predicates, _is)
-		var __lang = mml.Modules.Use("lang")
-		_fold = __lang.Values["fold"]
-		_foldr = __lang.Values["foldr"]
-		_map = __lang.Values["map"]
-		_filter = __lang.Values["filter"]
-		_contains = __lang.Values["contains"]
-		_sort = __lang.Values["sort"]
-		_flat = __lang.Values["flat"]
-		_flats = __lang.Values["flats"]
-		_concat = __lang.Values["concat"]
-		_concats = __lang.Values["concats"]
-		_uniq = __lang.Values["uniq"]
-		_every = __lang.Values["every"]
-		_some = __lang.Values["some"]
-		_join = __lang.Values["join"]
-		_joins = __lang.Values["joins"]
-		_formats = __lang.Values["formats"]
-		_enum = __lang.Values["enum"]
-		_log = __lang.Values["log"]
-		_fatal = __lang.Values["fatal"]
-		_bind = __lang.Values["bind"]
-		_identity = __lang.Values["identity"]
-		_eq = __lang.Values["eq"]
-		_any = __lang.Values["any"]
-		_function = __lang.Values["function"]
-		_channel = __lang.Values["channel"]
-		_natural = __lang.Values["natural"]
-		_type = __lang.Values["type"]
-		_listOf = __lang.Values["listOf"]
-		_structOf = __lang.Values["structOf"]
-		_range = __lang.Values["range"]
-		_rangeMin = __lang.Values["rangeMin"]
-		_listLength = __lang.Values["listLength"]
-		_or = __lang.Values["or"]
-		_and = __lang.Values["and"]
-		_not = __lang.Values["not"]
-		_predicate = __lang.Values["predicate"]
-		_predicates = __lang.Values["predicates"]
-		_is = __lang.Values["is"]
-		_readFile = &mml.Function{
+		var direct_dropComments func(interface{}) interface{}
+		mml.Nop(direct_dropComments)
+		var direct_validateCustom func(interface{}) interface{}
+		mml.Nop(direct_validateCustom)
+		var direct_node func(interface{}) interface{}
+		mml.Nop(direct_node)
+		var direct_minTextLength func(interface{}) interface{}
+		mml.Nop(direct_minTextLength)
+		var direct_childCount func(interface{}) interface{}
+		mml.Nop(direct_childCount)
+		var direct_minChildCount func(interface{}) interface{}
+		mml.Nop(direct_minChildCount)
+		var direct_paramsAreSymbols func(interface{}) interface{}
+		mml.Nop(direct_paramsAreSymbols)
+		var direct_onlyLastParamIsCollect func(interface{}) interface{}
+		mml.Nop(direct_onlyLastParamIsCollect)
+		var direct_do func(interface{}) interface{}
+		mml.Nop(direct_do)
+		mml.Nop(_dropComments, _rangeExpression, _functionParamsAndBody, _rangeOver, _startsWithCaseOrDefault, _functionCapture, _definitionChild, _exportedUse, _stringOrNamedStringOrInline, _customValidators, _validateCustom, _node, _minTextLength, _childCount, _minChildCount, _paramsAreSymbols, _onlyLastParamIsCollect, _textLengthMin2, _noChildren, _oneChild, _twoChildren, _threeChildren, _minOneChild, _minTwoChildren, _minThreeChildren, _symbol, _stringNode, _useInline, _symbolChild, _collectParameter, _rangeFrom, _rangeTo, _symbolAndAny, _comment, _offset, _do, _code, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _flats, _uniq, _every, _some, _join, _joins, _formats, _enum, _log, _fatal, _bind, _identity, _eq, _any, _function, _channel, _natural, _type, _listOf, _structOf, _range, _rangeMin, _listLength, _or, _and, _not, _predicate, _is)
+		var __lang = use("lang")
+		_fold = __lang.Get("fold")
+		_foldr = __lang.Get("foldr")
+		_map = __lang.Get("map")
+		_filter = __lang.Get("filter")
+		_contains = __lang.Get("contains")
+		_sort = __lang.Get("sort")
+		_flat = __lang.Get("flat")
+		_flats = __lang.Get("flats")
+		_uniq = __lang.Get("uniq")
+		_every = __lang.Get("every")
+		_some = __lang.Get("some")
+		_join = __lang.Get("join")
+		_joins = __lang.Get("joins")
+		_formats = __lang.Get("formats")
+		_enum = __lang.Get("enum")
+		_log = __lang.Get("log")
+		_fatal = __lang.Get("fatal")
+		_bind = __lang.Get("bind")
+		_identity = __lang.Get("identity")
+		_eq = __lang.Get("eq")
+		_any = __lang.Get("any")
+		_function = __lang.Get("function")
+		_channel = __lang.Get("channel")
+		_natural = __lang.Get("natural")
+		_type = __lang.Get("type")
+		_listOf = __lang.Get("listOf")
+		_structOf = __lang.Get("structOf")
+		_range = __lang.Get("range")
+		_rangeMin = __lang.Get("rangeMin")
+		_listLength = __lang.Get("listLength")
+		_or = __lang.Get("or")
+		_and = __lang.Get("and")
+		_not = __lang.Get("not")
+		_predicate = __lang.Get("predicate")
+		_is = __lang.Get("is")
+		_code = use("code")
+		direct_minTextLength = func(_n interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Set("text", _rangeMin.(*mml.Function).Call([]interface{}{_string, _n}))
+				return s
+			}()
+		}
+		_minTextLength = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _path = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_path)
-				var _f interface{}
-				mml.Nop(_f)
-				_f = _open.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _path)}).Values)
-				if v := _f; mml.IsError.F([]interface{}{v}).(bool) {
-					return v
-				}
-				defer _close.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _f)}).Values)
-				return _f.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.UnaryOp(2, 1))}).Values)
-				return nil
+				return direct_minTextLength(a[0])
 			},
 			FixedArgs: 1,
 		}
-		exports["readFile"] = _readFile
-
-		return exports
-	})
-
-	modulePath = "paths"
-
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
-		exports := make(map[string]interface{})
-
-		var c interface{}
-		mml.Nop(c)
-
-		var _normalize interface{}
-		var _trimExtension interface{}
-		mml.Nop(_normalize, _trimExtension)
-		_normalize = &mml.Function{
+		direct_childCount = func(_n interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Set("nodes", _range.(*mml.Function).Call([]interface{}{_listOf.(*mml.Function).Call([]interface{}{_any}), _n, _n}))
+				return s
+			}()
+		}
+		_childCount = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _path = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_path)
-				return _path
+				return direct_childCount(a[0])
 			},
 			FixedArgs: 1,
 		}
-		exports["normalize"] = _normalize
-		_trimExtension = &mml.Function{
+		direct_minChildCount = func(_n interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Set("nodes", _rangeMin.(*mml.Function).Call([]interface{}{_listOf.(*mml.Function).Call([]interface{}{_any}), _n}))
+				return s
+			}()
+		}
+		_minChildCount = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_minChildCount(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_paramsAreSymbols = func(_n interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _is.(*mml.Function).Call([]interface{}{_listOf.(*mml.Function).Call([]interface{}{_or.(*mml.Function).Call([]interface{}{_symbol, _collectParameter})}), mml.RefRange(mml.Ref(_n, "nodes"), nil, mml.BinaryOp(10, _len.(*mml.Function).Call([]interface{}{mml.Ref(_n, "nodes")}), 1))})
+		}
+		_paramsAreSymbols = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_paramsAreSymbols(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_onlyLastParamIsCollect = func(_n interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return (mml.BinaryOp(13, _len.(*mml.Function).Call([]interface{}{mml.Ref(_n, "nodes")}), 2).(bool) || _is.(*mml.Function).Call([]interface{}{_listOf.(*mml.Function).Call([]interface{}{_symbol}), mml.RefRange(mml.Ref(_n, "nodes"), nil, mml.BinaryOp(10, _len.(*mml.Function).Call([]interface{}{mml.Ref(_n, "nodes")}), 2))}).(bool))
+		}
+		_onlyLastParamIsCollect = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_onlyLastParamIsCollect(a[0])
+			},
+			FixedArgs: 1,
+		}
+		_textLengthMin2 = direct_minTextLength(2)
+		_noChildren = direct_childCount(0)
+		_oneChild = direct_childCount(1)
+		_twoChildren = direct_childCount(2)
+		_threeChildren = direct_childCount(3)
+		_minOneChild = direct_minChildCount(1)
+		_minTwoChildren = direct_minChildCount(2)
+		_minThreeChildren = direct_minChildCount(3)
+		_symbol = func() interface{} { s := &mml.Struct{}; s.Set("name", "symbol"); ; return s }()
+		_stringNode = func() interface{} { s := &mml.Struct{}; s.Set("name", "string"); ; return s }()
+		_useInline = func() interface{} { s := &mml.Struct{}; s.Set("name", "use-inline"); ; return s }()
+		_symbolChild = func() interface{} {
+			s := &mml.Struct{}
+			s.Set("nodes", (&mml.List{}).Append(func() interface{} { s := &mml.Struct{}; s.Set("name", "symbol"); ; return s }()))
+			return s
+		}()
+		_collectParameter = func() interface{} {
+			s := &mml.Struct{}
+			s.Set("name", "collect-parameter")
+			s.Set("nodes", (&mml.List{}).Append(func() interface{} { s := &mml.Struct{}; s.Set("name", "symbol"); ; return s }()))
+			return s
+		}()
+		_rangeFrom = func() interface{} { s := &mml.Struct{}; s.Set("name", "range-from"); ; return s }()
+		_rangeTo = func() interface{} { s := &mml.Struct{}; s.Set("name", "range-to"); ; return s }()
+		_symbolAndAny = func() interface{} {
+			s := &mml.Struct{}
+			s.Set("nodes", (&mml.List{}).Append(_symbol, _any))
+			return s
+		}()
+		_comment = _predicate.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} {
+			s := &mml.Struct{}
+			s.Set("name", _or.(*mml.Function).Call([]interface{}{"line-comment", "block-comment"}))
+			return s
+		}()})})
+		_offset = _and.(*mml.Function).Call([]interface{}{_type.(*mml.Function).Call([]interface{}{_int}), _predicate.(*mml.Function).Call([]interface{}{&mml.Function{
 			F: func(a []interface{}) interface{} {
 				var c interface{}
 				mml.Nop(c)
-				var _path = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_path)
-				return _path
+				var _o = a[0]
+				mml.Nop(_o)
+				return mml.BinaryOp(16, _o, 0)
 			},
 			FixedArgs: 1,
+		}})})
+		direct_dropComments = func(_nodes interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _n = a[0]
+					mml.Nop(_n)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_n.(*mml.Struct))
+						s.Set("nodes", direct_dropComments(mml.Ref(_n, "nodes")))
+						return s
+					}()
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{_not.(*mml.Function).Call([]interface{}{_comment})})}).(*mml.Function).Call([]interface{}{_nodes})})
 		}
-		exports["trimExtension"] = _trimExtension
-
-		return exports
-	})
-
-	modulePath = "compile"
-
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
-		exports := make(map[string]interface{})
-
-		var c interface{}
-		mml.Nop(c)
-
-		var _primitive interface{}
-		var _stringLiteral interface{}
-		var _symbol interface{}
-		var _spread interface{}
-		var _list interface{}
-		var _expressionKey interface{}
-		var _struct interface{}
-		var _getDefinitions interface{}
-		var _getScope interface{}
-		var _paramList interface{}
+		_dropComments = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_dropComments(a[0])
+			},
+			FixedArgs: 1,
+		}
+		_rangeExpression = func() interface{} {
+			s := &mml.Struct{}
+			s.Set("nodes", _or.(*mml.Function).Call([]interface{}{_listLength.(*mml.Function).Call([]interface{}{0}), _and.(*mml.Function).Call([]interface{}{_listLength.(*mml.Function).Call([]interface{}{1}), (&mml.List{}).Append(_or.(*mml.Function).Call([]interface{}{_rangeFrom, _rangeTo}))}), _and.(*mml.Function).Call([]interface{}{_listLength.(*mml.Function).Call([]interface{}{2}), (&mml.List{}).Append(_rangeFrom, _rangeTo)})}))
+			return s
+		}()
+		_functionParamsAndBody = _and.(*mml.Function).Call([]interface{}{_minOneChild, _predicate.(*mml.Function).Call([]interface{}{_paramsAreSymbols}), _predicate.(*mml.Function).Call([]interface{}{_onlyLastParamIsCollect})})
+		_rangeOver = func() interface{} {
+			s := &mml.Struct{}
+			s.Set("nodes", _or.(*mml.Function).Call([]interface{}{_listLength.(*mml.Function).Call([]interface{}{0}), _and.(*mml.Function).Call([]interface{}{_listLength.(*mml.Function).Call([]interface{}{1}), (&mml.List{}).Append(_or.(*mml.Function).Call([]interface{}{_rangeFrom, _rangeTo}))}), _and.(*mml.Function).Call([]interface{}{_listLength.(*mml.Function).Call([]interface{}{2}), (&mml.List{}).Append(_rangeFrom, _rangeTo)}), _and.(*mml.Function).Call([]interface{}{_listLength.(*mml.Function).Call([]interface{}{1}), (&mml.List{}).Append(_symbol)}), _and.(*mml.Function).Call([]interface{}{_listLength.(*mml.Function).Call([]interface{}{2}), (&mml.List{}).Append(_symbol, _any)}), _and.(*mml.Function).Call([]interface{}{_listLength.(*mml.Function).Call([]interface{}{3}), (&mml.List{}).Append(_symbol, _rangeFrom, _rangeTo)})}))
+			return s
+		}()
+		_startsWithCaseOrDefault = _or.(*mml.Function).Call([]interface{}{(&mml.List{}).Append(func() interface{} {
+			s := &mml.Struct{}
+			s.Set("name", _or.(*mml.Function).Call([]interface{}{"case-line", "default-line"}))
+			return s
+		}()), (&mml.List{}).Append(_any, func() interface{} {
+			s := &mml.Struct{}
+			s.Set("name", _or.(*mml.Function).Call([]interface{}{"case-line", "default-line"}))
+			return s
+		}())})
+		_functionCapture = _and.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("nodes", (&mml.List{}).Append(_symbol)); ; return s }(), _functionParamsAndBody})
+		_definitionChild = _and.(*mml.Function).Call([]interface{}{_oneChild, func() interface{} {
+			s := &mml.Struct{}
+			s.Set("nodes", (&mml.List{}).Append(func() interface{} {
+				s := &mml.Struct{}
+				s.Set("name", _or.(*mml.Function).Call([]interface{}{"value-definition", "value-definition-group", "mutable-definition-group", "function-definition", "function-definition-group", "effect-definition-group"}))
+				return s
+			}()))
+			return s
+		}()})
+		_exportedUse = _or.(*mml.Function).Call([]interface{}{func() interface{} {
+			s := &mml.Struct{}
+			s.Set("nodes", (&mml.List{}).Append(func() interface{} { s := &mml.Struct{}; s.Set("name", "use-modules"); ; return s }()))
+			return s
+		}(), func() interface{} {
+			s := &mml.Struct{}
+			s.Set("nodes", (&mml.List{}).Append(func() interface{} { s := &mml.Struct{}; s.Set("name", "use-selection"); ; return s }(), func() interface{} {
+				s := &mml.Struct{}
+				s.Set("name", _or.(*mml.Function).Call([]interface{}{"use-fact", "use-effect"}))
+				return s
+			}()))
+			return s
+		}()})
+		_stringOrNamedStringOrInline = func() interface{} {
+			s := &mml.Struct{}
+			s.Set("nodes", _or.(*mml.Function).Call([]interface{}{(&mml.List{}).Append(_stringNode), (&mml.List{}).Append(_symbol, _stringNode), (&mml.List{}).Append(_useInline, _stringNode)}))
+			return s
+		}()
+		_customValidators = func() interface{} {
+			s := &mml.Struct{}
+			s.Set("block-comment", _oneChild)
+			s.Set("string", _textLengthMin2)
+			s.Set("symbol", _symbol)
+			s.Set("spread", _oneChild)
+			s.Set("expression-key", _oneChild)
+			s.Set("entry", _twoChildren)
+			s.Set("check-ret", _oneChild)
+			s.Set("function", _functionParamsAndBody)
+			s.Set("effect", _functionParamsAndBody)
+			s.Set("range-from", _oneChild)
+			s.Set("range-to", _oneChild)
+			s.Set("symbol-index", _or.(*mml.Function).Call([]interface{}{_symbolChild, _noChildren}))
+			s.Set("range-index", _rangeExpression)
+			s.Set("indexer", _minTwoChildren)
+			s.Set("application", _minOneChild)
+			s.Set("unary", _twoChildren)
+			s.Set("binary", _minThreeChildren)
+			s.Set("chaining", _minTwoChildren)
+			s.Set("ternary", _threeChildren)
+			s.Set("if-statement", _minTwoChildren)
+			s.Set("case-block", _minOneChild)
+			s.Set("select-case-block", _minOneChild)
+			s.Set("range-over", _rangeOver)
+			s.Set("value-capture", _symbolAndAny)
+			s.Set("mutable-capture", _symbolAndAny)
+			s.Set("function-capture", _functionCapture)
+			s.Set("effect-capture", _functionCapture)
+			s.Set("assign", _twoChildren)
+			s.Set("send-statement", _twoChildren)
+			s.Set("receive-statement", _oneChild)
+			s.Set("go-statement", _oneChild)
+			s.Set("defer-statement", _oneChild)
+			s.Set("receive-definition", _symbolAndAny)
+			s.Set("export-statement", _or.(*mml.Function).Call([]interface{}{_definitionChild, _exportedUse}))
+			s.Set("use-selection", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("nodes", _rangeMin.(*mml.Function).Call([]interface{}{_listOf.(*mml.Function).Call([]interface{}{_symbol}), 1}))
+				return s
+			}())
+			s.Set("use-fact", _stringOrNamedStringOrInline)
+			return s
+		}()
+		direct_validateCustom = func(_n interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return (!_has.(*mml.Function).Call([]interface{}{mml.Ref(_n, "name"), _customValidators}).(bool) || _is.(*mml.Function).Call([]interface{}{mml.Ref(_customValidators, mml.Ref(_n, "name")), _n}).(bool))
+		}
+		_validateCustom = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_validateCustom(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_node = func(_n interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _is.(*mml.Function).Call([]interface{}{_and.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("name", _type.(*mml.Function).Call([]interface{}{_string}))
+				s.Set("nodes", _listOf.(*mml.Function).Call([]interface{}{_predicate.(*mml.Function).Call([]interface{}{_node})}))
+				s.Set("text", _type.(*mml.Function).Call([]interface{}{_string}))
+				s.Set("file", _type.(*mml.Function).Call([]interface{}{_string}))
+				s.Set("from", _offset)
+				s.Set("to", _offset)
+				s.Set("line", _offset)
+				s.Set("column", _offset)
+				return s
+			}(), _predicate.(*mml.Function).Call([]interface{}{_validateCustom})}), _n})
+		}
+		_node = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_node(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_do = func(_n interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _nc interface{}
+			mml.Nop(_nc)
+			_nc = func() interface{} {
+				s := &mml.Struct{}
+				s.Merge(_n.(*mml.Struct))
+				s.Set("nodes", direct_dropComments(mml.Ref(_n, "nodes")))
+				return s
+			}()
+			return func() interface{} {
+				c = _is.(*mml.Function).Call([]interface{}{_predicate.(*mml.Function).Call([]interface{}{_node}), _nc})
+				if c.(bool) {
+					return _n
+				} else {
+					return _error.(*mml.Function).Call([]interface{}{"invalid AST"})
+				}
+			}()
+		}
+		_do = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_do(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["do"] = _do
+
+		return exports
+	})
+
+	modulePath = "code"
+	// evaluated at compile time: binaryNot, plus, minus, logicalNot, binaryAnd, binaryOr, xor, andNot, lshift, rshift, mul, div, mod, add, sub, equals, notEq, less, lessOrEq, greater, greaterOrEq, logicalAnd, logicalOr
+
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
+		exports := make(map[string]interface{})
+
+		var c interface{}
+		mml.Nop(c)
+
+		var _controlStatement interface{}
+		var _unaryOp interface{}
+		var _binaryNot int
+		var _plus int
+		var _minus int
+		var _logicalNot int
+		var _binaryOp interface{}
+		var _binaryAnd int
+		var _binaryOr int
+		var _xor int
+		var _andNot int
+		var _lshift int
+		var _rshift int
+		var _mul int
+		var _div int
+		var _mod int
+		var _add int
+		var _sub int
+		var _equals int
+		var _notEq int
+		var _less int
+		var _lessOrEq int
+		var _greater int
+		var _greaterOrEq int
+		var _logicalAnd int
+		var _logicalOr int
+		var _builtin interface{}
+		var _getDefinitions interface{}
+		var _getScope interface{}
+		var _getModuleName interface{}
+		var _structs interface{}
+		var _paths interface{}
+		var _fold interface{}
+		var _foldr interface{}
+		var _map interface{}
+		var _filter interface{}
+		var _contains interface{}
+		var _sort interface{}
+		var _flat interface{}
+		var _flats interface{}
+		var _uniq interface{}
+		var _every interface{}
+		var _some interface{}
+		var _join interface{}
+		var _joins interface{}
+		var _formats interface{}
+		var _enum interface{}
+		var _log interface{}
+		var _fatal interface{}
+		var _bind interface{}
+		var _identity interface{}
+		var _eq interface{}
+		var _any interface{}
+		var _function interface{}
+		var _channel interface{}
+		var _natural interface{}
+		var _type interface{}
+		var _listOf interface{}
+		var _structOf interface{}
+		var _range interface{}
+		var _rangeMin interface{}
+		var _listLength interface{}
+		var _or interface{}
+		var _and interface{}
+		var _not interface{}
+		var _predicate interface{}
+		var _is interface{}
+		var direct_getDefinitions func(interface{}) interface{}
+		mml.Nop(direct_getDefinitions)
+		var direct_getScope func(interface{}) interface{}
+		mml.Nop(direct_getScope)
+		var direct_getModuleName func(interface{}) interface{}
+		mml.Nop(direct_getModuleName)
+		mml.Nop(_controlStatement, _unaryOp, _binaryNot, _plus, _minus, _logicalNot, _binaryOp, _binaryAnd, _binaryOr, _xor, _andNot, _lshift, _rshift, _mul, _div, _mod, _add, _sub, _equals, _notEq, _less, _lessOrEq, _greater, _greaterOrEq, _logicalAnd, _logicalOr, _builtin, _getDefinitions, _getScope, _getModuleName, _structs, _paths, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _flats, _uniq, _every, _some, _join, _joins, _formats, _enum, _log, _fatal, _bind, _identity, _eq, _any, _function, _channel, _natural, _type, _listOf, _structOf, _range, _rangeMin, _listLength, _or, _and, _not, _predicate, _is)
+		var __lang = use("lang")
+		_fold = __lang.Get("fold")
+		_foldr = __lang.Get("foldr")
+		_map = __lang.Get("map")
+		_filter = __lang.Get("filter")
+		_contains = __lang.Get("contains")
+		_sort = __lang.Get("sort")
+		_flat = __lang.Get("flat")
+		_flats = __lang.Get("flats")
+		_uniq = __lang.Get("uniq")
+		_every = __lang.Get("every")
+		_some = __lang.Get("some")
+		_join = __lang.Get("join")
+		_joins = __lang.Get("joins")
+		_formats = __lang.Get("formats")
+		_enum = __lang.Get("enum")
+		_log = __lang.Get("log")
+		_fatal = __lang.Get("fatal")
+		_bind = __lang.Get("bind")
+		_identity = __lang.Get("identity")
+		_eq = __lang.Get("eq")
+		_any = __lang.Get("any")
+		_function = __lang.Get("function")
+		_channel = __lang.Get("channel")
+		_natural = __lang.Get("natural")
+		_type = __lang.Get("type")
+		_listOf = __lang.Get("listOf")
+		_structOf = __lang.Get("structOf")
+		_range = __lang.Get("range")
+		_rangeMin = __lang.Get("rangeMin")
+		_listLength = __lang.Get("listLength")
+		_or = __lang.Get("or")
+		_and = __lang.Get("and")
+		_not = __lang.Get("not")
+		_predicate = __lang.Get("predicate")
+		_is = __lang.Get("is")
+		_structs = use("structs")
+		_paths = use("paths")
+		_controlStatement = _enum.(*mml.Function).Call([]interface{}{})
+		exports["controlStatement"] = _controlStatement
+		_unaryOp = _enum.(*mml.Function).Call([]interface{}{})
+		exports["unaryOp"] = _unaryOp
+		_binaryNot = 0
+		exports["binaryNot"] = _binaryNot
+		_plus = 1
+		exports["plus"] = _plus
+		_minus = 2
+		exports["minus"] = _minus
+		_logicalNot = 3
+		exports["logicalNot"] = _logicalNot
+		_binaryOp = _enum.(*mml.Function).Call([]interface{}{})
+		exports["binaryOp"] = _binaryOp
+		_binaryAnd = 0
+		exports["binaryAnd"] = _binaryAnd
+		_binaryOr = 1
+		exports["binaryOr"] = _binaryOr
+		_xor = 2
+		exports["xor"] = _xor
+		_andNot = 3
+		exports["andNot"] = _andNot
+		_lshift = 4
+		exports["lshift"] = _lshift
+		_rshift = 5
+		exports["rshift"] = _rshift
+		_mul = 6
+		exports["mul"] = _mul
+		_div = 7
+		exports["div"] = _div
+		_mod = 8
+		exports["mod"] = _mod
+		_add = 9
+		exports["add"] = _add
+		_sub = 10
+		exports["sub"] = _sub
+		_equals = 11
+		exports["equals"] = _equals
+		_notEq = 12
+		exports["notEq"] = _notEq
+		_less = 13
+		exports["less"] = _less
+		_lessOrEq = 14
+		exports["lessOrEq"] = _lessOrEq
+		_greater = 15
+		exports["greater"] = _greater
+		_greaterOrEq = 16
+		exports["greaterOrEq"] = _greaterOrEq
+		_logicalAnd = 17
+		exports["logicalAnd"] = _logicalAnd
+		_logicalOr = 18
+		exports["logicalOr"] = _logicalOr
+		_builtin = func() interface{} {
+			s := &mml.Struct{}
+			s.Set("len", "Len")
+			s.Set("isError", "IsError")
+			s.Set("keys", "Keys")
+			s.Set("format", "Format")
+			s.Set("stdin", "Stdin")
+			s.Set("stdout", "Stdout")
+			s.Set("stderr", "Stderr")
+			s.Set("int", "Int")
+			s.Set("float", "Float")
+			s.Set("string", "String")
+			s.Set("bool", "Bool")
+			s.Set("has", "Has")
+			s.Set("isBool", "IsBool")
+			s.Set("isInt", "IsInt")
+			s.Set("isFloat", "IsFloat")
+			s.Set("isString", "IsString")
+			s.Set("isList", "IsList")
+			s.Set("isStruct", "IsStruct")
+			s.Set("isFunction", "IsFunction")
+			s.Set("isChannel", "IsChannel")
+			s.Set("exit", "Exit")
+			s.Set("error", "Error")
+			s.Set("panic", "Panic")
+			s.Set("open", "Open")
+			s.Set("writeFile", "WriteFile")
+			s.Set("makeDir", "MakeDir")
+			s.Set("makeTempDir", "MakeTempDir")
+			s.Set("removeAll", "RemoveAll")
+			s.Set("readDir", "ReadDir")
+			s.Set("symlink", "Symlink")
+			s.Set("workDir", "WorkDir")
+			s.Set("getEnv", "GetEnv")
+			s.Set("executable", "Executable")
+			s.Set("execute", "Execute")
+			s.Set("stdlibSource", "StdlibSource")
+			s.Set("interopUse", "InteropUse")
+			s.Set("close", "Close")
+			s.Set("args", "Args")
+			s.Set("parseAST", "ParseAST")
+			s.Set("parseInt", "ParseInt")
+			s.Set("parseFloat", "ParseFloat")
+			s.Set("formatGo", "FormatGo")
+			s.Set("spawn", "Spawn")
+			s.Set("await", "Await")
+			s.Set("taskGroup", "TaskGroup")
+			s.Set("spawnIn", "SpawnIn")
+			s.Set("wait", "Wait")
+			s.Set("cancel", "Cancel")
+			s.Set("isCancelled", "IsCancelled")
+			s.Set("chan", "Chan")
+			s.Set("bufchan", "Bufchan")
+			s.Set("closed", "Closed")
+			s.Set("mutex", "Mutex")
+			s.Set("atomic", "Atomic")
+			s.Set("once", "Once")
+			s.Set("listFold", "ListFold")
+			s.Set("listFoldr", "ListFoldr")
+			s.Set("listMap", "ListMap")
+			s.Set("listFilter", "ListFilter")
+			s.Set("listSort", "ListSort")
+			s.Set("stringJoin", "StringJoin")
+			s.Set("stringEscape", "StringEscape")
+			s.Set("stringUnescape", "StringUnescape")
+			s.Set("stringSplit", "StringSplit")
+			s.Set("stringHash", "StringHash")
+			return s
+		}()
+		exports["builtin"] = _builtin
+		direct_getDefinitions = func(_statementList interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _definitions interface{}
+			var _definitionsFromGroups interface{}
+			mml.Nop(_definitions, _definitionsFromGroups)
+			_definitions = _filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "definition"); ; return s }()})}).(*mml.Function).Call([]interface{}{mml.Ref(_statementList, "statements")})
+			_definitionsFromGroups = _flat.(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{mml.Ref(_structs, "get").(*mml.Function).Call([]interface{}{"definitions"})}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "definition-group"); ; return s }()})}).(*mml.Function).Call([]interface{}{mml.Ref(_statementList, "statements")})})})
+			return (&mml.List{}).Concat(_definitions.(*mml.List)).Concat(_definitionsFromGroups.(*mml.List))
+		}
+		_getDefinitions = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_getDefinitions(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["getDefinitions"] = _getDefinitions
+		direct_getScope = func(_statementList interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _definitions interface{}
+			var _uses interface{}
+			var _inlineUses interface{}
+			var _unnamedUses interface{}
+			var _namedUses interface{}
+			mml.Nop(_definitions, _uses, _inlineUses, _unnamedUses, _namedUses)
+			_definitions = _map.(*mml.Function).Call([]interface{}{mml.Ref(_structs, "get").(*mml.Function).Call([]interface{}{"symbol"})}).(*mml.Function).Call([]interface{}{direct_getDefinitions(_statementList)})
+			_uses = _flat.(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{mml.Ref(_structs, "get").(*mml.Function).Call([]interface{}{"uses"})}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "use-list"); ; return s }()})}).(*mml.Function).Call([]interface{}{mml.Ref(_statementList, "statements")})})})
+			_unnamedUses = _map.(*mml.Function).Call([]interface{}{_getModuleName}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{mml.Ref(_structs, "get").(*mml.Function).Call([]interface{}{"value"})}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{mml.Ref(_structs, "get").(*mml.Function).Call([]interface{}{"path"})}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{_not.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("capture", _any); ; return s }()})})}).(*mml.Function).Call([]interface{}{_uses})})})})
+			_namedUses = _map.(*mml.Function).Call([]interface{}{mml.Ref(_structs, "get").(*mml.Function).Call([]interface{}{"capture"})}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("capture", _not.(*mml.Function).Call([]interface{}{"."}))
+				return s
+			}()})}).(*mml.Function).Call([]interface{}{_uses})})
+			_inlineUses = _map.(*mml.Function).Call([]interface{}{mml.Ref(_structs, "get").(*mml.Function).Call([]interface{}{"symbol"})}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("exported", true); ; return s }()})}).(*mml.Function).Call([]interface{}{_flat.(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_getDefinitions}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{mml.Ref(_structs, "get").(*mml.Function).Call([]interface{}{"body"})}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{mml.Ref(_structs, "get").(*mml.Function).Call([]interface{}{"module"})}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("capture", "."); ; return s }()})}).(*mml.Function).Call([]interface{}{_uses})})})})})})})
+			return _flats.(*mml.Function).Call([]interface{}{_definitions, _unnamedUses, _namedUses, _inlineUses})
+		}
+		_getScope = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_getScope(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["getScope"] = _getScope
+		direct_getModuleName = func(_path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return mml.Ref(_paths, "base").(*mml.Function).Call([]interface{}{_path})
+		}
+		_getModuleName = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_getModuleName(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["getModuleName"] = _getModuleName
+
+		return exports
+	})
+
+	modulePath = "structs"
+
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
+		exports := make(map[string]interface{})
+
+		var c interface{}
+		mml.Nop(c)
+
+		var _merge interface{}
+		var _merges interface{}
+		var _get interface{}
+		var _values interface{}
+		var _lists interface{}
+		var direct_merge func(interface{}) interface{}
+		mml.Nop(direct_merge)
+		var direct_get func(interface{}, interface{}) interface{}
+		mml.Nop(direct_get)
+		var direct_values func(interface{}) interface{}
+		mml.Nop(direct_values)
+		mml.Nop(_merge, _merges, _get, _values, _lists)
+		_lists = use("lists")
+		direct_merge = func(_s interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return mml.Ref(_lists, "fold").(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _i = a[0]
+					var _s = a[1]
+					mml.Nop(_i, _s)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_s.(*mml.Struct))
+						s.Merge(_i.(*mml.Struct))
+						return s
+					}()
+				},
+				FixedArgs: 2,
+			}, func() interface{} { s := &mml.Struct{}; ; return s }(), _s})
+		}
+		_merge = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_merge(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["merge"] = _merge
+		_merges = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				var c interface{}
+				mml.Nop(c)
+				var _s interface{}
+				_s = mml.NewList(a[0:])
+				mml.Nop(_s)
+				return direct_merge(_s)
+			},
+			FixedArgs: 0,
+		}
+		exports["merges"] = _merges
+		direct_get = func(_key, _s interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return mml.Ref(_s, _key)
+		}
+		_get = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_get(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		exports["get"] = _get
+		direct_values = func(_s interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return mml.Ref(_lists, "map").(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _key = a[0]
+					mml.Nop(_key)
+					return mml.Ref(_s, _key)
+				},
+				FixedArgs: 1,
+			}, _keys.(*mml.Function).Call([]interface{}{_s})})
+		}
+		_values = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_values(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["values"] = _values
+
+		return exports
+	})
+
+	modulePath = "errors"
+
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
+		exports := make(map[string]interface{})
+
+		var c interface{}
+		mml.Nop(c)
+
+		var _only interface{}
+		var _pass interface{}
+		var _any interface{}
+		var _lists interface{}
+		var _functions interface{}
+		var direct_any func(interface{}) interface{}
+		mml.Nop(direct_any)
+		mml.Nop(_only, _pass, _any, _lists, _functions)
+		_lists = use("lists")
+		_functions = use("functions")
+		_only = mml.Ref(_functions, "bind").(*mml.Function).Call([]interface{}{mml.Ref(_functions, "only"), _isError})
+		exports["only"] = _only
+		_pass = mml.Ref(_functions, "bind").(*mml.Function).Call([]interface{}{mml.Ref(_functions, "only"), mml.Ref(_functions, "not").(*mml.Function).Call([]interface{}{_isError})})
+		exports["pass"] = _pass
+		direct_any = func(_l interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _first interface{}
+			mml.Nop(_first)
+			_first = mml.Ref(_lists, "first").(*mml.Function).Call([]interface{}{_isError, _l})
+			return func() interface{} {
+				c = mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_first}), 0)
+				if c.(bool) {
+					return _l
+				} else {
+					return mml.Ref(_first, 0)
+				}
+			}()
+		}
+		_any = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_any(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["any"] = _any
+
+		return exports
+	})
+
+	modulePath = "codetree"
+
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
+		exports := make(map[string]interface{})
+
+		var c interface{}
+		mml.Nop(c)
+
+		var _removeToken interface{}
+		var _callTransform interface{}
+		var _children interface{}
+		var _do interface{}
+		var _edit interface{}
+		var _filter interface{}
+		var _mapChildren interface{}
+		var _each interface{}
+		var direct_removeToken func() interface{}
+		mml.Nop(direct_removeToken)
+		var direct_callTransform func(interface{}, interface{}, interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_callTransform)
+		var direct_children func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_children)
+		var direct_do func(interface{}, interface{}) interface{}
+		mml.Nop(direct_do)
+		var direct_edit func(interface{}, interface{}) interface{}
+		mml.Nop(direct_edit)
+		var direct_filter func(interface{}, interface{}) interface{}
+		mml.Nop(direct_filter)
+		var direct_mapChildren func(interface{}, interface{}) interface{}
+		mml.Nop(direct_mapChildren)
+		var direct_each func(interface{}, interface{}) interface{}
+		mml.Nop(direct_each)
+		mml.Nop(_removeToken, _callTransform, _children, _do, _edit, _filter, _mapChildren, _each)
+		direct_removeToken = func() interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _removeToken
+		}
+		_removeToken = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_removeToken()
+			},
+			FixedArgs: 0,
+		}
+		direct_callTransform = func(_child, _transform, _code, _fields, _listFields interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _results interface{}
+			mml.Nop(_results)
+			_results = func() interface{} { s := &mml.Struct{}; ; return s }()
+			for _, _f := range _fields.(*mml.List).Values() {
+				var _value interface{}
+				mml.Nop(_value)
+				if !_has.(*mml.Function).Call([]interface{}{_f, _code}).(bool) {
+					mml.Nop()
+					continue
+				}
+				_value = _child.(*mml.Function).Call([]interface{}{mml.Ref(_code, _f)})
+				if v := _value; mml.IsError.F([]interface{}{v}).(bool) {
+					return v
+				}
+				c = mml.BinaryOp(12, _value, _removeToken)
+				if c.(bool) {
+					mml.Nop()
+					mml.SetRef(_results, _f, _value)
+				}
+			}
+			for _, _f := range _listFields.(*mml.List).Values() {
+				var _values interface{}
+				mml.Nop(_values)
+				if !_has.(*mml.Function).Call([]interface{}{_f, _code}).(bool) {
+					mml.Nop()
+					continue
+				}
+				_values = (&mml.List{})
+				for _, _item := range mml.Ref(_code, _f).(*mml.List).Values() {
+					var _value interface{}
+					mml.Nop(_value)
+					_value = _child.(*mml.Function).Call([]interface{}{_item})
+					if v := _value; mml.IsError.F([]interface{}{v}).(bool) {
+						return v
+					}
+					c = mml.BinaryOp(12, _value, _removeToken)
+					if c.(bool) {
+						mml.Nop()
+						_values = (&mml.List{}).Concat(_values.(*mml.List)).Append(_value)
+					}
+				}
+				mml.SetRef(_results, _f, _values)
+			}
+			return _transform.(*mml.Function).Call([]interface{}{_code, func() interface{} {
+				s := &mml.Struct{}
+				s.Merge(_results.(*mml.Struct))
+				return s
+			}()})
+		}
+		_callTransform = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_callTransform(a[0], a[1], a[2], a[3], a[4])
+			},
+			FixedArgs: 5,
+		}
+		direct_children = func(_child, _transform, _code interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _withFieldsAndLists interface{}
+			var _withFields interface{}
+			var _withListFields interface{}
+			var _leaf interface{}
+			mml.Nop(_withFieldsAndLists, _withFields, _withListFields, _leaf)
+			_withFieldsAndLists = _callTransform.(*mml.Function).Call([]interface{}{_child, _transform, _code})
+			_withFields = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _keys interface{}
+					_keys = mml.NewList(a[0:])
+					mml.Nop(_keys)
+					return _withFieldsAndLists.(*mml.Function).Call([]interface{}{_keys, (&mml.List{})})
+				},
+				FixedArgs: 0,
+			}
+			_withListFields = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _keys interface{}
+					_keys = mml.NewList(a[0:])
+					mml.Nop(_keys)
+					return _withFieldsAndLists.(*mml.Function).Call([]interface{}{(&mml.List{}), _keys})
+				},
+				FixedArgs: 0,
+			}
+			_leaf = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+
+					mml.Nop()
+					return _withFieldsAndLists.(*mml.Function).Call([]interface{}{(&mml.List{}), (&mml.List{})})
+				},
+				FixedArgs: 0,
+			}
+			switch mml.Ref(_code, "type") {
+			case "spread":
+
+				mml.Nop()
+				return _withFields.(*mml.Function).Call([]interface{}{"value"})
+			case "list":
+
+				mml.Nop()
+				return _withListFields.(*mml.Function).Call([]interface{}{"values"})
+			case "mutable-list":
+
+				mml.Nop()
+				return _withListFields.(*mml.Function).Call([]interface{}{"values"})
+			case "expression-key":
+
+				mml.Nop()
+				return _withFields.(*mml.Function).Call([]interface{}{"value"})
+			case "entry":
+
+				mml.Nop()
+				return _withFields.(*mml.Function).Call([]interface{}{"key", "value"})
+			case "struct":
+
+				mml.Nop()
+				return _withListFields.(*mml.Function).Call([]interface{}{"entries"})
+			case "mutable-struct":
+
+				mml.Nop()
+				return _withListFields.(*mml.Function).Call([]interface{}{"entries"})
+			case "ret":
+
+				mml.Nop()
+				return _withFields.(*mml.Function).Call([]interface{}{"value"})
+			case "check-ret":
+
+				mml.Nop()
+				return _withFields.(*mml.Function).Call([]interface{}{"value"})
+			case "statement-list":
+
+				mml.Nop()
+				return _withListFields.(*mml.Function).Call([]interface{}{"statements"})
+			case "function":
+
+				mml.Nop()
+				return _withFields.(*mml.Function).Call([]interface{}{"body"})
+			case "range":
+
+				mml.Nop()
+				return _withFields.(*mml.Function).Call([]interface{}{"from", "to"})
+			case "indexer":
+
+				mml.Nop()
+				return _withFields.(*mml.Function).Call([]interface{}{"expression", "index"})
+			case "application":
+
+				mml.Nop()
+				return _withFieldsAndLists.(*mml.Function).Call([]interface{}{(&mml.List{}).Append("function"), (&mml.List{}).Append("args")})
+			case "tail-call":
+
+				mml.Nop()
+				return _withListFields.(*mml.Function).Call([]interface{}{"args"})
+			case "unary":
+
+				mml.Nop()
+				return _withFields.(*mml.Function).Call([]interface{}{"arg"})
+			case "binary":
+
+				mml.Nop()
+				return _withFields.(*mml.Function).Call([]interface{}{"left", "right"})
+			case "cond":
+
+				mml.Nop()
+				return _withFields.(*mml.Function).Call([]interface{}{"condition", "consequent", "alternative"})
+			case "switch-case":
+
+				mml.Nop()
+				return _withFields.(*mml.Function).Call([]interface{}{"expression", "body"})
+			case "switch-statement":
+
+				mml.Nop()
+				return _withFieldsAndLists.(*mml.Function).Call([]interface{}{(&mml.List{}).Append("expression", "defaultStatements"), (&mml.List{}).Append("cases")})
+			case "send-statement":
+
+				mml.Nop()
+				return _withFields.(*mml.Function).Call([]interface{}{"channel", "value"})
+			case "receive-expression":
+
+				mml.Nop()
+				return _withFields.(*mml.Function).Call([]interface{}{"channel"})
+			case "definition":
+
+				mml.Nop()
+				return _withFields.(*mml.Function).Call([]interface{}{"docs", "expression"})
+			case "select-case":
+
+				mml.Nop()
+				return _withFields.(*mml.Function).Call([]interface{}{"expression", "body"})
+			case "select-statement":
+
+				mml.Nop()
+				return _withFieldsAndLists.(*mml.Function).Call([]interface{}{(&mml.List{}).Append("defaultStatements"), (&mml.List{}).Append("cases")})
+			case "go-statement":
+
+				mml.Nop()
+				return _withFields.(*mml.Function).Call([]interface{}{"application"})
+			case "defer-statement":
+
+				mml.Nop()
+				return _withFields.(*mml.Function).Call([]interface{}{"application"})
+			case "range-over":
+
+				mml.Nop()
+				return _withFields.(*mml.Function).Call([]interface{}{"expression"})
+			case "loop":
+
+				mml.Nop()
+				return _withFields.(*mml.Function).Call([]interface{}{"expression", "body"})
+			case "assign":
+
+				mml.Nop()
+				return _withFields.(*mml.Function).Call([]interface{}{"capture", "value"})
+			case "definition-group":
+
+				mml.Nop()
+				return _withListFields.(*mml.Function).Call([]interface{}{"definitions"})
+			case "use":
+
+				mml.Nop()
+				return _withFields.(*mml.Function).Call([]interface{}{"path"})
+			case "use-list":
+
+				mml.Nop()
+				return _withListFields.(*mml.Function).Call([]interface{}{"uses"})
+			case "module":
+
+				mml.Nop()
+				return _withFields.(*mml.Function).Call([]interface{}{"body"})
+			default:
+
+				mml.Nop()
+				return _leaf.(*mml.Function).Call([]interface{}{})
+			}
+		}
+		_children = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_children(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_do = func(_transform, _code interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return direct_children(_do.(*mml.Function).Call([]interface{}{_transform}), _transform, _code)
+		}
+		_do = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_do(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_edit = func(_transform, _code interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return direct_do(&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _code = a[0]
+					var _fieldResults = a[1]
+					mml.Nop(_code, _fieldResults)
+					return _transform.(*mml.Function).Call([]interface{}{func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_code.(*mml.Struct))
+						s.Merge(_fieldResults.(*mml.Struct))
+						return s
+					}()})
+				},
+				FixedArgs: 2,
+			}, _code)
+		}
+		_edit = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_edit(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		exports["edit"] = _edit
+		direct_filter = func(_predicate, _code interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _matches interface{}
+			var _collect interface{}
+			var _walk interface{}
+			mml.Nop(_matches, _collect, _walk)
+			_matches = (&mml.List{})
+			_collect = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _code = a[0]
+					mml.Nop(_code)
+
+					mml.Nop()
+					c = _predicate.(*mml.Function).Call([]interface{}{_code})
+					if c.(bool) {
+						mml.Nop()
+						_matches = (&mml.List{}).Concat(_matches.(*mml.List)).Append(_code)
+					}
+					return _code
+				},
+				FixedArgs: 1,
+			}
+			_walk = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _code = a[0]
+					mml.Nop(_code)
+					return direct_children(_walk, &mml.Function{
+						F: func(a []interface{}) interface{} {
+							var c interface{}
+							mml.Nop(c)
+							var _code = a[0]
+							var __ = a[1]
+							mml.Nop(_code, __)
+							return _collect.(*mml.Function).Call([]interface{}{_code})
+						},
+						FixedArgs: 2,
+					}, _code)
+				},
+				FixedArgs: 1,
+			}
+			_walk.(*mml.Function).Call([]interface{}{_code})
+			return _matches
+		}
+		_filter = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_filter(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		exports["filter"] = _filter
+		direct_mapChildren = func(_f, _code interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return direct_children(_f, &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _code = a[0]
+					var _fieldResults = a[1]
+					mml.Nop(_code, _fieldResults)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_code.(*mml.Struct))
+						s.Merge(_fieldResults.(*mml.Struct))
+						return s
+					}()
+				},
+				FixedArgs: 2,
+			}, _code)
+		}
+		_mapChildren = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_mapChildren(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		exports["mapChildren"] = _mapChildren
+		direct_each = func(_f, _code interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return direct_children(_f, &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _code = a[0]
+					var __ = a[1]
+					mml.Nop(_code, __)
+					return _code
+				},
+				FixedArgs: 2,
+			}, _code)
+		}
+		_each = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_each(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		exports["each"] = _each
+
+		return exports
+	})
+
+	modulePath = "io"
+
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
+		exports := make(map[string]interface{})
+
+		var c interface{}
+		mml.Nop(c)
+
+		var _readFile interface{}
+		var _fold interface{}
+		var _foldr interface{}
+		var _map interface{}
+		var _filter interface{}
+		var _contains interface{}
+		var _sort interface{}
+		var _flat interface{}
+		var _flats interface{}
+		var _uniq interface{}
+		var _every interface{}
+		var _some interface{}
+		var _join interface{}
+		var _joins interface{}
+		var _formats interface{}
+		var _enum interface{}
+		var _log interface{}
+		var _fatal interface{}
+		var _bind interface{}
+		var _identity interface{}
+		var _eq interface{}
+		var _any interface{}
+		var _function interface{}
+		var _channel interface{}
+		var _natural interface{}
+		var _type interface{}
+		var _listOf interface{}
+		var _structOf interface{}
+		var _range interface{}
+		var _rangeMin interface{}
+		var _listLength interface{}
+		var _or interface{}
+		var _and interface{}
+		var _not interface{}
+		var _predicate interface{}
+		var _is interface{}
+		var direct_readFile func(interface{}) interface{}
+		mml.Nop(direct_readFile)
+		mml.Nop(_readFile, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _flats, _uniq, _every, _some, _join, _joins, _formats, _enum, _log, _fatal, _bind, _identity, _eq, _any, _function, _channel, _natural, _type, _listOf, _structOf, _range, _rangeMin, _listLength, _or, _and, _not, _predicate, _is)
+		var __lang = use("lang")
+		_fold = __lang.Get("fold")
+		_foldr = __lang.Get("foldr")
+		_map = __lang.Get("map")
+		_filter = __lang.Get("filter")
+		_contains = __lang.Get("contains")
+		_sort = __lang.Get("sort")
+		_flat = __lang.Get("flat")
+		_flats = __lang.Get("flats")
+		_uniq = __lang.Get("uniq")
+		_every = __lang.Get("every")
+		_some = __lang.Get("some")
+		_join = __lang.Get("join")
+		_joins = __lang.Get("joins")
+		_formats = __lang.Get("formats")
+		_enum = __lang.Get("enum")
+		_log = __lang.Get("log")
+		_fatal = __lang.Get("fatal")
+		_bind = __lang.Get("bind")
+		_identity = __lang.Get("identity")
+		_eq = __lang.Get("eq")
+		_any = __lang.Get("any")
+		_function = __lang.Get("function")
+		_channel = __lang.Get("channel")
+		_natural = __lang.Get("natural")
+		_type = __lang.Get("type")
+		_listOf = __lang.Get("listOf")
+		_structOf = __lang.Get("structOf")
+		_range = __lang.Get("range")
+		_rangeMin = __lang.Get("rangeMin")
+		_listLength = __lang.Get("listLength")
+		_or = __lang.Get("or")
+		_and = __lang.Get("and")
+		_not = __lang.Get("not")
+		_predicate = __lang.Get("predicate")
+		_is = __lang.Get("is")
+		direct_readFile = func(_path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _f interface{}
+			mml.Nop(_f)
+			_f = _open.(*mml.Function).Call([]interface{}{_path})
+			if v := _f; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			defer _close.(*mml.Function).Call([]interface{}{_f})
+			return _f.(*mml.Function).Call([]interface{}{-(1)})
+		}
+		_readFile = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_readFile(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["readFile"] = _readFile
+
+		return exports
+	})
+
+	modulePath = "tasks"
+
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
+		exports := make(map[string]interface{})
+
+		var c interface{}
+		mml.Nop(c)
+
+		var _all interface{}
+		var _map interface{}
+		var _lists interface{}
+		var direct_all func(interface{}) interface{}
+		mml.Nop(direct_all)
+		var direct_map func(interface{}, interface{}) interface{}
+		mml.Nop(direct_map)
+		mml.Nop(_all, _map, _lists)
+		_lists = use("lists")
+		direct_all = func(_f interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _g interface{}
+			mml.Nop(_g)
+			_g = _taskGroup.(*mml.Function).Call([]interface{}{})
+			for _, _fi := range _f.(*mml.List).Values() {
+
+				mml.Nop()
+				mml.At("tasks.mml:35:3", _spawnIn.(*mml.Function)).Call([]interface{}{_g, _fi})
+			}
+			return mml.At("tasks.mml:38:9", _wait.(*mml.Function)).Call([]interface{}{_g})
+		}
+		_all = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_all(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["all"] = _all
+		direct_map = func(_m, _l interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return direct_all(mml.Ref(_lists, "map").(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _i = a[0]
+					mml.Nop(_i)
+					return &mml.Function{
+						F: func(a []interface{}) interface{} {
+							var c interface{}
+							mml.Nop(c)
+
+							mml.Nop()
+							return _m.(*mml.Function).Call([]interface{}{_i})
+						},
+						FixedArgs: 0,
+					}
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_l}))
+		}
+		_map = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_map(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		exports["map"] = _map
+
+		return exports
+	})
+
+	modulePath = "contracts"
+
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
+		exports := make(map[string]interface{})
+
+		var c interface{}
+		mml.Nop(c)
+
+		var _position interface{}
+		var _entryKey interface{}
+		var _patternEntry interface{}
+		var _negative interface{}
+		var _pattern interface{}
+		var _known interface{}
+		var _value interface{}
+		var _params interface{}
+		var _signature interface{}
+		var _sameSignature interface{}
+		var _verifyFunction interface{}
+		var _verifyValue interface{}
+		var _verifyDefinition interface{}
+		var _namedPatterns interface{}
+		var _patternFunctions interface{}
+		var _implementation interface{}
+		var _verify interface{}
+		var _code interface{}
+		var _errors interface{}
+		var _lists interface{}
+		var _fold interface{}
+		var _foldr interface{}
+		var _map interface{}
+		var _filter interface{}
+		var _contains interface{}
+		var _sort interface{}
+		var _flat interface{}
+		var _flats interface{}
+		var _uniq interface{}
+		var _every interface{}
+		var _some interface{}
+		var _join interface{}
+		var _joins interface{}
+		var _formats interface{}
+		var _enum interface{}
+		var _log interface{}
+		var _fatal interface{}
+		var _bind interface{}
+		var _identity interface{}
+		var _eq interface{}
+		var _any interface{}
+		var _function interface{}
+		var _channel interface{}
+		var _natural interface{}
+		var _type interface{}
+		var _listOf interface{}
+		var _structOf interface{}
+		var _range interface{}
+		var _rangeMin interface{}
+		var _listLength interface{}
+		var _or interface{}
+		var _and interface{}
+		var _not interface{}
+		var _predicate interface{}
+		var _is interface{}
+		var direct_position func(interface{}) interface{}
+		mml.Nop(direct_position)
+		var direct_entryKey func(interface{}) interface{}
+		mml.Nop(direct_entryKey)
+		var direct_patternEntry func(interface{}) interface{}
+		mml.Nop(direct_patternEntry)
+		var direct_pattern func(interface{}) interface{}
+		mml.Nop(direct_pattern)
+		var direct_known func(interface{}) interface{}
+		mml.Nop(direct_known)
+		var direct_value func(interface{}) interface{}
+		mml.Nop(direct_value)
+		var direct_params func(interface{}) interface{}
+		mml.Nop(direct_params)
+		var direct_signature func(interface{}) interface{}
+		mml.Nop(direct_signature)
+		var direct_sameSignature func(interface{}, interface{}) interface{}
+		mml.Nop(direct_sameSignature)
+		var direct_verifyFunction func(interface{}, interface{}) interface{}
+		mml.Nop(direct_verifyFunction)
+		var direct_verifyValue func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_verifyValue)
+		var direct_verifyDefinition func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_verifyDefinition)
+		var direct_implementation func(interface{}) interface{}
+		mml.Nop(direct_implementation)
+		var direct_verify func(interface{}, interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_verify)
+		mml.Nop(_position, _entryKey, _patternEntry, _negative, _pattern, _known, _value, _params, _signature, _sameSignature, _verifyFunction, _verifyValue, _verifyDefinition, _namedPatterns, _patternFunctions, _implementation, _verify, _code, _errors, _lists, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _flats, _uniq, _every, _some, _join, _joins, _formats, _enum, _log, _fatal, _bind, _identity, _eq, _any, _function, _channel, _natural, _type, _listOf, _structOf, _range, _rangeMin, _listLength, _or, _and, _not, _predicate, _is)
+		var __lang = use("lang")
+		_fold = __lang.Get("fold")
+		_foldr = __lang.Get("foldr")
+		_map = __lang.Get("map")
+		_filter = __lang.Get("filter")
+		_contains = __lang.Get("contains")
+		_sort = __lang.Get("sort")
+		_flat = __lang.Get("flat")
+		_flats = __lang.Get("flats")
+		_uniq = __lang.Get("uniq")
+		_every = __lang.Get("every")
+		_some = __lang.Get("some")
+		_join = __lang.Get("join")
+		_joins = __lang.Get("joins")
+		_formats = __lang.Get("formats")
+		_enum = __lang.Get("enum")
+		_log = __lang.Get("log")
+		_fatal = __lang.Get("fatal")
+		_bind = __lang.Get("bind")
+		_identity = __lang.Get("identity")
+		_eq = __lang.Get("eq")
+		_any = __lang.Get("any")
+		_function = __lang.Get("function")
+		_channel = __lang.Get("channel")
+		_natural = __lang.Get("natural")
+		_type = __lang.Get("type")
+		_listOf = __lang.Get("listOf")
+		_structOf = __lang.Get("structOf")
+		_range = __lang.Get("range")
+		_rangeMin = __lang.Get("rangeMin")
+		_listLength = __lang.Get("listLength")
+		_or = __lang.Get("or")
+		_and = __lang.Get("and")
+		_not = __lang.Get("not")
+		_predicate = __lang.Get("predicate")
+		_is = __lang.Get("is")
+		_code = use("code")
+		_errors = use("errors")
+		_lists = use("lists")
+		_namedPatterns = func() interface{} {
+			s := &mml.Struct{}
+			s.Set("any", _any)
+			s.Set("function", _function)
+			s.Set("channel", _channel)
+			s.Set("natural", _natural)
+			s.Set("int", _type.(*mml.Function).Call([]interface{}{_int}))
+			s.Set("float", _type.(*mml.Function).Call([]interface{}{_float}))
+			s.Set("string", _type.(*mml.Function).Call([]interface{}{_string}))
+			s.Set("bool", _type.(*mml.Function).Call([]interface{}{_bool}))
+			s.Set("error", _type.(*mml.Function).Call([]interface{}{_error}))
+			return s
+		}()
+		_patternFunctions = func() interface{} {
+			s := &mml.Struct{}
+			s.Set("type", _type)
+			s.Set("listOf", _listOf)
+			s.Set("structOf", _structOf)
+			s.Set("range", _range)
+			s.Set("rangeMin", _rangeMin)
+			s.Set("listLength", _listLength)
+			s.Set("or", _or)
+			s.Set("and", _and)
+			s.Set("not", _not)
+			return s
+		}()
+		direct_position = func(_c interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _formats.(*mml.Function).Call([]interface{}{"%s:%d:%d", mml.Ref(mml.Ref(_c, "ast"), "file"), mml.Ref(mml.Ref(_c, "ast"), "line"), mml.Ref(mml.Ref(_c, "ast"), "column")})
+		}
+		_position = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_position(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_entryKey = func(_e interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				c = mml.BinaryOp(11, mml.Ref(mml.Ref(_e, "key"), "type"), "symbol")
+				if c.(bool) {
+					return mml.Ref(mml.Ref(_e, "key"), "name")
+				} else {
+					return mml.Ref(mml.Ref(_e, "key"), "value")
+				}
+			}()
+		}
+		_entryKey = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_entryKey(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_patternEntry = func(_e interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _p interface{}
+			mml.Nop(_p)
+			_p = direct_pattern(mml.Ref(_e, "value"))
+			if v := _p; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			return func() interface{} { s := &mml.Struct{}; s.Set(direct_entryKey(_e).(string), _p); ; return s }()
+		}
+		_patternEntry = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_patternEntry(a[0])
+			},
+			FixedArgs: 1,
+		}
+		_negative = _is.(*mml.Function).Call([]interface{}{func() interface{} {
+			s := &mml.Struct{}
+			s.Set("type", "unary")
+			s.Set("op", mml.Ref(_code, "minus"))
+			s.Set("arg", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("type", _or.(*mml.Function).Call([]interface{}{"int", "float"}))
+				return s
+			}())
+			return s
+		}()})
+		direct_pattern = func(_c interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			switch {
+			case _is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("type", _or.(*mml.Function).Call([]interface{}{"int", "float", "string", "bool"}))
+				return s
+			}(), _c}):
+
+				mml.Nop()
+				return mml.Ref(_c, "value")
+			case _negative.(*mml.Function).Call([]interface{}{_c}):
+
+				mml.Nop()
+				return mml.UnaryOp(2, mml.Ref(mml.Ref(_c, "arg"), "value"))
+			case (_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "symbol"); ; return s }(), _c}).(bool) && _has.(*mml.Function).Call([]interface{}{mml.Ref(_c, "name"), _namedPatterns}).(bool)):
+
+				mml.Nop()
+				return mml.Ref(_namedPatterns, mml.Ref(_c, "name"))
+			case _is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("type", "list")
+				s.Set("values", _listOf.(*mml.Function).Call([]interface{}{func() interface{} {
+					s := &mml.Struct{}
+					s.Set("type", _not.(*mml.Function).Call([]interface{}{"spread"}))
+					return s
+				}()}))
+				return s
+			}(), _c}):
+
+				mml.Nop()
+				return mml.Ref(_errors, "any").(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_pattern}).(*mml.Function).Call([]interface{}{mml.Ref(_c, "values")})})
+			case _is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("type", "struct")
+				s.Set("entries", _listOf.(*mml.Function).Call([]interface{}{func() interface{} {
+					s := &mml.Struct{}
+					s.Set("type", "entry")
+					s.Set("key", func() interface{} {
+						s := &mml.Struct{}
+						s.Set("type", _or.(*mml.Function).Call([]interface{}{"symbol", "string"}))
+						return s
+					}())
+					return s
+				}()}))
+				return s
+			}(), _c}):
+				var _entries interface{}
+				mml.Nop(_entries)
+				_entries = mml.Ref(_errors, "any").(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_patternEntry}).(*mml.Function).Call([]interface{}{mml.Ref(_c, "entries")})})
+				if v := _entries; mml.IsError.F([]interface{}{v}).(bool) {
+					return v
+				}
+				return _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _e = a[0]
+						var _s = a[1]
+						mml.Nop(_e, _s)
+						return func() interface{} {
+							s := &mml.Struct{}
+							s.Merge(_s.(*mml.Struct))
+							s.Merge(_e.(*mml.Struct))
+							return s
+						}()
+					},
+					FixedArgs: 2,
+				}, func() interface{} { s := &mml.Struct{}; ; return s }(), _entries})
+			case (_is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("type", "application")
+				s.Set("function", func() interface{} { s := &mml.Struct{}; s.Set("type", "symbol"); ; return s }())
+				return s
+			}(), _c}).(bool) && _has.(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_c, "function"), "name"), _patternFunctions}).(bool)):
+				var _args interface{}
+				mml.Nop(_args)
+				_args = mml.Ref(_errors, "any").(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_pattern}).(*mml.Function).Call([]interface{}{mml.Ref(_c, "args")})})
+				if v := _args; mml.IsError.F([]interface{}{v}).(bool) {
+					return v
+				}
+				return mml.Ref(_patternFunctions, mml.Ref(mml.Ref(_c, "function"), "name")).(*mml.Function).Call(append([]interface{}{}, _args.(*mml.List).Values()...))
+			default:
+
+				mml.Nop()
+				return _error.(*mml.Function).Call([]interface{}{_formats.(*mml.Function).Call([]interface{}{"%s: unsupported pattern", direct_position(_c)})})
+			}
+		}
+		_pattern = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_pattern(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_known = func(_c interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			switch mml.Ref(_c, "type") {
+			case "int":
+
+				mml.Nop()
+				return true
+			case "float":
+
+				mml.Nop()
+				return true
+			case "string":
+
+				mml.Nop()
+				return true
+			case "bool":
+
+				mml.Nop()
+				return true
+			case "function":
+
+				mml.Nop()
+				return true
+			case "unary":
+
+				mml.Nop()
+				return _negative.(*mml.Function).Call([]interface{}{_c})
+			case "list":
+
+				mml.Nop()
+				return _every.(*mml.Function).Call([]interface{}{_known, mml.Ref(_c, "values")})
+			case "struct":
+
+				mml.Nop()
+				return _every.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} {
+					s := &mml.Struct{}
+					s.Set("type", "entry")
+					s.Set("key", func() interface{} {
+						s := &mml.Struct{}
+						s.Set("type", _or.(*mml.Function).Call([]interface{}{"symbol", "string"}))
+						return s
+					}())
+					s.Set("value", _predicate.(*mml.Function).Call([]interface{}{_known}))
+					return s
+				}()}), mml.Ref(_c, "entries")})
+			default:
+
+				mml.Nop()
+				return false
+			}
+		}
+		_known = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_known(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_value = func(_c interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			switch mml.Ref(_c, "type") {
+			case "list":
+
+				mml.Nop()
+				return _map.(*mml.Function).Call([]interface{}{_value, mml.Ref(_c, "values")})
+			case "struct":
+
+				mml.Nop()
+				return _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _e = a[0]
+						var _s = a[1]
+						mml.Nop(_e, _s)
+						return func() interface{} {
+							s := &mml.Struct{}
+							s.Merge(_s.(*mml.Struct))
+							s.Set(direct_entryKey(_e).(string), direct_value(mml.Ref(_e, "value")))
+							return s
+						}()
+					},
+					FixedArgs: 2,
+				}, func() interface{} { s := &mml.Struct{}; ; return s }(), mml.Ref(_c, "entries")})
+			case "function":
+
+				mml.Nop()
+				return _identity
+			case "unary":
+
+				mml.Nop()
+				return mml.UnaryOp(2, mml.Ref(mml.Ref(_c, "arg"), "value"))
+			default:
+
+				mml.Nop()
+				return mml.Ref(_c, "value")
+			}
+		}
+		_value = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_value(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_params = func(_f interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				c = mml.BinaryOp(11, mml.Ref(_f, "collectParam"), "")
+				if c.(bool) {
+					return mml.Ref(_f, "params")
+				} else {
+					return (&mml.List{}).Concat(mml.Ref(_f, "params").(*mml.List)).Append(_formats.(*mml.Function).Call([]interface{}{"...%s", mml.Ref(_f, "collectParam")}))
+				}
+			}()
+		}
+		_params = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_params(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_signature = func(_f interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _formats.(*mml.Function).Call([]interface{}{"%s(%s)", func() string {
+				c = mml.Ref(_f, "effect")
+				if c.(bool) {
+					return "fn~"
+				} else {
+					return "fn"
+				}
+			}(), _join.(*mml.Function).Call([]interface{}{", ", direct_params(_f)})})
+		}
+		_signature = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_signature(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_sameSignature = func(_left, _right interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return ((mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{mml.Ref(_left, "params")}), _len.(*mml.Function).Call([]interface{}{mml.Ref(_right, "params")})).(bool) && mml.BinaryOp(11, mml.BinaryOp(11, mml.Ref(_left, "collectParam"), ""), mml.BinaryOp(11, mml.Ref(_right, "collectParam"), "")).(bool)) && mml.BinaryOp(11, mml.Ref(_left, "effect"), mml.Ref(_right, "effect")).(bool))
+		}
+		_sameSignature = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_sameSignature(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_verifyFunction = func(_declared, _d interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _f interface{}
+			mml.Nop(_f)
+			_f = mml.Ref(_d, "expression")
+			switch {
+			case (mml.BinaryOp(11, mml.Ref(_f, "type"), "function").(bool) && !direct_sameSignature(mml.Ref(_declared, "expression"), _f).(bool)):
+
+				mml.Nop()
+				return (&mml.List{}).Append(_formats.(*mml.Function).Call([]interface{}{"%s: %s: expected %s, found %s", direct_position(_d), mml.Ref(_d, "symbol"), direct_signature(mml.Ref(_declared, "expression")), direct_signature(_f)}))
+			case (mml.BinaryOp(12, mml.Ref(_f, "type"), "function").(bool) && direct_known(_f).(bool)):
+
+				mml.Nop()
+				return (&mml.List{}).Append(_formats.(*mml.Function).Call([]interface{}{"%s: %s: expected a function", direct_position(_d), mml.Ref(_d, "symbol")}))
+			default:
+
+				mml.Nop()
+				return (&mml.List{})
+			}
+		}
+		_verifyFunction = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_verifyFunction(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_verifyValue = func(_declared, _p, _d interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				if direct_known(mml.Ref(_d, "expression")).(bool) && !_is.(*mml.Function).Call([]interface{}{_p, direct_value(mml.Ref(_d, "expression"))}).(bool) {
+					return (&mml.List{}).Append(_formats.(*mml.Function).Call([]interface{}{"%s: %s: the value does not match the pattern at %s", direct_position(_d), mml.Ref(_d, "symbol"), direct_position(_declared)}))
+				} else {
+					return (&mml.List{})
+				}
+			}()
+		}
+		_verifyValue = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_verifyValue(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_verifyDefinition = func(_implementation, _declared, _p interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			switch {
+			case !_contains.(*mml.Function).Call([]interface{}{mml.Ref(_declared, "symbol"), mml.Ref(_implementation, "names")}).(bool):
+
+				mml.Nop()
+				return (&mml.List{}).Append(_formats.(*mml.Function).Call([]interface{}{"%s: %s: not exported", direct_position(_declared), mml.Ref(_declared, "symbol")}))
+			case !_has.(*mml.Function).Call([]interface{}{mml.Ref(_declared, "symbol"), mml.Ref(_implementation, "definitions")}).(bool):
+
+				mml.Nop()
+				return (&mml.List{})
+			case mml.BinaryOp(11, mml.Ref(mml.Ref(_declared, "expression"), "type"), "function"):
+
+				mml.Nop()
+				return direct_verifyFunction(_declared, mml.Ref(mml.Ref(_implementation, "definitions"), mml.Ref(_declared, "symbol")))
+			default:
+
+				mml.Nop()
+				return direct_verifyValue(_declared, _p, mml.Ref(mml.Ref(_implementation, "definitions"), mml.Ref(_declared, "symbol")))
+			}
+		}
+		_verifyDefinition = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_verifyDefinition(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_implementation = func(_moduleCode interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _exported interface{}
+			mml.Nop(_exported)
+			_exported = _filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("exported", true); ; return s }()})}).(*mml.Function).Call([]interface{}{mml.Ref(_code, "getDefinitions").(*mml.Function).Call([]interface{}{mml.Ref(_moduleCode, "body")})})
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Set("names", _map.(*mml.Function).Call([]interface{}{&mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _d = a[0]
+						mml.Nop(_d)
+						return mml.Ref(_d, "symbol")
+					},
+					FixedArgs: 1,
+				}, _exported}))
+				s.Set("definitions", _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _d = a[0]
+						var _defs = a[1]
+						mml.Nop(_d, _defs)
+						return func() interface{} {
+							s := &mml.Struct{}
+							s.Merge(_defs.(*mml.Struct))
+							s.Set(mml.Ref(_d, "symbol").(string), _d)
+							return s
+						}()
+					},
+					FixedArgs: 2,
+				}, func() interface{} { s := &mml.Struct{}; ; return s }(), _exported}))
+				return s
+			}()
+		}
+		_implementation = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_implementation(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["implementation"] = _implementation
+		direct_verify = func(_used, _interfaceFile, _interfaceCode, _implementation interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _declared interface{}
+			var _patterns interface{}
+			var _problems interface{}
+			mml.Nop(_declared, _patterns, _problems)
+			_declared = _filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("exported", true); ; return s }()})}).(*mml.Function).Call([]interface{}{mml.Ref(_code, "getDefinitions").(*mml.Function).Call([]interface{}{mml.Ref(_interfaceCode, "body")})})
+			_patterns = mml.Ref(_errors, "any").(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _d = a[0]
+					mml.Nop(_d)
+					return func() interface{} {
+						c = mml.BinaryOp(11, mml.Ref(mml.Ref(_d, "expression"), "type"), "function")
+						if c.(bool) {
+							return _any
+						} else {
+							return direct_pattern(mml.Ref(_d, "expression"))
+						}
+					}()
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_declared})})
+			if v := _patterns; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_problems = _flat.(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _i = a[0]
+					mml.Nop(_i)
+					return direct_verifyDefinition(_implementation, mml.Ref(_declared, _i), mml.Ref(_patterns, _i))
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{mml.Ref(_lists, "indexes").(*mml.Function).Call([]interface{}{_declared})})})
+			c = mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_problems}), 0)
+			if c.(bool) {
+				mml.Nop()
+				return true
+			}
+			return _error.(*mml.Function).Call([]interface{}{_formats.(*mml.Function).Call([]interface{}{"%s does not implement %s:%s", _used, _interfaceFile, _join.(*mml.Function).Call([]interface{}{"", _map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _p = a[0]
+					mml.Nop(_p)
+					return _formats.(*mml.Function).Call([]interface{}{"\n\t%s", _p})
+				},
+				FixedArgs: 1,
+			}, _problems})})})})
+		}
+		_verify = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_verify(a[0], a[1], a[2], a[3])
+			},
+			FixedArgs: 4,
+		}
+		exports["verify"] = _verify
+
+		return exports
+	})
+
+	modulePath = "signatures"
+
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
+		exports := make(map[string]interface{})
+
+		var c interface{}
+		mml.Nop(c)
+
+		var _interopPath string
+		var _literalUse interface{}
+		var _position interface{}
+		var _parseLine interface{}
+		var _packageSignatures interface{}
+		var _shadow interface{}
+		var _annotate interface{}
+		var _literalTypes interface{}
+		var _argumentProblems interface{}
+		var _moduleProblems interface{}
+		var _replaceUse interface{}
+		var _uses interface{}
+		var _packages interface{}
+		var _parse interface{}
+		var _verify interface{}
+		var _code interface{}
+		var _codetree interface{}
+		var _strings interface{}
+		var _lists interface{}
+		var _fold interface{}
+		var _foldr interface{}
+		var _map interface{}
+		var _filter interface{}
+		var _contains interface{}
+		var _sort interface{}
+		var _flat interface{}
+		var _flats interface{}
+		var _uniq interface{}
+		var _every interface{}
+		var _some interface{}
+		var _join interface{}
+		var _joins interface{}
+		var _formats interface{}
+		var _enum interface{}
+		var _log interface{}
+		var _fatal interface{}
+		var _bind interface{}
+		var _identity interface{}
+		var _eq interface{}
+		var _any interface{}
+		var _function interface{}
+		var _channel interface{}
+		var _natural interface{}
+		var _type interface{}
+		var _listOf interface{}
+		var _structOf interface{}
+		var _range interface{}
+		var _rangeMin interface{}
+		var _listLength interface{}
+		var _or interface{}
+		var _and interface{}
+		var _not interface{}
+		var _predicate interface{}
+		var _is interface{}
+		var direct_position func(interface{}) interface{}
+		mml.Nop(direct_position)
+		var direct_parseLine func(interface{}) interface{}
+		mml.Nop(direct_parseLine)
+		var direct_packageSignatures func(interface{}, interface{}) interface{}
+		mml.Nop(direct_packageSignatures)
+		var direct_shadow func(interface{}, interface{}) interface{}
+		mml.Nop(direct_shadow)
+		var direct_annotate func(interface{}, interface{}) interface{}
+		mml.Nop(direct_annotate)
+		var direct_argumentProblems func(interface{}) interface{}
+		mml.Nop(direct_argumentProblems)
+		var direct_moduleProblems func(interface{}, interface{}) interface{}
+		mml.Nop(direct_moduleProblems)
+		var direct_replaceUse func(interface{}) interface{}
+		mml.Nop(direct_replaceUse)
+		var direct_uses func(interface{}) interface{}
+		mml.Nop(direct_uses)
+		var direct_packages func(interface{}) interface{}
+		mml.Nop(direct_packages)
+		var direct_parse func(interface{}) interface{}
+		mml.Nop(direct_parse)
+		var direct_verify func(interface{}, interface{}) interface{}
+		mml.Nop(direct_verify)
+		mml.Nop(_interopPath, _literalUse, _position, _parseLine, _packageSignatures, _shadow, _annotate, _literalTypes, _argumentProblems, _moduleProblems, _replaceUse, _uses, _packages, _parse, _verify, _code, _codetree, _strings, _lists, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _flats, _uniq, _every, _some, _join, _joins, _formats, _enum, _log, _fatal, _bind, _identity, _eq, _any, _function, _channel, _natural, _type, _listOf, _structOf, _range, _rangeMin, _listLength, _or, _and, _not, _predicate, _is)
+		var __lang = use("lang")
+		_fold = __lang.Get("fold")
+		_foldr = __lang.Get("foldr")
+		_map = __lang.Get("map")
+		_filter = __lang.Get("filter")
+		_contains = __lang.Get("contains")
+		_sort = __lang.Get("sort")
+		_flat = __lang.Get("flat")
+		_flats = __lang.Get("flats")
+		_uniq = __lang.Get("uniq")
+		_every = __lang.Get("every")
+		_some = __lang.Get("some")
+		_join = __lang.Get("join")
+		_joins = __lang.Get("joins")
+		_formats = __lang.Get("formats")
+		_enum = __lang.Get("enum")
+		_log = __lang.Get("log")
+		_fatal = __lang.Get("fatal")
+		_bind = __lang.Get("bind")
+		_identity = __lang.Get("identity")
+		_eq = __lang.Get("eq")
+		_any = __lang.Get("any")
+		_function = __lang.Get("function")
+		_channel = __lang.Get("channel")
+		_natural = __lang.Get("natural")
+		_type = __lang.Get("type")
+		_listOf = __lang.Get("listOf")
+		_structOf = __lang.Get("structOf")
+		_range = __lang.Get("range")
+		_rangeMin = __lang.Get("rangeMin")
+		_listLength = __lang.Get("listLength")
+		_or = __lang.Get("or")
+		_and = __lang.Get("and")
+		_not = __lang.Get("not")
+		_predicate = __lang.Get("predicate")
+		_is = __lang.Get("is")
+		_code = use("code")
+		_codetree = use("codetree")
+		_strings = use("strings")
+		_lists = use("lists")
+		_interopPath = "mml:/interop"
+		_literalUse = _and.(*mml.Function).Call([]interface{}{func() interface{} {
+			s := &mml.Struct{}
+			s.Set("type", "application")
+			s.Set("function", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("type", "symbol")
+				s.Set("name", "interopUse")
+				return s
+			}())
+			s.Set("args", (&mml.List{}).Append(func() interface{} { s := &mml.Struct{}; s.Set("type", "string"); ; return s }(), func() interface{} { s := &mml.Struct{}; s.Set("type", "string"); ; return s }()))
+			return s
+		}(), _predicate.(*mml.Function).Call([]interface{}{&mml.Function{
+			F: func(a []interface{}) interface{} {
+				var c interface{}
+				mml.Nop(c)
+				var _a = a[0]
+				mml.Nop(_a)
+				return mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{mml.Ref(_a, "args")}), 2)
+			},
+			FixedArgs: 1,
+		}})})
+		direct_position = func(_c interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _formats.(*mml.Function).Call([]interface{}{"%s:%d:%d", mml.Ref(mml.Ref(_c, "ast"), "file"), mml.Ref(mml.Ref(_c, "ast"), "line"), mml.Ref(mml.Ref(_c, "ast"), "column")})
+		}
+		_position = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_position(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_replaceUse = func(_moduleCode interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _names interface{}
+			var _replace interface{}
+			mml.Nop(_names, _replace)
+			_names = _map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _u = a[0]
+					mml.Nop(_u)
+					return func() interface{} {
+						c = _has.(*mml.Function).Call([]interface{}{"capture", _u})
+						if c.(bool) {
+							return mml.Ref(_u, "capture")
+						} else {
+							return mml.Ref(_code, "getModuleName").(*mml.Function).Call([]interface{}{_interopPath})
+						}
+					}()
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{mml.Ref(_codetree, "filter").(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("type", "use")
+				s.Set("path", func() interface{} { s := &mml.Struct{}; s.Set("value", _interopPath); ; return s }())
+				return s
+			}()})}).(*mml.Function).Call([]interface{}{_moduleCode})})
+			_replace = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _c = a[0]
+					mml.Nop(_c)
+					return func() interface{} {
+						if _is.(*mml.Function).Call([]interface{}{func() interface{} {
+							s := &mml.Struct{}
+							s.Set("type", "indexer")
+							s.Set("expression", func() interface{} { s := &mml.Struct{}; s.Set("type", "symbol"); ; return s }())
+							s.Set("index", func() interface{} {
+								s := &mml.Struct{}
+								s.Set("type", "symbol-index")
+								s.Set("symbol", func() interface{} { s := &mml.Struct{}; s.Set("name", "use"); ; return s }())
+								return s
+							}())
+							return s
+						}(), _c}).(bool) && _contains.(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_c, "expression"), "name"), _names}).(bool) {
+							return func() interface{} {
+								s := &mml.Struct{}
+								s.Set("type", "symbol")
+								s.Set("ast", mml.Ref(_c, "ast"))
+								s.Set("name", "interopUse")
+								return s
+							}()
+						} else {
+							return _c
+						}
+					}()
+				},
+				FixedArgs: 1,
+			}
+			return func() interface{} {
+				c = mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_names}), 0)
+				if c.(bool) {
+					return _moduleCode
+				} else {
+					return mml.Ref(_codetree, "edit").(*mml.Function).Call([]interface{}{_replace, _moduleCode})
+				}
+			}()
+		}
+		_replaceUse = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_replaceUse(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["replaceUse"] = _replaceUse
+		direct_uses = func(_modules interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _applications interface{}
+			var _invalid interface{}
+			mml.Nop(_applications, _invalid)
+			_applications = _flat.(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{mml.Ref(_codetree, "filter").(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("type", "application")
+				s.Set("function", func() interface{} {
+					s := &mml.Struct{}
+					s.Set("type", "symbol")
+					s.Set("name", "interopUse")
+					return s
+				}())
+				return s
+			}()})})}).(*mml.Function).Call([]interface{}{_modules})})
+			_invalid = _filter.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _a = a[0]
+					mml.Nop(_a)
+					return !_is.(*mml.Function).Call([]interface{}{_literalUse, _a}).(bool)
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_applications})
+			c = mml.BinaryOp(15, _len.(*mml.Function).Call([]interface{}{_invalid}), 0)
+			if c.(bool) {
+				mml.Nop()
+				return _error.(*mml.Function).Call([]interface{}{_formats.(*mml.Function).Call([]interface{}{"%s: interop.use expects two string literals", direct_position(mml.Ref(_invalid, 0))})})
+			}
+			return _map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _a = a[0]
+					mml.Nop(_a)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Set("package", mml.Ref(mml.Ref(mml.Ref(_a, "args"), 0), "value"))
+						s.Set("name", mml.Ref(mml.Ref(mml.Ref(_a, "args"), 1), "value"))
+						s.Set("ast", mml.Ref(_a, "ast"))
+						return s
+					}()
+				},
+				FixedArgs: 1,
+			}, _applications})
+		}
+		_uses = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_uses(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["uses"] = _uses
+		direct_packages = func(_modules interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _sort.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _left = a[0]
+					var _right = a[1]
+					mml.Nop(_left, _right)
+					return mml.BinaryOp(13, _left, _right)
+				},
+				FixedArgs: 2,
+			}}).(*mml.Function).Call([]interface{}{_uniq.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _left = a[0]
+					var _right = a[1]
+					mml.Nop(_left, _right)
+					return mml.BinaryOp(11, _left, _right)
+				},
+				FixedArgs: 2,
+			}}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _a = a[0]
+					mml.Nop(_a)
+					return mml.Ref(mml.Ref(mml.Ref(_a, "args"), 0), "value")
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_flat.(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{mml.Ref(_codetree, "filter").(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{_literalUse})})}).(*mml.Function).Call([]interface{}{_modules})})})})})
+		}
+		_packages = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_packages(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["packages"] = _packages
+		direct_parseLine = func(_line interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _fields interface{}
+			mml.Nop(_fields)
+			_fields = mml.Ref(_strings, "split").(*mml.Function).Call([]interface{}{" ", _line})
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Set("package", mml.Ref(_fields, 0))
+				s.Set("name", mml.Ref(_fields, 1))
+				s.Set("returns", mml.Ref(_fields, 2))
+				s.Set("variadic", mml.BinaryOp(11, mml.Ref(_fields, 3), "true"))
+				s.Set("collect", mml.Ref(_fields, 4))
+				s.Set("params", mml.RefRange(_fields, 5, nil))
+				return s
+			}()
+		}
+		_parseLine = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_parseLine(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_packageSignatures = func(_all, _p interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				c = _has.(*mml.Function).Call([]interface{}{_p, _all})
+				if c.(bool) {
+					return mml.Ref(_all, _p)
+				} else {
+					return func() interface{} { s := &mml.Struct{}; ; return s }()
+				}
+			}()
+		}
+		_packageSignatures = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_packageSignatures(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_parse = func(_description interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _s = a[0]
+					var _all = a[1]
+					mml.Nop(_s, _all)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_all.(*mml.Struct))
+						s.Set(mml.Ref(_s, "package").(string), func() interface{} {
+							s := &mml.Struct{}
+							s.Merge(direct_packageSignatures(_all, mml.Ref(_s, "package")).(*mml.Struct))
+							s.Set(mml.Ref(_s, "name").(string), _s)
+							return s
+						}())
+						return s
+					}()
+				},
+				FixedArgs: 2,
+			}, func() interface{} { s := &mml.Struct{}; ; return s }()}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_parseLine}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _l = a[0]
+					mml.Nop(_l)
+					return mml.BinaryOp(12, _l, "")
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{mml.Ref(_strings, "split").(*mml.Function).Call([]interface{}{"\n"}).(*mml.Function).Call([]interface{}{_description})})})})
+		}
+		_parse = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_parse(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["parse"] = _parse
+		direct_shadow = func(_env, _names interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _name = a[0]
+					var _e = a[1]
+					mml.Nop(_name, _e)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_e.(*mml.Struct))
+						s.Set(_name.(string), func() interface{} { s := &mml.Struct{}; ; return s }())
+						return s
+					}()
+				},
+				FixedArgs: 2,
+			}, _env, _names})
+		}
+		_shadow = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_shadow(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_annotate = func(_env, _c interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _annotateChildren interface{}
+			mml.Nop(_annotateChildren)
+			_annotateChildren = mml.Ref(_codetree, "mapChildren").(*mml.Function).Call([]interface{}{_annotate.(*mml.Function).Call([]interface{}{_env})})
+			switch mml.Ref(_c, "type") {
+			case "application":
+				var _a interface{}
+				mml.Nop(_a)
+				_a = _annotateChildren.(*mml.Function).Call([]interface{}{_c})
+				return func() interface{} {
+					if (_is.(*mml.Function).Call([]interface{}{func() interface{} {
+						s := &mml.Struct{}
+						s.Set("function", func() interface{} { s := &mml.Struct{}; s.Set("type", "symbol"); ; return s }())
+						return s
+					}(), _c}).(bool) && _has.(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_c, "function"), "name"), _env}).(bool)) && _has.(*mml.Function).Call([]interface{}{"signature", mml.Ref(_env, mml.Ref(mml.Ref(_c, "function"), "name"))}).(bool) {
+						return func() interface{} {
+							s := &mml.Struct{}
+							s.Merge(_a.(*mml.Struct))
+							s.Set("signature", mml.Ref(mml.Ref(_env, mml.Ref(mml.Ref(_c, "function"), "name")), "signature"))
+							return s
+						}()
+					} else {
+						return _a
+					}
+				}()
+			case "symbol":
+
+				mml.Nop()
+				return _c
+			case "statement-list":
+
+				mml.Nop()
+				return mml.Ref(_codetree, "mapChildren").(*mml.Function).Call([]interface{}{_annotate.(*mml.Function).Call([]interface{}{direct_shadow(_env, mml.Ref(_code, "getScope").(*mml.Function).Call([]interface{}{_c}))}), _c})
+			case "function":
+
+				mml.Nop()
+				return mml.Ref(_codetree, "mapChildren").(*mml.Function).Call([]interface{}{_annotate.(*mml.Function).Call([]interface{}{direct_shadow(_env, (&mml.List{}).Concat(mml.Ref(_c, "params").(*mml.List)).Append(mml.Ref(_c, "collectParam")))}), _c})
+			case "loop":
+
+				mml.Nop()
+				return func() interface{} {
+					c = _is.(*mml.Function).Call([]interface{}{func() interface{} {
+						s := &mml.Struct{}
+						s.Set("expression", func() interface{} { s := &mml.Struct{}; s.Set("type", "range-over"); s.Set("symbol", _any); ; return s }())
+						return s
+					}(), _c})
+					if c.(bool) {
+						return func() interface{} {
+							s := &mml.Struct{}
+							s.Merge(_annotateChildren.(*mml.Function).Call([]interface{}{_c}).(*mml.Struct))
+							s.Set("body", direct_annotate(direct_shadow(_env, (&mml.List{}).Append(mml.Ref(mml.Ref(_c, "expression"), "symbol"))), mml.Ref(_c, "body")))
+							return s
+						}()
+					} else {
+						return _annotateChildren.(*mml.Function).Call([]interface{}{_c})
+					}
+				}()
+			case "select-case":
+
+				mml.Nop()
+				return func() interface{} {
+					c = _is.(*mml.Function).Call([]interface{}{func() interface{} {
+						s := &mml.Struct{}
+						s.Set("expression", func() interface{} { s := &mml.Struct{}; s.Set("type", "definition"); ; return s }())
+						return s
+					}(), _c})
+					if c.(bool) {
+						return mml.Ref(_codetree, "mapChildren").(*mml.Function).Call([]interface{}{_annotate.(*mml.Function).Call([]interface{}{direct_shadow(_env, (&mml.List{}).Append(mml.Ref(mml.Ref(_c, "expression"), "symbol")))}), _c})
+					} else {
+						return _annotateChildren.(*mml.Function).Call([]interface{}{_c})
+					}
+				}()
+			default:
+
+				mml.Nop()
+				return _annotateChildren.(*mml.Function).Call([]interface{}{_c})
+			}
+		}
+		_annotate = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_annotate(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		_literalTypes = func() interface{} {
+			s := &mml.Struct{}
+			s.Set("int", "int")
+			s.Set("float", "float")
+			s.Set("string", "string")
+			s.Set("bool", "bool")
+			s.Set("list", "list")
+			s.Set("struct", "struct")
+			s.Set("function", "function")
+			return s
+		}()
+		direct_argumentProblems = func(_a interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _s interface{}
+			var _name interface{}
+			var _expected interface{}
+			mml.Nop(_s, _name, _expected)
+			_s = mml.Ref(_a, "signature")
+			_name = _formats.(*mml.Function).Call([]interface{}{"%s.%s", mml.Ref(_s, "package"), mml.Ref(_s, "name")})
+			if !mml.Ref(_s, "variadic").(bool) && mml.BinaryOp(15, _len.(*mml.Function).Call([]interface{}{mml.Ref(_a, "args")}), _len.(*mml.Function).Call([]interface{}{mml.Ref(_s, "params")})).(bool) {
+				mml.Nop()
+				return (&mml.List{}).Append(_formats.(*mml.Function).Call([]interface{}{"%s: %s: too many arguments, expected %d, got %d", direct_position(_a), _name, _len.(*mml.Function).Call([]interface{}{mml.Ref(_s, "params")}), _len.(*mml.Function).Call([]interface{}{mml.Ref(_a, "args")})}))
+			}
+			_expected = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _i = a[0]
+					mml.Nop(_i)
+					return func() interface{} {
+						c = mml.BinaryOp(13, _i, _len.(*mml.Function).Call([]interface{}{mml.Ref(_s, "params")}))
+						if c.(bool) {
+							return mml.Ref(mml.Ref(_s, "params"), _i)
+						} else {
+							return mml.Ref(_s, "collect")
+						}
+					}()
+				},
+				FixedArgs: 1,
+			}
+			return _map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _i = a[0]
+					mml.Nop(_i)
+					return _formats.(*mml.Function).Call([]interface{}{"%s: %s: argument %d: expected %s, got %s", direct_position(mml.Ref(mml.Ref(_a, "args"), _i)), _name, mml.BinaryOp(9, _i, 1), _expected.(*mml.Function).Call([]interface{}{_i}), mml.Ref(_literalTypes, mml.Ref(mml.Ref(mml.Ref(_a, "args"), _i), "type"))})
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _i = a[0]
+					mml.Nop(_i)
+					return (mml.BinaryOp(12, _expected.(*mml.Function).Call([]interface{}{_i}), "any").(bool) && mml.BinaryOp(12, _expected.(*mml.Function).Call([]interface{}{_i}), mml.Ref(_literalTypes, mml.Ref(mml.Ref(mml.Ref(_a, "args"), _i), "type"))).(bool))
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _i = a[0]
+					mml.Nop(_i)
+					return _has.(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(mml.Ref(_a, "args"), _i), "type"), _literalTypes})
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{mml.Ref(_lists, "indexes").(*mml.Function).Call([]interface{}{mml.Ref(_a, "args")})})})})
+		}
+		_argumentProblems = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_argumentProblems(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_moduleProblems = func(_signatures, _m interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _bound interface{}
+			mml.Nop(_bound)
+			_bound = _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _d = a[0]
+					var _env = a[1]
+					mml.Nop(_d, _env)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_env.(*mml.Struct))
+						s.Set(mml.Ref(_d, "symbol").(string), func() interface{} {
+							s := &mml.Struct{}
+							s.Set("signature", mml.Ref(mml.Ref(_signatures, mml.Ref(mml.Ref(mml.Ref(mml.Ref(_d, "expression"), "args"), 0), "value")), mml.Ref(mml.Ref(mml.Ref(mml.Ref(_d, "expression"), "args"), 1), "value")))
+							return s
+						}())
+						return s
+					}()
+				},
+				FixedArgs: 2,
+			}, func() interface{} { s := &mml.Struct{}; ; return s }()}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _d = a[0]
+					mml.Nop(_d)
+					return _has.(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(mml.Ref(mml.Ref(_d, "expression"), "args"), 1), "value"), mml.Ref(_signatures, mml.Ref(mml.Ref(mml.Ref(mml.Ref(_d, "expression"), "args"), 0), "value"))})
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _d = a[0]
+					mml.Nop(_d)
+					return _has.(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(mml.Ref(mml.Ref(_d, "expression"), "args"), 0), "value"), _signatures})
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("mutable", false)
+				s.Set("expression", _literalUse)
+				return s
+			}()})}).(*mml.Function).Call([]interface{}{mml.Ref(_code, "getDefinitions").(*mml.Function).Call([]interface{}{mml.Ref(_m, "body")})})})})})
+			return _flat.(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_argumentProblems}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _a = a[0]
+					mml.Nop(_a)
+					return _every.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} {
+						s := &mml.Struct{}
+						s.Set("type", _not.(*mml.Function).Call([]interface{}{"spread"}))
+						return s
+					}()}), mml.Ref(_a, "args")})
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{mml.Ref(_codetree, "filter").(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("type", "application")
+				s.Set("signature", _any)
+				return s
+			}()})}).(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Merge(_m.(*mml.Struct))
+				s.Set("body", mml.Ref(_codetree, "mapChildren").(*mml.Function).Call([]interface{}{_annotate.(*mml.Function).Call([]interface{}{_bound}), mml.Ref(_m, "body")}))
+				return s
+			}()})})})})
+		}
+		_moduleProblems = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_moduleProblems(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_verify = func(_modules, _signatures interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _used interface{}
+			var _missing interface{}
+			var _problems interface{}
+			mml.Nop(_used, _missing, _problems)
+			_used = direct_uses(_modules)
+			if v := _used; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_missing = _map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _u = a[0]
+					mml.Nop(_u)
+					return _formats.(*mml.Function).Call([]interface{}{"%s: interop function not registered: %s.%s", direct_position(_u), mml.Ref(_u, "package"), mml.Ref(_u, "name")})
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _u = a[0]
+					mml.Nop(_u)
+					return (!_has.(*mml.Function).Call([]interface{}{mml.Ref(_u, "package"), _signatures}).(bool) || !_has.(*mml.Function).Call([]interface{}{mml.Ref(_u, "name"), mml.Ref(_signatures, mml.Ref(_u, "package"))}).(bool))
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_used})})
+			_problems = (&mml.List{}).Concat(_missing.(*mml.List)).Concat(_flat.(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_moduleProblems.(*mml.Function).Call([]interface{}{_signatures}), _modules})}).(*mml.List))
+			return func() interface{} {
+				c = mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_problems}), 0)
+				if c.(bool) {
+					return true
+				} else {
+					return _error.(*mml.Function).Call([]interface{}{_join.(*mml.Function).Call([]interface{}{"\n", _problems})})
+				}
+			}()
+		}
+		_verify = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_verify(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		exports["verify"] = _verify
+
+		return exports
+	})
+
+	modulePath = "compile"
+	// evaluated at compile time: lowerCase, upperCase
+
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
+		exports := make(map[string]interface{})
+
+		var c interface{}
+		mml.Nop(c)
+
+		var _primitive interface{}
+		var _floatLiteral interface{}
+		var _stringLiteral interface{}
+		var _symbol interface{}
+		var _goTypes interface{}
+		var _goOperators interface{}
+		var _literalGoTypes interface{}
+		var _goTypeOf interface{}
+		var _typed interface{}
+		var _ifCondition interface{}
+		var _spread interface{}
+		var _listGroups interface{}
+		var _values interface{}
+		var _list interface{}
+		var _expressionKey interface{}
+		var _struct interface{}
+		var _paramList interface{}
+		var _breaks interface{}
+		var _continues interface{}
+		var _terminates interface{}
+		var _functionBody interface{}
 		var _functionLiteral interface{}
+		var _directFunction interface{}
+		var _directWrapper interface{}
 		var _indexer interface{}
 		var _application interface{}
 		var _unary interface{}
 		var _binary interface{}
 		var _ternary interface{}
-		var _ifStatement interface{}
-		var _cond interface{}
-		var _caseBlock interface{}
-		var _switchStatement interface{}
-		var _sendStatement interface{}
-		var _receiveExpression interface{}
-		var _goStatement interface{}
-		var _deferStatement interface{}
-		var _selectStatement interface{}
-		var _rangeOver interface{}
-		var _loop interface{}
-		var _definition interface{}
-		var _definitionGroup interface{}
-		var _assign interface{}
-		var _ret interface{}
-		var _checkRet interface{}
-		var _useStatement interface{}
-		var _useList interface{}
-		var _module interface{}
+		var _ifStatement interface{}
+		var _cond interface{}
+		var _caseBlock interface{}
+		var _switchStatement interface{}
+		var _position interface{}
+		var _sendStatement interface{}
+		var _receiveExpression interface{}
+		var _goStatement interface{}
+		var _deferStatement interface{}
+		var _selectStatement interface{}
+		var _rangeOver interface{}
+		var _loop interface{}
+		var _definition interface{}
+		var _definitionGroup interface{}
+		var _assign interface{}
+		var _returnValue interface{}
+		var _ret interface{}
+		var _checkValue interface{}
+		var _checkRet interface{}
+		var _useStatement interface{}
+		var _useList interface{}
+		var _module interface{}
+		var _statementList interface{}
+		var _do interface{}
+		var _builtins interface{}
+		var _builtinDefinition interface{}
+		var _moduleCode interface{}
+		var _interopImports interface{}
+		var _modulesToGo interface{}
+		var _goKeywords interface{}
+		var _zeroValues interface{}
+		var _exportedName interface{}
+		var _goParam interface{}
+		var _exportParams interface{}
+		var _exportArgs interface{}
+		var _exportResult interface{}
+		var _exportFunction interface{}
+		var _exportValue interface{}
+		var _exports interface{}
+		var _intLiteral interface{}
+		var _boolLiteral interface{}
+		var _breakStatement interface{}
+		var _continueStatement interface{}
+		var _allModules interface{}
+		var _toGo interface{}
+		var _lowerCase interface{}
+		var _upperCase interface{}
+		var _libraryToGo interface{}
+		var _moduleToGo interface{}
+		var _mainToGo interface{}
+		var _strings interface{}
+		var _code interface{}
+		var _lists interface{}
+		var _structs interface{}
+		var _snippets interface{}
+		var _codetree interface{}
+		var _tailcalls interface{}
+		var _constants interface{}
+		var _deadcode interface{}
+		var _types interface{}
+		var _tasks interface{}
+		var _signatures interface{}
+		var _fold interface{}
+		var _foldr interface{}
+		var _map interface{}
+		var _filter interface{}
+		var _contains interface{}
+		var _sort interface{}
+		var _flat interface{}
+		var _flats interface{}
+		var _uniq interface{}
+		var _every interface{}
+		var _some interface{}
+		var _join interface{}
+		var _joins interface{}
+		var _formats interface{}
+		var _enum interface{}
+		var _log interface{}
+		var _fatal interface{}
+		var _bind interface{}
+		var _identity interface{}
+		var _eq interface{}
+		var _any interface{}
+		var _function interface{}
+		var _channel interface{}
+		var _natural interface{}
+		var _type interface{}
+		var _listOf interface{}
+		var _structOf interface{}
+		var _range interface{}
+		var _rangeMin interface{}
+		var _listLength interface{}
+		var _or interface{}
+		var _and interface{}
+		var _not interface{}
+		var _predicate interface{}
+		var _is interface{}
+		var direct_primitive func(interface{}) interface{}
+		mml.Nop(direct_primitive)
+		var direct_floatLiteral func(interface{}) interface{}
+		mml.Nop(direct_floatLiteral)
+		var direct_stringLiteral func(interface{}) interface{}
+		mml.Nop(direct_stringLiteral)
+		var direct_symbol func(interface{}) interface{}
+		mml.Nop(direct_symbol)
+		var direct_goTypeOf func(interface{}) interface{}
+		mml.Nop(direct_goTypeOf)
+		var direct_typed func(interface{}, interface{}) interface{}
+		mml.Nop(direct_typed)
+		var direct_ifCondition func(interface{}) interface{}
+		mml.Nop(direct_ifCondition)
+		var direct_spread func(interface{}) interface{}
+		mml.Nop(direct_spread)
+		var direct_listGroups func(interface{}) interface{}
+		mml.Nop(direct_listGroups)
+		var direct_values func(interface{}) interface{}
+		mml.Nop(direct_values)
+		var direct_list func(interface{}) interface{}
+		mml.Nop(direct_list)
+		var direct_expressionKey func(interface{}) interface{}
+		mml.Nop(direct_expressionKey)
+		var direct_struct func(interface{}) interface{}
+		mml.Nop(direct_struct)
+		var direct_paramList func(interface{}, interface{}) interface{}
+		mml.Nop(direct_paramList)
+		var direct_breaks func(interface{}) interface{}
+		mml.Nop(direct_breaks)
+		var direct_continues func(interface{}) interface{}
+		mml.Nop(direct_continues)
+		var direct_terminates func(interface{}) interface{}
+		mml.Nop(direct_terminates)
+		var direct_functionBody func(interface{}) interface{}
+		mml.Nop(direct_functionBody)
+		var direct_functionLiteral func(interface{}) interface{}
+		mml.Nop(direct_functionLiteral)
+		var direct_directFunction func(interface{}) interface{}
+		mml.Nop(direct_directFunction)
+		var direct_directWrapper func(interface{}) interface{}
+		mml.Nop(direct_directWrapper)
+		var direct_indexer func(interface{}) interface{}
+		mml.Nop(direct_indexer)
+		var direct_application func(interface{}) interface{}
+		mml.Nop(direct_application)
+		var direct_unary func(interface{}) interface{}
+		mml.Nop(direct_unary)
+		var direct_binary func(interface{}) interface{}
+		mml.Nop(direct_binary)
+		var direct_ternary func(interface{}) interface{}
+		mml.Nop(direct_ternary)
+		var direct_ifStatement func(interface{}) interface{}
+		mml.Nop(direct_ifStatement)
+		var direct_cond func(interface{}) interface{}
+		mml.Nop(direct_cond)
+		var direct_caseBlock func(interface{}) interface{}
+		mml.Nop(direct_caseBlock)
+		var direct_switchStatement func(interface{}) interface{}
+		mml.Nop(direct_switchStatement)
+		var direct_position func(interface{}) interface{}
+		mml.Nop(direct_position)
+		var direct_sendStatement func(interface{}) interface{}
+		mml.Nop(direct_sendStatement)
+		var direct_receiveExpression func(interface{}) interface{}
+		mml.Nop(direct_receiveExpression)
+		var direct_goStatement func(interface{}) interface{}
+		mml.Nop(direct_goStatement)
+		var direct_deferStatement func(interface{}) interface{}
+		mml.Nop(direct_deferStatement)
+		var direct_selectStatement func(interface{}) interface{}
+		mml.Nop(direct_selectStatement)
+		var direct_rangeOver func(interface{}) interface{}
+		mml.Nop(direct_rangeOver)
+		var direct_loop func(interface{}) interface{}
+		mml.Nop(direct_loop)
+		var direct_definition func(interface{}) interface{}
+		mml.Nop(direct_definition)
+		var direct_definitionGroup func(interface{}) interface{}
+		mml.Nop(direct_definitionGroup)
+		var direct_assign func(interface{}) interface{}
+		mml.Nop(direct_assign)
+		var direct_returnValue func(interface{}, interface{}) interface{}
+		mml.Nop(direct_returnValue)
+		var direct_ret func(interface{}) interface{}
+		mml.Nop(direct_ret)
+		var direct_checkValue func(interface{}) interface{}
+		mml.Nop(direct_checkValue)
+		var direct_checkRet func(interface{}) interface{}
+		mml.Nop(direct_checkRet)
+		var direct_useStatement func(interface{}) interface{}
+		mml.Nop(direct_useStatement)
+		var direct_useList func(interface{}) interface{}
+		mml.Nop(direct_useList)
+		var direct_module func(interface{}) interface{}
+		mml.Nop(direct_module)
+		var direct_statementList func(interface{}) interface{}
+		mml.Nop(direct_statementList)
+		var direct_do func(interface{}) interface{}
+		mml.Nop(direct_do)
+		var direct_builtins func(interface{}) interface{}
+		mml.Nop(direct_builtins)
+		var direct_builtinDefinition func(interface{}) interface{}
+		mml.Nop(direct_builtinDefinition)
+		var direct_moduleCode func(interface{}) interface{}
+		mml.Nop(direct_moduleCode)
+		var direct_interopImports func(interface{}) interface{}
+		mml.Nop(direct_interopImports)
+		var direct_modulesToGo func(interface{}) interface{}
+		mml.Nop(direct_modulesToGo)
+		var direct_exportedName func(interface{}) interface{}
+		mml.Nop(direct_exportedName)
+		var direct_goParam func(interface{}) interface{}
+		mml.Nop(direct_goParam)
+		var direct_exportParams func(interface{}) interface{}
+		mml.Nop(direct_exportParams)
+		var direct_exportArgs func(interface{}) interface{}
+		mml.Nop(direct_exportArgs)
+		var direct_exportResult func(interface{}, interface{}) interface{}
+		mml.Nop(direct_exportResult)
+		var direct_exportFunction func(interface{}, interface{}) interface{}
+		mml.Nop(direct_exportFunction)
+		var direct_exportValue func(interface{}, interface{}) interface{}
+		mml.Nop(direct_exportValue)
+		var direct_exports func(interface{}) interface{}
+		mml.Nop(direct_exports)
+		var direct_breakStatement func(interface{}) interface{}
+		mml.Nop(direct_breakStatement)
+		var direct_continueStatement func(interface{}) interface{}
+		mml.Nop(direct_continueStatement)
+		var direct_allModules func(interface{}) interface{}
+		mml.Nop(direct_allModules)
+		var direct_toGo func(interface{}) interface{}
+		mml.Nop(direct_toGo)
+		var direct_libraryToGo func(interface{}, interface{}) interface{}
+		mml.Nop(direct_libraryToGo)
+		var direct_moduleToGo func(interface{}) interface{}
+		mml.Nop(direct_moduleToGo)
+		var direct_mainToGo func(interface{}) interface{}
+		mml.Nop(direct_mainToGo)
+		mml.Nop(_primitive, _floatLiteral, _stringLiteral, _symbol, _goTypes, _goOperators, _literalGoTypes, _goTypeOf, _typed, _ifCondition, _spread, _listGroups, _values, _list, _expressionKey, _struct, _paramList, _breaks, _continues, _terminates, _functionBody, _functionLiteral, _directFunction, _directWrapper, _indexer, _application, _unary, _binary, _ternary, _ifStatement, _cond, _caseBlock, _switchStatement, _position, _sendStatement, _receiveExpression, _goStatement, _deferStatement, _selectStatement, _rangeOver, _loop, _definition, _definitionGroup, _assign, _returnValue, _ret, _checkValue, _checkRet, _useStatement, _useList, _module, _statementList, _do, _builtins, _builtinDefinition, _moduleCode, _interopImports, _modulesToGo, _goKeywords, _zeroValues, _exportedName, _goParam, _exportParams, _exportArgs, _exportResult, _exportFunction, _exportValue, _exports, _intLiteral, _boolLiteral, _breakStatement, _continueStatement, _allModules, _toGo, _lowerCase, _upperCase, _libraryToGo, _moduleToGo, _mainToGo, _strings, _code, _lists, _structs, _snippets, _codetree, _tailcalls, _constants, _deadcode, _types, _tasks, _signatures, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _flats, _uniq, _every, _some, _join, _joins, _formats, _enum, _log, _fatal, _bind, _identity, _eq, _any, _function, _channel, _natural, _type, _listOf, _structOf, _range, _rangeMin, _listLength, _or, _and, _not, _predicate, _is)
+		var __lang = use("lang")
+		_fold = __lang.Get("fold")
+		_foldr = __lang.Get("foldr")
+		_map = __lang.Get("map")
+		_filter = __lang.Get("filter")
+		_contains = __lang.Get("contains")
+		_sort = __lang.Get("sort")
+		_flat = __lang.Get("flat")
+		_flats = __lang.Get("flats")
+		_uniq = __lang.Get("uniq")
+		_every = __lang.Get("every")
+		_some = __lang.Get("some")
+		_join = __lang.Get("join")
+		_joins = __lang.Get("joins")
+		_formats = __lang.Get("formats")
+		_enum = __lang.Get("enum")
+		_log = __lang.Get("log")
+		_fatal = __lang.Get("fatal")
+		_bind = __lang.Get("bind")
+		_identity = __lang.Get("identity")
+		_eq = __lang.Get("eq")
+		_any = __lang.Get("any")
+		_function = __lang.Get("function")
+		_channel = __lang.Get("channel")
+		_natural = __lang.Get("natural")
+		_type = __lang.Get("type")
+		_listOf = __lang.Get("listOf")
+		_structOf = __lang.Get("structOf")
+		_range = __lang.Get("range")
+		_rangeMin = __lang.Get("rangeMin")
+		_listLength = __lang.Get("listLength")
+		_or = __lang.Get("or")
+		_and = __lang.Get("and")
+		_not = __lang.Get("not")
+		_predicate = __lang.Get("predicate")
+		_is = __lang.Get("is")
+		_strings = use("strings")
+		_code = use("code")
+		_lists = use("lists")
+		_structs = use("structs")
+		_snippets = use("snippets")
+		_codetree = use("codetree")
+		_tailcalls = use("tailcalls")
+		_constants = use("constants")
+		_deadcode = use("deadcode")
+		_types = use("types")
+		_tasks = use("tasks")
+		_signatures = use("signatures")
+		direct_primitive = func(_code interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _string.(*mml.Function).Call([]interface{}{mml.Ref(_code, "value")})
+		}
+		_primitive = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_primitive(a[0])
+			},
+			FixedArgs: 1,
+		}
+		_intLiteral = _primitive
+		_boolLiteral = _primitive
+		direct_floatLiteral = func(_code interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _formats.(*mml.Function).Call([]interface{}{"float64(%s)", direct_primitive(_code)})
+		}
+		_floatLiteral = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_floatLiteral(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_stringLiteral = func(_s interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _formats.(*mml.Function).Call([]interface{}{"\"%s\"", mml.Ref(_strings, "escape").(*mml.Function).Call([]interface{}{mml.Ref(_s, "value")})})
+		}
+		_stringLiteral = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_stringLiteral(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_symbol = func(_s interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _formats.(*mml.Function).Call([]interface{}{"_%s", mml.Ref(_s, "name")})
+		}
+		_symbol = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_symbol(a[0])
+			},
+			FixedArgs: 1,
+		}
+		_goTypes = func() interface{} {
+			s := &mml.Struct{}
+			s.Set("int", "int")
+			s.Set("float", "float64")
+			s.Set("string", "string")
+			s.Set("bool", "bool")
+			return s
+		}()
+		_goOperators = (&mml.List{}).Append("&", "|", "^", "&^", "<<", ">>", "*", "/", "%", "+", "-", "==", "!=", "<", "<=", ">", ">=", "&&", "||")
+		_literalGoTypes = func() interface{} {
+			s := &mml.Struct{}
+			s.Set("list", "*mml.List")
+			s.Set("function", "*mml.Function")
+			return s
+		}()
+		direct_goTypeOf = func(_c interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _t interface{}
+			mml.Nop(_t)
+			_t = mml.Ref(_types, "of").(*mml.Function).Call([]interface{}{_c})
+			switch {
+			case mml.BinaryOp(12, _t, ""):
+
+				mml.Nop()
+				return mml.Ref(_goTypes, _t)
+			case _has.(*mml.Function).Call([]interface{}{mml.Ref(_c, "type"), _literalGoTypes}):
+
+				mml.Nop()
+				return mml.Ref(_literalGoTypes, mml.Ref(_c, "type"))
+			default:
+
+				mml.Nop()
+				return ""
+			}
+		}
+		_goTypeOf = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_goTypeOf(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_typed = func(_goType, _c interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _t interface{}
+			mml.Nop(_t)
+			_t = direct_goTypeOf(_c)
+			switch {
+			case mml.BinaryOp(11, _t, _goType):
+
+				mml.Nop()
+				return direct_do(_c)
+			case mml.BinaryOp(12, _t, ""):
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"interface{}(%s).(%s)", direct_do(_c), _goType})
+			default:
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"%s.(%s)", direct_do(_c), _goType})
+			}
+		}
+		_typed = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_typed(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_ifCondition = func(_c interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				c = mml.BinaryOp(11, mml.Ref(_types, "of").(*mml.Function).Call([]interface{}{_c}), "bool")
+				if c.(bool) {
+					return _formats.(*mml.Function).Call([]interface{}{"if %s", direct_do(_c)})
+				} else {
+					return _formats.(*mml.Function).Call([]interface{}{"c = %s; if c.(bool)", direct_do(_c)})
+				}
+			}()
+		}
+		_ifCondition = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_ifCondition(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_spread = func(_s interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _formats.(*mml.Function).Call([]interface{}{"%s...", direct_typed("*mml.List", mml.Ref(_s, "value"))})
+		}
+		_spread = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_spread(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_listGroups = func(_l interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _groupSpread interface{}
+			var _isSpread interface{}
+			var _selectSpread interface{}
+			mml.Nop(_groupSpread, _isSpread, _selectSpread)
+			_isSpread = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _c = a[0]
+					mml.Nop(_c)
+					return (mml.BinaryOp(15, _len.(*mml.Function).Call([]interface{}{_c}), 3).(bool) && mml.BinaryOp(11, mml.RefRange(_c, mml.BinaryOp(10, _len.(*mml.Function).Call([]interface{}{_c}), 3), nil), "...").(bool))
+				},
+				FixedArgs: 1,
+			}
+			_selectSpread = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _c = a[0]
+					mml.Nop(_c)
+					return func() interface{} {
+						c = _isSpread.(*mml.Function).Call([]interface{}{_c})
+						if c.(bool) {
+							return func() interface{} {
+								s := &mml.Struct{}
+								s.Set("spread", mml.RefRange(_c, nil, mml.BinaryOp(10, _len.(*mml.Function).Call([]interface{}{_c}), 3)))
+								return s
+							}()
+						} else {
+							return _c
+						}
+					}()
+				},
+				FixedArgs: 1,
+			}
+			_groupSpread = _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _item = a[0]
+					var _groups = a[1]
+					mml.Nop(_item, _groups)
+					var _i interface{}
+					var _isSpread interface{}
+					var _groupIsSpread bool
+					var _appendNewSimple interface{}
+					var _appendNewSpread interface{}
+					var _appendSimple interface{}
+					var _appendSpread interface{}
+					mml.Nop(_i, _isSpread, _groupIsSpread, _appendNewSimple, _appendNewSpread, _appendSimple, _appendSpread)
+					_i = mml.BinaryOp(10, _len.(*mml.Function).Call([]interface{}{_groups}), 1)
+					_isSpread = _has.(*mml.Function).Call([]interface{}{"spread", _item})
+					_groupIsSpread = (mml.BinaryOp(16, _i, 0).(bool) && _has.(*mml.Function).Call([]interface{}{"spread", mml.Ref(_groups, _i)}).(bool))
+					_appendNewSimple = &mml.Function{
+						F: func(a []interface{}) interface{} {
+							var c interface{}
+							mml.Nop(c)
+
+							mml.Nop()
+							return (&mml.List{}).Concat(_groups.(*mml.List)).Append(func() interface{} { s := &mml.Struct{}; s.Set("simple", (&mml.List{}).Append(_item)); ; return s }())
+						},
+						FixedArgs: 0,
+					}
+					_appendNewSpread = &mml.Function{
+						F: func(a []interface{}) interface{} {
+							var c interface{}
+							mml.Nop(c)
+
+							mml.Nop()
+							return (&mml.List{}).Concat(_groups.(*mml.List)).Append(func() interface{} {
+								s := &mml.Struct{}
+								s.Set("spread", (&mml.List{}).Append(mml.Ref(_item, "spread")))
+								return s
+							}())
+						},
+						FixedArgs: 0,
+					}
+					_appendSimple = &mml.Function{
+						F: func(a []interface{}) interface{} {
+							var c interface{}
+							mml.Nop(c)
+
+							mml.Nop()
+							return (&mml.List{}).Concat(mml.RefRange(_groups, nil, _i).(*mml.List)).Append(func() interface{} {
+								s := &mml.Struct{}
+								s.Set("simple", (&mml.List{}).Concat(mml.Ref(mml.Ref(_groups, _i), "simple").(*mml.List)).Append(_item))
+								return s
+							}())
+						},
+						FixedArgs: 0,
+					}
+					_appendSpread = &mml.Function{
+						F: func(a []interface{}) interface{} {
+							var c interface{}
+							mml.Nop(c)
+
+							mml.Nop()
+							return (&mml.List{}).Concat(mml.RefRange(_groups, nil, _i).(*mml.List)).Append(func() interface{} {
+								s := &mml.Struct{}
+								s.Set("spread", (&mml.List{}).Concat(mml.Ref(mml.Ref(_groups, _i), "spread").(*mml.List)).Append(mml.Ref(_item, "spread")))
+								return s
+							}())
+						},
+						FixedArgs: 0,
+					}
+					switch {
+					case ((mml.BinaryOp(13, _i, 0).(bool) || _groupIsSpread) && !_isSpread.(bool)):
+
+						mml.Nop()
+						return _appendNewSimple.(*mml.Function).Call([]interface{}{})
+					case ((mml.BinaryOp(13, _i, 0).(bool) || !_groupIsSpread) && _isSpread.(bool)):
+
+						mml.Nop()
+						return _appendNewSpread.(*mml.Function).Call([]interface{}{})
+					case (!_groupIsSpread && !_isSpread.(bool)):
+
+						mml.Nop()
+						return _appendSimple.(*mml.Function).Call([]interface{}{})
+					case (_groupIsSpread && _isSpread.(bool)):
+
+						mml.Nop()
+						return _appendSpread.(*mml.Function).Call([]interface{}{})
+					}
+					return nil
+				},
+				FixedArgs: 2,
+			}, (&mml.List{})})
+			return _groupSpread.(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_selectSpread}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_do}).(*mml.Function).Call([]interface{}{mml.Ref(_l, "values")})})})
+		}
+		_listGroups = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_listGroups(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_values = func(_l interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _appendGroup interface{}
+			var _groups interface{}
+			var _appendSimples interface{}
+			var _appendSpread interface{}
+			var _appendSpreads interface{}
+			mml.Nop(_appendGroup, _groups, _appendSimples, _appendSpread, _appendSpreads)
+			_appendSimples = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _code = a[0]
+					var _group = a[1]
+					mml.Nop(_code, _group)
+					return _formats.(*mml.Function).Call([]interface{}{"append(%s, %s)", _code, _join.(*mml.Function).Call([]interface{}{", ", _group})})
+				},
+				FixedArgs: 2,
+			}
+			_appendSpread = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _item = a[0]
+					var _code = a[1]
+					mml.Nop(_item, _code)
+					return _formats.(*mml.Function).Call([]interface{}{"append(%s, %s.Values()...)", _code, _item})
+				},
+				FixedArgs: 2,
+			}
+			_appendSpreads = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _code = a[0]
+					var _group = a[1]
+					mml.Nop(_code, _group)
+					return _fold.(*mml.Function).Call([]interface{}{_appendSpread, _code, _group})
+				},
+				FixedArgs: 2,
+			}
+			_appendGroup = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _group = a[0]
+					var _code = a[1]
+					mml.Nop(_group, _code)
+					return func() interface{} {
+						c = _has.(*mml.Function).Call([]interface{}{"spread", _group})
+						if c.(bool) {
+							return _appendSpreads.(*mml.Function).Call([]interface{}{_code, mml.Ref(_group, "spread")})
+						} else {
+							return _appendSimples.(*mml.Function).Call([]interface{}{_code, mml.Ref(_group, "simple")})
+						}
+					}()
+				},
+				FixedArgs: 2,
+			}
+			_groups = direct_listGroups(_l)
+			return func() interface{} {
+				if mml.BinaryOp(15, _len.(*mml.Function).Call([]interface{}{_groups}), 0).(bool) && _has.(*mml.Function).Call([]interface{}{"simple", mml.Ref(_groups, 0)}).(bool) {
+					return _fold.(*mml.Function).Call([]interface{}{_appendGroup, _formats.(*mml.Function).Call([]interface{}{"[]interface{}{%s}", _join.(*mml.Function).Call([]interface{}{", ", mml.Ref(mml.Ref(_groups, 0), "simple")})}), mml.RefRange(_groups, 1, nil)})
+				} else {
+					return _fold.(*mml.Function).Call([]interface{}{_appendGroup, "[]interface{}{}", _groups})
+				}
+			}()
+		}
+		_values = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_values(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_list = func(_l interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _appendGroup interface{}
+			var _appendSimples interface{}
+			var _appendSpread interface{}
+			var _appendSpreads interface{}
+			mml.Nop(_appendGroup, _appendSimples, _appendSpread, _appendSpreads)
+			_appendSimples = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _code = a[0]
+					var _group = a[1]
+					mml.Nop(_code, _group)
+					return _formats.(*mml.Function).Call([]interface{}{"%s.Append(%s)", _code, _join.(*mml.Function).Call([]interface{}{", ", _group})})
+				},
+				FixedArgs: 2,
+			}
+			_appendSpread = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _item = a[0]
+					var _code = a[1]
+					mml.Nop(_item, _code)
+					return _formats.(*mml.Function).Call([]interface{}{"%s.Concat(%s)", _code, _item})
+				},
+				FixedArgs: 2,
+			}
+			_appendSpreads = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _code = a[0]
+					var _group = a[1]
+					mml.Nop(_code, _group)
+					return _fold.(*mml.Function).Call([]interface{}{_appendSpread, _code, _group})
+				},
+				FixedArgs: 2,
+			}
+			_appendGroup = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _group = a[0]
+					var _code = a[1]
+					mml.Nop(_group, _code)
+					return func() interface{} {
+						c = _has.(*mml.Function).Call([]interface{}{"spread", _group})
+						if c.(bool) {
+							return _appendSpreads.(*mml.Function).Call([]interface{}{_code, mml.Ref(_group, "spread")})
+						} else {
+							return _appendSimples.(*mml.Function).Call([]interface{}{_code, mml.Ref(_group, "simple")})
+						}
+					}()
+				},
+				FixedArgs: 2,
+			}
+			return _fold.(*mml.Function).Call([]interface{}{_appendGroup, "(&mml.List{})"}).(*mml.Function).Call([]interface{}{direct_listGroups(_l)})
+		}
+		_list = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_list(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_expressionKey = func(_k interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return direct_do(mml.Ref(_k, "value"))
+		}
+		_expressionKey = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_expressionKey(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_struct = func(_s interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _entry interface{}
+			mml.Nop(_entry)
+			_entry = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _e = a[0]
+					mml.Nop(_e)
+					var _v interface{}
+					mml.Nop(_v)
+					_v = direct_do(mml.Ref(_e, "value"))
+					switch mml.Ref(_e, "type") {
+					case "spread":
+
+						mml.Nop()
+						return _formats.(*mml.Function).Call([]interface{}{"s.Merge(%s);\n", direct_typed("*mml.Struct", mml.Ref(_e, "value"))})
+					default:
+
+						mml.Nop()
+						switch mml.Ref(mml.Ref(_e, "key"), "type") {
+						case "string":
+
+							mml.Nop()
+							return _formats.(*mml.Function).Call([]interface{}{"s.Set(%s, %s);", direct_do(mml.Ref(_e, "key")), _v})
+						case "symbol":
+
+							mml.Nop()
+							return _formats.(*mml.Function).Call([]interface{}{"s.Set(\"%s\", %s);", mml.Ref(mml.Ref(_e, "key"), "name"), _v})
+						default:
+
+							mml.Nop()
+							return _formats.(*mml.Function).Call([]interface{}{"s.Set(%s, %s);", direct_typed("string", mml.Ref(_e, "key")), _v})
+						}
+					}
+				},
+				FixedArgs: 1,
+			}
+			return _formats.(*mml.Function).Call([]interface{}{"func() interface{} { s := &mml.Struct{}; %s; return s }()", _join.(*mml.Function).Call([]interface{}{""}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_entry}).(*mml.Function).Call([]interface{}{mml.Ref(_s, "entries")})})})
+		}
+		_struct = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_struct(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_paramList = func(_params, _collectParam interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _paramFormat string
+			var _collectParamFormat string
+			var _paramsString interface{}
+			var _collectParamString interface{}
+			mml.Nop(_paramFormat, _collectParamFormat, _paramsString, _collectParamString)
+			_paramFormat = "var _%s = a[%d]"
+			_collectParamFormat = "var _%s interface{}; _%s = mml.NewList(a[%d:])"
+			_paramsString = _map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _i = a[0]
+					mml.Nop(_i)
+					return _formats.(*mml.Function).Call([]interface{}{_paramFormat, mml.Ref(_params, _i), _i})
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{mml.Ref(_lists, "indexes").(*mml.Function).Call([]interface{}{_params})})
+			_collectParamString = _formats.(*mml.Function).Call([]interface{}{_collectParamFormat, _collectParam, _collectParam, _len.(*mml.Function).Call([]interface{}{_params})})
+			return func() interface{} {
+				c = mml.BinaryOp(11, _collectParam, "")
+				if c.(bool) {
+					return _join.(*mml.Function).Call([]interface{}{";\n", _paramsString})
+				} else {
+					return _join.(*mml.Function).Call([]interface{}{";\n", (&mml.List{}).Concat(_paramsString.(*mml.List)).Append(_collectParamString)})
+				}
+			}()
+		}
+		_paramList = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_paramList(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_breaks = func(_s interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			switch mml.Ref(_s, "type") {
+			case "break":
+
+				mml.Nop()
+				return true
+			case "statement-list":
+
+				mml.Nop()
+				return _some.(*mml.Function).Call([]interface{}{_breaks, mml.Ref(_s, "statements")})
+			case "cond":
+
+				mml.Nop()
+				return (!mml.Ref(_s, "ternary").(bool) && (direct_breaks(mml.Ref(_s, "consequent")).(bool) || (_has.(*mml.Function).Call([]interface{}{"alternative", _s}).(bool) && direct_breaks(mml.Ref(_s, "alternative")).(bool))))
+			default:
+
+				mml.Nop()
+				return false
+			}
+		}
+		_breaks = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_breaks(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_continues = func(_v interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return (_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "tail-call"); ; return s }(), _v}).(bool) || ((_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("tailCall", true); ; return s }(), _v}).(bool) && direct_continues(mml.Ref(_v, "consequent")).(bool)) && direct_continues(mml.Ref(_v, "alternative")).(bool)))
+		}
+		_continues = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_continues(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_terminates = func(_s interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			switch mml.Ref(_s, "type") {
+			case "ret":
+
+				mml.Nop()
+				return true
+			case "check-ret":
+
+				mml.Nop()
+				return direct_continues(mml.Ref(_s, "value"))
+			case "statement-list":
+				var _statements interface{}
+				mml.Nop(_statements)
+				_statements = _filter.(*mml.Function).Call([]interface{}{&mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _si = a[0]
+						mml.Nop(_si)
+						return mml.BinaryOp(12, mml.Ref(_si, "type"), "comment")
+					},
+					FixedArgs: 1,
+				}}).(*mml.Function).Call([]interface{}{mml.Ref(_s, "statements")})
+				return (mml.BinaryOp(15, _len.(*mml.Function).Call([]interface{}{_statements}), 0).(bool) && direct_terminates(mml.Ref(_statements, mml.BinaryOp(10, _len.(*mml.Function).Call([]interface{}{_statements}), 1))).(bool))
+			case "cond":
+
+				mml.Nop()
+				return (((!mml.Ref(_s, "ternary").(bool) && _has.(*mml.Function).Call([]interface{}{"alternative", _s}).(bool)) && direct_terminates(mml.Ref(_s, "consequent")).(bool)) && direct_terminates(mml.Ref(_s, "alternative")).(bool))
+			case "switch-statement":
+				var _bodies interface{}
+				mml.Nop(_bodies)
+				_bodies = (&mml.List{}).Concat(_map.(*mml.Function).Call([]interface{}{&mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _c = a[0]
+						mml.Nop(_c)
+						return mml.Ref(_c, "body")
+					},
+					FixedArgs: 1,
+				}}).(*mml.Function).Call([]interface{}{mml.Ref(_s, "cases")}).(*mml.List)).Append(mml.Ref(_s, "defaultStatements"))
+				return ((mml.BinaryOp(15, _len.(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_s, "defaultStatements"), "statements")}), 0).(bool) && _every.(*mml.Function).Call([]interface{}{_terminates, _bodies}).(bool)) && !_some.(*mml.Function).Call([]interface{}{_breaks, _bodies}).(bool))
+			case "loop":
+
+				mml.Nop()
+				return (!_has.(*mml.Function).Call([]interface{}{"expression", _s}).(bool) && !direct_breaks(mml.Ref(_s, "body")).(bool))
+			default:
+
+				mml.Nop()
+				return false
+			}
+		}
+		_terminates = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_terminates(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_functionBody = func(_body interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				if _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "statement-list"); ; return s }(), _body}).(bool) && !direct_terminates(_body).(bool) {
+					return _formats.(*mml.Function).Call([]interface{}{"%s;\nreturn nil", direct_do(_body)})
+				} else {
+					return direct_do(_body)
+				}
+			}()
+		}
+		_functionBody = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_functionBody(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_functionLiteral = func(_f interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _paramNames interface{}
+			var _statementListFormat string
+			var _expressionFormat string
+			var _tailCallFormat string
+			var _checkTailCallFormat string
+			var _format interface{}
+			mml.Nop(_paramNames, _statementListFormat, _expressionFormat, _tailCallFormat, _checkTailCallFormat, _format)
+			_paramNames = func() interface{} {
+				c = mml.BinaryOp(11, mml.Ref(_f, "collectParam"), "")
+				if c.(bool) {
+					return mml.Ref(_f, "params")
+				} else {
+					return (&mml.List{}).Concat(mml.Ref(_f, "params").(*mml.List)).Append(mml.Ref(_f, "collectParam"))
+				}
+			}()
+			_statementListFormat = "&mml.Function{\n\t\tF: func(a []interface{}) interface{} {\n\t\t\tvar c interface{}\n\t\t\tmml.Nop(c)\n\t\t\t%s;\n\t\t\tmml.Nop(%s);\n\t\t\t%s\n\t\t},\n\t\tFixedArgs: %d,\n\t}"
+			_expressionFormat = "&mml.Function{\n\t\tF: func(a []interface{}) interface{} {\n\t\t\tvar c interface{}\n\t\t\tmml.Nop(c)\n\t\t\t%s;\n\t\t\tmml.Nop(%s);\n\t\t\treturn %s\n\t\t},\n\t\tFixedArgs: %d,\n\t}"
+			_tailCallFormat = "&mml.Function{\n\t\tF: func(a []interface{}) interface{} {\n\t\t\tvar c interface{}\n\t\t\tmml.Nop(c)\n\t\ttailcall:\n\t\t\tfor {\n\t\t\t\t%s;\n\t\t\t\tmml.Nop(%s);\n\t\t\t\t%s\n\t\t\t}\n\t\t},\n\t\tFixedArgs: %d,\n\t}"
+			_checkTailCallFormat = "&mml.Function{\n\t\tF: func(a []interface{}) interface{} {\n\t\t\tvar c interface{}\n\t\t\tmml.Nop(c)\n\t\t\tvar checked bool\n\t\t\tmml.Nop(checked)\n\t\ttailcall:\n\t\t\tfor {\n\t\t\t\t%s;\n\t\t\t\tmml.Nop(%s);\n\t\t\t\t%s\n\t\t\t}\n\t\t},\n\t\tFixedArgs: %d,\n\t}"
+			_format = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+
+					mml.Nop()
+
+					mml.Nop()
+					switch {
+					case _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("checkTailCalls", true); ; return s }(), _f}):
+
+						mml.Nop()
+						return _checkTailCallFormat
+					case _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("tailCalls", true); ; return s }(), _f}):
+
+						mml.Nop()
+						return _tailCallFormat
+					case _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "statement-list"); ; return s }(), mml.Ref(_f, "body")}):
+
+						mml.Nop()
+						return _statementListFormat
+					default:
+
+						mml.Nop()
+						return _expressionFormat
+					}
+				},
+				FixedArgs: 0,
+			}
+			return _formats.(*mml.Function).Call([]interface{}{_format.(*mml.Function).Call([]interface{}{}), direct_paramList(mml.Ref(_f, "params"), mml.Ref(_f, "collectParam")), _join.(*mml.Function).Call([]interface{}{", ", _map.(*mml.Function).Call([]interface{}{mml.Ref(_strings, "formatOne").(*mml.Function).Call([]interface{}{"_%s"}), (&mml.List{}).Concat(_paramNames.(*mml.List))})}), direct_functionBody(mml.Ref(_f, "body")), _len.(*mml.Function).Call([]interface{}{mml.Ref(_f, "params")})})
+		}
+		_functionLiteral = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_functionLiteral(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_directFunction = func(_f interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _statementListFormat string
+			var _expressionFormat string
+			var _tailCallFormat string
+			var _checkTailCallFormat string
+			var _paramNames interface{}
+			var _signature interface{}
+			mml.Nop(_statementListFormat, _expressionFormat, _tailCallFormat, _checkTailCallFormat, _paramNames, _signature)
+			_statementListFormat = "func(%s) interface{} {\n\t\tvar c interface{}\n\t\tmml.Nop(c)\n\t\t%s\n\t}"
+			_expressionFormat = "func(%s) interface{} {\n\t\tvar c interface{}\n\t\tmml.Nop(c)\n\t\treturn %s\n\t}"
+			_tailCallFormat = "func(%s) interface{} {\n\t\tvar c interface{}\n\t\tmml.Nop(c)\n\t\ta := []interface{}{%s}\n\t\t_ = a\n\ttailcall:\n\t\tfor {\n\t\t\t%s;\n\t\t\tmml.Nop(%s);\n\t\t\t%s\n\t\t}\n\t}"
+			_checkTailCallFormat = "func(%s) interface{} {\n\t\tvar c interface{}\n\t\tmml.Nop(c)\n\t\tvar checked bool\n\t\tmml.Nop(checked)\n\t\ta := []interface{}{%s}\n\t\t_ = a\n\ttailcall:\n\t\tfor {\n\t\t\t%s;\n\t\t\tmml.Nop(%s);\n\t\t\t%s\n\t\t}\n\t}"
+			_paramNames = _map.(*mml.Function).Call([]interface{}{mml.Ref(_strings, "formatOne").(*mml.Function).Call([]interface{}{"_%s"}), mml.Ref(_f, "params")})
+			_signature = func() interface{} {
+				c = mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{mml.Ref(_f, "params")}), 0)
+				if c.(bool) {
+					return ""
+				} else {
+					return _formats.(*mml.Function).Call([]interface{}{"%s interface{}", _join.(*mml.Function).Call([]interface{}{", ", _paramNames})})
+				}
+			}()
+			switch {
+			case _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("tailCalls", true); ; return s }(), _f}):
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{func() string {
+					c = mml.Ref(_f, "checkTailCalls")
+					if c.(bool) {
+						return _checkTailCallFormat
+					} else {
+						return _tailCallFormat
+					}
+				}(), _signature, _join.(*mml.Function).Call([]interface{}{", ", _paramNames}), direct_paramList(mml.Ref(_f, "params"), ""), _join.(*mml.Function).Call([]interface{}{", ", _paramNames}), direct_functionBody(mml.Ref(_f, "body"))})
+			case _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "statement-list"); ; return s }(), mml.Ref(_f, "body")}):
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{_statementListFormat, _signature, direct_functionBody(mml.Ref(_f, "body"))})
+			default:
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{_expressionFormat, _signature, direct_do(mml.Ref(_f, "body"))})
+			}
+		}
+		_directFunction = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_directFunction(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_directWrapper = func(_d interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _formats.(*mml.Function).Call([]interface{}{"&mml.Function{\n\t\tF: func(a []interface{}) interface{} {\n\t\t\treturn direct_%s(%s)\n\t\t},\n\t\tFixedArgs: %d,\n\t}", mml.Ref(_d, "symbol"), _join.(*mml.Function).Call([]interface{}{", "}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _i = a[0]
+					mml.Nop(_i)
+					return _formats.(*mml.Function).Call([]interface{}{"a[%d]", _i})
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{mml.Ref(_lists, "indexes").(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_d, "expression"), "params")})})}), _len.(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_d, "expression"), "params")})})
+		}
+		_directWrapper = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_directWrapper(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_indexer = func(_i interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			switch {
+			case _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "range"); ; return s }(), mml.Ref(_i, "index")}):
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"mml.RefRange(%s, %s, %s)", direct_do(mml.Ref(_i, "expression")), func() interface{} {
+					c = _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("from", _any); ; return s }(), mml.Ref(_i, "index")})
+					if c.(bool) {
+						return direct_do(mml.Ref(mml.Ref(_i, "index"), "from"))
+					} else {
+						return "nil"
+					}
+				}(), func() interface{} {
+					c = _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("to", _any); ; return s }(), mml.Ref(_i, "index")})
+					if c.(bool) {
+						return direct_do(mml.Ref(mml.Ref(_i, "index"), "to"))
+					} else {
+						return "nil"
+					}
+				}()})
+			case _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "symbol-index"); ; return s }(), mml.Ref(_i, "index")}):
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"mml.Ref(%s, \"%s\")", direct_do(mml.Ref(_i, "expression")), mml.Ref(mml.Ref(mml.Ref(_i, "index"), "symbol"), "name")})
+			default:
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"mml.Ref(%s, %s)", direct_do(mml.Ref(_i, "expression")), direct_do(mml.Ref(_i, "index"))})
+			}
+		}
+		_indexer = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_indexer(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_application = func(_a interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			switch {
+			case (_is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("function", func() interface{} {
+					s := &mml.Struct{}
+					s.Set("type", "symbol")
+					s.Set("directArgs", _len.(*mml.Function).Call([]interface{}{mml.Ref(_a, "args")}))
+					return s
+				}())
+				return s
+			}(), _a}).(bool) && !_some.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "spread"); ; return s }()}), mml.Ref(_a, "args")}).(bool)):
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"direct_%s(%s)", mml.Ref(mml.Ref(_a, "function"), "name"), _join.(*mml.Function).Call([]interface{}{", "}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_do}).(*mml.Function).Call([]interface{}{mml.Ref(_a, "args")})})})
+			case (_is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("function", func() interface{} {
+					s := &mml.Struct{}
+					s.Set("type", "symbol")
+					s.Set("name", _or.(*mml.Function).Call([]interface{}{"spawn", "spawnIn", "await", "wait", "once"}))
+					return s
+				}())
+				return s
+			}(), _a}).(bool) || _is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("function", func() interface{} {
+					s := &mml.Struct{}
+					s.Set("type", "indexer")
+					s.Set("index", func() interface{} {
+						s := &mml.Struct{}
+						s.Set("type", "symbol-index")
+						s.Set("symbol", func() interface{} { s := &mml.Struct{}; s.Set("name", "lock"); ; return s }())
+						return s
+					}())
+					return s
+				}())
+				return s
+			}(), _a}).(bool)):
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"mml.At(%s, %s).Call(%s)", direct_position(_a), direct_typed("*mml.Function", mml.Ref(_a, "function")), direct_values(func() interface{} { s := &mml.Struct{}; s.Set("values", mml.Ref(_a, "args")); ; return s }())})
+			case _is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("function", func() interface{} { s := &mml.Struct{}; s.Set("type", "function"); ; return s }())
+				return s
+			}(), _a}):
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"(%s).Call(%s)", direct_do(mml.Ref(_a, "function")), direct_values(func() interface{} { s := &mml.Struct{}; s.Set("values", mml.Ref(_a, "args")); ; return s }())})
+			default:
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"%s.Call(%s)", direct_typed("*mml.Function", mml.Ref(_a, "function")), direct_values(func() interface{} { s := &mml.Struct{}; s.Set("values", mml.Ref(_a, "args")); ; return s }())})
+			}
+		}
+		_application = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_application(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_unary = func(_u interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			switch {
+			case mml.BinaryOp(11, mml.Ref(_u, "op"), mml.Ref(_code, "logicalNot")):
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"!%s", direct_typed("bool", mml.Ref(_u, "arg"))})
+			case mml.BinaryOp(12, mml.Ref(_types, "of").(*mml.Function).Call([]interface{}{_u}), ""):
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"%s(%s)", func() string {
+					c = mml.BinaryOp(11, mml.Ref(_u, "op"), mml.Ref(_code, "minus"))
+					if c.(bool) {
+						return "-"
+					} else {
+						return "+"
+					}
+				}(), direct_do(mml.Ref(_u, "arg"))})
+			default:
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"mml.UnaryOp(%d, %s)", mml.Ref(_u, "op"), direct_do(mml.Ref(_u, "arg"))})
+			}
+		}
+		_unary = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_unary(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_binary = func(_b interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			switch {
+			case _is.(*mml.Function).Call([]interface{}{_or.(*mml.Function).Call([]interface{}{mml.Ref(_code, "logicalAnd"), mml.Ref(_code, "logicalOr")}), mml.Ref(_b, "op")}):
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"(%s %s %s)", direct_typed("bool", mml.Ref(_b, "left")), mml.Ref(_goOperators, mml.Ref(_b, "op")), direct_typed("bool", mml.Ref(_b, "right"))})
+			case mml.BinaryOp(12, mml.Ref(_types, "of").(*mml.Function).Call([]interface{}{_b}), ""):
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"(%s %s %s)", direct_do(mml.Ref(_b, "left")), mml.Ref(_goOperators, mml.Ref(_b, "op")), direct_do(mml.Ref(_b, "right"))})
+			default:
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"mml.BinaryOp(%d, %s, %s)", mml.Ref(_b, "op"), direct_do(mml.Ref(_b, "left")), direct_do(mml.Ref(_b, "right"))})
+			}
+		}
+		_binary = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_binary(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_ternary = func(_c interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _t interface{}
+			mml.Nop(_t)
+			_t = mml.Ref(_types, "of").(*mml.Function).Call([]interface{}{_c})
+			return _formats.(*mml.Function).Call([]interface{}{"func () %s { %s { return %s } else { return %s } }()", func() interface{} {
+				c = mml.BinaryOp(11, _t, "")
+				if c.(bool) {
+					return "interface{}"
+				} else {
+					return mml.Ref(_goTypes, _t)
+				}
+			}(), direct_ifCondition(mml.Ref(_c, "condition")), direct_do(mml.Ref(_c, "consequent")), direct_do(mml.Ref(_c, "alternative"))})
+		}
+		_ternary = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_ternary(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_ifStatement = func(_c interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				c = _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("alternative", _any); ; return s }(), _c})
+				if c.(bool) {
+					return _formats.(*mml.Function).Call([]interface{}{"%s { %s } else { %s }", direct_ifCondition(mml.Ref(_c, "condition")), direct_do(mml.Ref(_c, "consequent")), direct_do(mml.Ref(_c, "alternative"))})
+				} else {
+					return _formats.(*mml.Function).Call([]interface{}{"%s { %s }", direct_ifCondition(mml.Ref(_c, "condition")), direct_do(mml.Ref(_c, "consequent"))})
+				}
+			}()
+		}
+		_ifStatement = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_ifStatement(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_cond = func(_c interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				c = mml.Ref(_c, "ternary")
+				if c.(bool) {
+					return direct_ternary(_c)
+				} else {
+					return direct_ifStatement(_c)
+				}
+			}()
+		}
+		_cond = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_cond(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_caseBlock = func(_c interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _formats.(*mml.Function).Call([]interface{}{"case %s:\n%s", direct_do(mml.Ref(_c, "expression")), direct_do(mml.Ref(_c, "body"))})
+		}
+		_caseBlock = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_caseBlock(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_switchStatement = func(_s interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _hasDefault interface{}
+			var _cases interface{}
+			var _def interface{}
+			var _defaultCode interface{}
+			mml.Nop(_hasDefault, _cases, _def, _defaultCode)
+			_hasDefault = mml.BinaryOp(15, _len.(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_s, "defaultStatements"), "statements")}), 0)
+			_cases = _map.(*mml.Function).Call([]interface{}{_do}).(*mml.Function).Call([]interface{}{mml.Ref(_s, "cases")})
+			_def = func() interface{} {
+				c = _hasDefault
+				if c.(bool) {
+					return direct_do(mml.Ref(_s, "defaultStatements"))
+				} else {
+					return ""
+				}
+			}()
+			_defaultCode = func() interface{} {
+				c = _hasDefault
+				if c.(bool) {
+					return _formats.(*mml.Function).Call([]interface{}{"default:\n%s", _def})
+				} else {
+					return ""
+				}
+			}()
+			return _formats.(*mml.Function).Call([]interface{}{"switch %s {\n%s\n}", func() interface{} {
+				c = _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("expression", _any); ; return s }(), _s})
+				if c.(bool) {
+					return direct_do(mml.Ref(_s, "expression"))
+				} else {
+					return ""
+				}
+			}(), _join.(*mml.Function).Call([]interface{}{"\n"}).(*mml.Function).Call([]interface{}{func() interface{} {
+				c = _hasDefault
+				if c.(bool) {
+					return (&mml.List{}).Concat(_cases.(*mml.List)).Append(_defaultCode)
+				} else {
+					return _cases
+				}
+			}()})})
+		}
+		_switchStatement = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_switchStatement(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_position = func(_code interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _formats.(*mml.Function).Call([]interface{}{"%q", _formats.(*mml.Function).Call([]interface{}{"%s:%d:%d", mml.Ref(mml.Ref(_code, "ast"), "file"), mml.Ref(mml.Ref(_code, "ast"), "line"), mml.Ref(mml.Ref(_code, "ast"), "column")})})
+		}
+		_position = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_position(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_sendStatement = func(_s interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _formats.(*mml.Function).Call([]interface{}{"mml.Send(%s, %s, %s)", direct_do(mml.Ref(_s, "channel")), direct_do(mml.Ref(_s, "value")), direct_position(_s)})
+		}
+		_sendStatement = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_sendStatement(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_receiveExpression = func(_r interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _formats.(*mml.Function).Call([]interface{}{"mml.Receive(%s, %s)", direct_do(mml.Ref(_r, "channel")), direct_position(_r)})
+		}
+		_receiveExpression = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_receiveExpression(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_goStatement = func(_g interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _formats.(*mml.Function).Call([]interface{}{"mml.Go(%s, %s, %s)", direct_position(_g), direct_do(mml.Ref(mml.Ref(_g, "application"), "function")), direct_values(func() interface{} {
+				s := &mml.Struct{}
+				s.Set("values", mml.Ref(mml.Ref(_g, "application"), "args"))
+				return s
+			}())})
+		}
+		_goStatement = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_goStatement(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_deferStatement = func(_d interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _formats.(*mml.Function).Call([]interface{}{func() string {
+				c = _is.(*mml.Function).Call([]interface{}{func() interface{} {
+					s := &mml.Struct{}
+					s.Set("application", func() interface{} {
+						s := &mml.Struct{}
+						s.Set("function", func() interface{} { s := &mml.Struct{}; s.Set("type", "function"); ; return s }())
+						return s
+					}())
+					return s
+				}(), _d})
+				if c.(bool) {
+					return "c = (%s); defer c.Call(%s)"
+				} else {
+					return "defer %s.Call(%s)"
+				}
+			}(), func() interface{} {
+				c = _is.(*mml.Function).Call([]interface{}{func() interface{} {
+					s := &mml.Struct{}
+					s.Set("application", func() interface{} {
+						s := &mml.Struct{}
+						s.Set("function", func() interface{} { s := &mml.Struct{}; s.Set("type", "function"); ; return s }())
+						return s
+					}())
+					return s
+				}(), _d})
+				if c.(bool) {
+					return direct_do(mml.Ref(mml.Ref(_d, "application"), "function"))
+				} else {
+					return direct_typed("*mml.Function", mml.Ref(mml.Ref(_d, "application"), "function"))
+				}
+			}(), direct_values(func() interface{} {
+				s := &mml.Struct{}
+				s.Set("values", mml.Ref(mml.Ref(_d, "application"), "args"))
+				return s
+			}())})
+		}
+		_deferStatement = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_deferStatement(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_selectStatement = func(_s interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _selectCase interface{}
+			var _caseBody interface{}
+			var _cases interface{}
+			var _defaultCase interface{}
+			mml.Nop(_selectCase, _caseBody, _cases, _defaultCase)
+			_selectCase = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _c = a[0]
+					mml.Nop(_c)
+
+					mml.Nop()
+					switch mml.Ref(mml.Ref(_c, "expression"), "type") {
+					case "send-statement":
+
+						mml.Nop()
+						return _formats.(*mml.Function).Call([]interface{}{"{Send: true, Channel: %s, Value: %s}", direct_do(mml.Ref(mml.Ref(_c, "expression"), "channel")), direct_do(mml.Ref(mml.Ref(_c, "expression"), "value"))})
+					case "receive-expression":
+
+						mml.Nop()
+						return _formats.(*mml.Function).Call([]interface{}{"{Channel: %s}", direct_do(mml.Ref(mml.Ref(_c, "expression"), "channel"))})
+					default:
+
+						mml.Nop()
+						return _formats.(*mml.Function).Call([]interface{}{"{Channel: %s}", direct_do(mml.Ref(mml.Ref(mml.Ref(_c, "expression"), "expression"), "channel"))})
+					}
+				},
+				FixedArgs: 1,
+			}
+			_caseBody = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _i = a[0]
+					mml.Nop(_i)
+					var _c interface{}
+					var _capture interface{}
+					mml.Nop(_c, _capture)
+					_c = mml.Ref(mml.Ref(_s, "cases"), _i)
+					_capture = func() interface{} {
+						c = _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "definition"); ; return s }(), mml.Ref(_c, "expression")})
+						if c.(bool) {
+							return _formats.(*mml.Function).Call([]interface{}{"var _%s = sv; mml.Nop(_%s);\n", mml.Ref(mml.Ref(_c, "expression"), "symbol"), mml.Ref(mml.Ref(_c, "expression"), "symbol")})
+						} else {
+							return ""
+						}
+					}()
+					return _formats.(*mml.Function).Call([]interface{}{"case %d:\n%s%s", _i, _capture, direct_do(mml.Ref(_c, "body"))})
+				},
+				FixedArgs: 1,
+			}
+			_cases = _map.(*mml.Function).Call([]interface{}{_caseBody}).(*mml.Function).Call([]interface{}{mml.Ref(_lists, "indexes").(*mml.Function).Call([]interface{}{mml.Ref(_s, "cases")})})
+			_defaultCase = mml.Ref(_strings, "formatOne").(*mml.Function).Call([]interface{}{"default:\n%s"}).(*mml.Function).Call([]interface{}{direct_do(mml.Ref(_s, "defaultStatements"))})
+			return _formats.(*mml.Function).Call([]interface{}{"{\nsc, sv := mml.Select([]mml.SelectCase{%s}, %v, %s);\nmml.Nop(sv);\nswitch sc {\n%s\n}\n}", _join.(*mml.Function).Call([]interface{}{", "}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_selectCase}).(*mml.Function).Call([]interface{}{mml.Ref(_s, "cases")})}), mml.Ref(_s, "hasDefault"), direct_position(_s), _join.(*mml.Function).Call([]interface{}{"\n"}).(*mml.Function).Call([]interface{}{func() interface{} {
+				c = mml.Ref(_s, "hasDefault")
+				if c.(bool) {
+					return (&mml.List{}).Concat(_cases.(*mml.List)).Append(_defaultCase)
+				} else {
+					return _cases
+				}
+			}()})})
+		}
+		_selectStatement = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_selectStatement(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_rangeOver = func(_r interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _infiniteCounter interface{}
+			var _withRangeExpression interface{}
+			var _listStyleRange interface{}
+			mml.Nop(_infiniteCounter, _withRangeExpression, _listStyleRange)
+			_infiniteCounter = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+
+					mml.Nop()
+					return _formats.(*mml.Function).Call([]interface{}{"_%s := 0; true; _%s++", mml.Ref(_r, "symbol"), mml.Ref(_r, "symbol")})
+				},
+				FixedArgs: 0,
+			}
+			_withRangeExpression = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+
+					mml.Nop()
+					return _formats.(*mml.Function).Call([]interface{}{"_%s := %s; %s; _%s++", mml.Ref(_r, "symbol"), func() interface{} {
+						c = _has.(*mml.Function).Call([]interface{}{"from", mml.Ref(_r, "expression")})
+						if c.(bool) {
+							return direct_typed("int", mml.Ref(mml.Ref(_r, "expression"), "from"))
+						} else {
+							return "0"
+						}
+					}(), func() interface{} {
+						c = _has.(*mml.Function).Call([]interface{}{"to", mml.Ref(_r, "expression")})
+						if c.(bool) {
+							return _formats.(*mml.Function).Call([]interface{}{"_%s < %s", mml.Ref(_r, "symbol"), direct_typed("int", mml.Ref(mml.Ref(_r, "expression"), "to"))})
+						} else {
+							return "true"
+						}
+					}(), mml.Ref(_r, "symbol")})
+				},
+				FixedArgs: 0,
+			}
+			_listStyleRange = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+
+					mml.Nop()
+					return _formats.(*mml.Function).Call([]interface{}{"_, _%s := range %s.Values()", mml.Ref(_r, "symbol"), direct_typed("*mml.List", mml.Ref(_r, "expression"))})
+				},
+				FixedArgs: 0,
+			}
+			switch {
+			case !_has.(*mml.Function).Call([]interface{}{"expression", _r}).(bool):
+
+				mml.Nop()
+				return _infiniteCounter.(*mml.Function).Call([]interface{}{})
+			case _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "range"); ; return s }(), mml.Ref(_r, "expression")}):
+
+				mml.Nop()
+				return _withRangeExpression.(*mml.Function).Call([]interface{}{})
+			default:
+
+				mml.Nop()
+				return _listStyleRange.(*mml.Function).Call([]interface{}{})
+			}
+		}
+		_rangeOver = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_rangeOver(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_breakStatement = func(__ interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return "break"
+		}
+		_breakStatement = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_breakStatement(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_continueStatement = func(__ interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return "continue"
+		}
+		_continueStatement = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_continueStatement(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_loop = func(_l interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _expression interface{}
+			mml.Nop(_expression)
+			_expression = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _e = a[0]
+					mml.Nop(_e)
+					return func() interface{} {
+						c = _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "range-over"); ; return s }(), _e})
+						if c.(bool) {
+							return direct_do(_e)
+						} else {
+							return direct_typed("bool", _e)
+						}
+					}()
+				},
+				FixedArgs: 1,
+			}
+			return _formats.(*mml.Function).Call([]interface{}{"for %s {\n%s\n}", func() interface{} {
+				c = _has.(*mml.Function).Call([]interface{}{"expression", _l})
+				if c.(bool) {
+					return _expression.(*mml.Function).Call([]interface{}{mml.Ref(_l, "expression")})
+				} else {
+					return ""
+				}
+			}(), direct_do(mml.Ref(_l, "body"))})
+		}
+		_loop = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_loop(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_definition = func(_d interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _value interface{}
+			mml.Nop(_value)
+			_value = func() interface{} {
+				c = _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("directArgs", _any); ; return s }(), _d})
+				if c.(bool) {
+					return _formats.(*mml.Function).Call([]interface{}{"direct_%s = %s;\n_%s = %s", mml.Ref(_d, "symbol"), direct_directFunction(mml.Ref(_d, "expression")), mml.Ref(_d, "symbol"), direct_directWrapper(_d)})
+				} else {
+					return _formats.(*mml.Function).Call([]interface{}{"_%s = %s", mml.Ref(_d, "symbol"), direct_do(mml.Ref(_d, "expression"))})
+				}
+			}()
+			return func() interface{} {
+				c = mml.Ref(_d, "exported")
+				if c.(bool) {
+					return _formats.(*mml.Function).Call([]interface{}{"%s; exports[\"%s\"] = _%s", _value, mml.Ref(_d, "symbol"), mml.Ref(_d, "symbol")})
+				} else {
+					return _value
+				}
+			}()
+		}
+		_definition = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_definition(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_definitionGroup = func(_g interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _join.(*mml.Function).Call([]interface{}{";\n"}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_do}).(*mml.Function).Call([]interface{}{mml.Ref(_g, "definitions")})})
+		}
+		_definitionGroup = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_definitionGroup(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_assign = func(_a interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				c = mml.BinaryOp(11, mml.Ref(mml.Ref(_a, "capture"), "type"), "symbol")
+				if c.(bool) {
+					return _formats.(*mml.Function).Call([]interface{}{"%s = %s", direct_do(mml.Ref(_a, "capture")), direct_do(mml.Ref(_a, "value"))})
+				} else {
+					return _formats.(*mml.Function).Call([]interface{}{"mml.SetRef(%s, %s, %s)", direct_do(mml.Ref(mml.Ref(_a, "capture"), "expression")), func() interface{} {
+						c = _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "symbol-index"); ; return s }(), mml.Ref(mml.Ref(_a, "capture"), "index")})
+						if c.(bool) {
+							return _formats.(*mml.Function).Call([]interface{}{"\"%s\"", mml.Ref(mml.Ref(mml.Ref(mml.Ref(_a, "capture"), "index"), "symbol"), "name")})
+						} else {
+							return direct_do(mml.Ref(mml.Ref(_a, "capture"), "index"))
+						}
+					}(), direct_do(mml.Ref(_a, "value"))})
+				}
+			}()
+		}
+		_assign = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_assign(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_returnValue = func(_checked, _v interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			switch {
+			case _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "tail-call"); ; return s }(), _v}):
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"a = %s; continue tailcall", direct_values(func() interface{} { s := &mml.Struct{}; s.Set("values", mml.Ref(_v, "args")); ; return s }())})
+			case _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("tailCall", true); ; return s }(), _v}):
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"%s { %s } else { %s }", direct_ifCondition(mml.Ref(_v, "condition")), direct_returnValue(_checked, mml.Ref(_v, "consequent")), direct_returnValue(_checked, mml.Ref(_v, "alternative"))})
+			case _checked:
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"if v := %s; !checked || mml.IsError.F([]interface{}{v}).(bool) { return v }; return nil", direct_do(_v)})
+			default:
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"return %s", direct_do(_v)})
+			}
+		}
+		_returnValue = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_returnValue(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_ret = func(_r interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				c = _has.(*mml.Function).Call([]interface{}{"value", _r})
+				if c.(bool) {
+					return direct_returnValue(_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("checked", true); ; return s }(), _r}), mml.Ref(_r, "value"))
+				} else {
+					return "return nil"
+				}
+			}()
+		}
+		_ret = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_ret(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_checkValue = func(_v interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			switch {
+			case _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "tail-call"); ; return s }(), _v}):
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"a = %s; checked = true; continue tailcall", direct_values(func() interface{} { s := &mml.Struct{}; s.Set("values", mml.Ref(_v, "args")); ; return s }())})
+			case _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("tailCall", true); ; return s }(), _v}):
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"%s { %s } else { %s }", direct_ifCondition(mml.Ref(_v, "condition")), direct_checkValue(mml.Ref(_v, "consequent")), direct_checkValue(mml.Ref(_v, "alternative"))})
+			default:
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"if v := %s; mml.IsError.F([]interface{}{v}).(bool) { return v }", direct_do(_v)})
+			}
+		}
+		_checkValue = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_checkValue(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_checkRet = func(_r interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return direct_checkValue(mml.Ref(_r, "value"))
+		}
+		_checkRet = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_checkRet(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_useStatement = func(_u interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			switch {
+			case _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("capture", "."); ; return s }(), _u}):
+				var _statement interface{}
+				var _names interface{}
+				var _assigns interface{}
+				mml.Nop(_statement, _names, _assigns)
+				_statement = _formats.(*mml.Function).Call([]interface{}{"var __%s = use(\"%s\");", mml.Ref(_code, "getModuleName").(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_u, "path"), "value")}), mml.Ref(mml.Ref(_u, "path"), "value")})
+				_names = _map.(*mml.Function).Call([]interface{}{mml.Ref(_structs, "get").(*mml.Function).Call([]interface{}{"symbol"})}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("exported", true); ; return s }()})}).(*mml.Function).Call([]interface{}{mml.Ref(_code, "getDefinitions").(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_u, "module"), "body")})})})
+				c = mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_names}), 0)
+				if c.(bool) {
+					mml.Nop()
+					return _formats.(*mml.Function).Call([]interface{}{"use(\"%s\")", mml.Ref(mml.Ref(_u, "path"), "value")})
+				}
+				_assigns = _join.(*mml.Function).Call([]interface{}{";\n"}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{&mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _name = a[0]
+						mml.Nop(_name)
+						return _formats.(*mml.Function).Call([]interface{}{"_%s = __%s.Get(\"%s\")", _name, mml.Ref(_code, "getModuleName").(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_u, "path"), "value")}), _name})
+					},
+					FixedArgs: 1,
+				}, _names})})
+				return _joins.(*mml.Function).Call([]interface{}{";", _statement, _assigns})
+			case _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("capture", _any); ; return s }(), _u}):
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"_%s = use(\"%s\")", mml.Ref(_u, "capture"), mml.Ref(mml.Ref(_u, "path"), "value")})
+			default:
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"_%s = use(\"%s\")", mml.Ref(_code, "getModuleName").(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_u, "path"), "value")}), mml.Ref(mml.Ref(_u, "path"), "value")})
+			}
+		}
+		_useStatement = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_useStatement(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_useList = func(_u interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _join.(*mml.Function).Call([]interface{}{";\n"}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_do}).(*mml.Function).Call([]interface{}{mml.Ref(_u, "uses")})})
+		}
+		_useList = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_useList(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_module = func(_m interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _folded interface{}
+			mml.Nop(_folded)
+			_folded = _map.(*mml.Function).Call([]interface{}{mml.Ref(_structs, "get").(*mml.Function).Call([]interface{}{"symbol"})}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("folded", true); ; return s }()})}).(*mml.Function).Call([]interface{}{mml.Ref(_code, "getDefinitions").(*mml.Function).Call([]interface{}{mml.Ref(_m, "body")})})})
+			return _joins.(*mml.Function).Call([]interface{}{"\n", _formats.(*mml.Function).Call([]interface{}{"modulePath = \"%s\"", mml.Ref(_m, "path")}), func() interface{} {
+				c = mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_folded}), 0)
+				if c.(bool) {
+					return ""
+				} else {
+					return _formats.(*mml.Function).Call([]interface{}{"// evaluated at compile time: %s", _join.(*mml.Function).Call([]interface{}{", ", _folded})})
+				}
+			}(), mml.Ref(_snippets, "moduleHead"), direct_do(mml.Ref(_m, "body")), mml.Ref(_snippets, "moduleFooter")})
+		}
+		_module = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_module(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_statementList = func(_l interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _nativeTypes interface{}
+			var _directDefs interface{}
+			var _scopeDefs interface{}
+			var _scope interface{}
+			var _scopeNames interface{}
+			var _statements interface{}
+			mml.Nop(_nativeTypes, _directDefs, _scopeDefs, _scope, _scopeNames, _statements)
+			_scope = mml.Ref(_code, "getScope").(*mml.Function).Call([]interface{}{_l})
+			_scopeNames = _join.(*mml.Function).Call([]interface{}{", ", _map.(*mml.Function).Call([]interface{}{_bind.(*mml.Function).Call([]interface{}{mml.Ref(_strings, "formats"), "_%s"}), _scope})})
+			_statements = _join.(*mml.Function).Call([]interface{}{";\n"}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_do, mml.Ref(_l, "statements")})})
+			_nativeTypes = _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _d = a[0]
+					var _t = a[1]
+					mml.Nop(_d, _t)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_t.(*mml.Struct))
+						s.Set(mml.Ref(_d, "symbol").(string), mml.Ref(_goTypes, mml.Ref(_d, "valueType")))
+						return s
+					}()
+				},
+				FixedArgs: 2,
+			}, func() interface{} { s := &mml.Struct{}; ; return s }()}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("valueType", _any); ; return s }()})}).(*mml.Function).Call([]interface{}{mml.Ref(_code, "getDefinitions").(*mml.Function).Call([]interface{}{_l})})})
+			_directDefs = _map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _d = a[0]
+					mml.Nop(_d)
+					return _formats.(*mml.Function).Call([]interface{}{"var direct_%s func(%s) interface{}; mml.Nop(direct_%s)", mml.Ref(_d, "symbol"), _join.(*mml.Function).Call([]interface{}{", "}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{&mml.Function{
+						F: func(a []interface{}) interface{} {
+							var c interface{}
+							mml.Nop(c)
+							var __ = a[0]
+							mml.Nop(__)
+							return "interface{}"
+						},
+						FixedArgs: 1,
+					}}).(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_d, "expression"), "params")})}), mml.Ref(_d, "symbol")})
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("directArgs", _any); ; return s }()})}).(*mml.Function).Call([]interface{}{mml.Ref(_code, "getDefinitions").(*mml.Function).Call([]interface{}{_l})})})
+			_scopeDefs = _join.(*mml.Function).Call([]interface{}{";\n"}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _s = a[0]
+					mml.Nop(_s)
+					return _formats.(*mml.Function).Call([]interface{}{"var _%s %s", _s, func() interface{} {
+						c = _has.(*mml.Function).Call([]interface{}{_s, _nativeTypes})
+						if c.(bool) {
+							return mml.Ref(_nativeTypes, _s)
+						} else {
+							return "interface{}"
+						}
+					}()})
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_scope})})
+			return _formats.(*mml.Function).Call([]interface{}{"%s;\nmml.Nop(%s);\n%s", _joins.(*mml.Function).Call(append([]interface{}{";\n", _scopeDefs}, _directDefs.(*mml.List).Values()...)), _scopeNames, _statements})
+		}
+		_statementList = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_statementList(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_do = func(_code interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			switch {
+			case mml.BinaryOp(11, mml.Ref(_code, "type"), "int"):
+
+				mml.Nop()
+				return _intLiteral.(*mml.Function).Call([]interface{}{_code})
+			case mml.BinaryOp(11, mml.Ref(_code, "type"), "float"):
+
+				mml.Nop()
+				return direct_floatLiteral(_code)
+			case mml.BinaryOp(11, mml.Ref(_code, "type"), "string"):
+
+				mml.Nop()
+				return direct_stringLiteral(_code)
+			case mml.BinaryOp(11, mml.Ref(_code, "type"), "bool"):
+
+				mml.Nop()
+				return _boolLiteral.(*mml.Function).Call([]interface{}{_code})
+			}
+			switch mml.Ref(_code, "type") {
+			case "comment":
+
+				mml.Nop()
+				return ""
+			case "symbol":
+
+				mml.Nop()
+				return direct_symbol(_code)
+			case "list":
+
+				mml.Nop()
+				return direct_list(_code)
+			case "expression-key":
+
+				mml.Nop()
+				return direct_expressionKey(_code)
+			case "struct":
+
+				mml.Nop()
+				return direct_struct(_code)
+			case "function":
+
+				mml.Nop()
+				return direct_functionLiteral(_code)
+			case "indexer":
+
+				mml.Nop()
+				return direct_indexer(_code)
+			case "spread":
+
+				mml.Nop()
+				return direct_spread(_code)
+			case "application":
+
+				mml.Nop()
+				return direct_application(_code)
+			case "unary":
+
+				mml.Nop()
+				return direct_unary(_code)
+			case "binary":
+
+				mml.Nop()
+				return direct_binary(_code)
+			case "cond":
+
+				mml.Nop()
+				return direct_cond(_code)
+			case "switch-case":
+
+				mml.Nop()
+				return direct_caseBlock(_code)
+			case "switch-statement":
+
+				mml.Nop()
+				return direct_switchStatement(_code)
+			case "send-statement":
+
+				mml.Nop()
+				return direct_sendStatement(_code)
+			case "receive-expression":
+
+				mml.Nop()
+				return direct_receiveExpression(_code)
+			case "go-statement":
+
+				mml.Nop()
+				return direct_goStatement(_code)
+			case "defer-statement":
+
+				mml.Nop()
+				return direct_deferStatement(_code)
+			case "select-statement":
+
+				mml.Nop()
+				return direct_selectStatement(_code)
+			case "range-over":
+
+				mml.Nop()
+				return direct_rangeOver(_code)
+			case "break":
+
+				mml.Nop()
+				return direct_breakStatement(_code)
+			case "continue":
+
+				mml.Nop()
+				return direct_continueStatement(_code)
+			case "loop":
+
+				mml.Nop()
+				return direct_loop(_code)
+			case "definition":
+
+				mml.Nop()
+				return direct_definition(_code)
+			case "definition-group":
+
+				mml.Nop()
+				return direct_definitionGroup(_code)
+			case "assign":
+
+				mml.Nop()
+				return direct_assign(_code)
+			case "ret":
+
+				mml.Nop()
+				return direct_ret(_code)
+			case "check-ret":
+
+				mml.Nop()
+				return direct_checkRet(_code)
+			case "use":
+
+				mml.Nop()
+				return direct_useStatement(_code)
+			case "use-list":
+
+				mml.Nop()
+				return direct_useList(_code)
+			case "module":
+
+				mml.Nop()
+				return direct_module(_code)
+			default:
+
+				mml.Nop()
+				return direct_statementList(_code)
+			}
+		}
+		_do = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_do(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_allModules = func(_module interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _visited interface{}
+			var _modules interface{}
+			var _visit interface{}
+			mml.Nop(_visited, _modules, _visit)
+			_visited = func() interface{} { s := &mml.Struct{}; ; return s }()
+			_modules = (&mml.List{})
+			_visit = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _m = a[0]
+					mml.Nop(_m)
+
+					mml.Nop()
+					c = _has.(*mml.Function).Call([]interface{}{mml.Ref(_m, "path"), _visited})
+					if c.(bool) {
+						mml.Nop()
+						return nil
+					}
+					mml.SetRef(_visited, mml.Ref(_m, "path"), true)
+					_modules = (&mml.List{}).Concat(_modules.(*mml.List)).Append(_m)
+					for _, _u := range mml.Ref(_codetree, "filter").(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "use"); ; return s }()}), _m}).(*mml.List).Values() {
+
+						mml.Nop()
+						_visit.(*mml.Function).Call([]interface{}{mml.Ref(_u, "module")})
+					}
+					return nil
+				},
+				FixedArgs: 1,
+			}
+			_visit.(*mml.Function).Call([]interface{}{_module})
+			return _modules
+		}
+		_allModules = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_allModules(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["allModules"] = _allModules
+		direct_builtins = func(_modules interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _sort.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _left = a[0]
+					var _right = a[1]
+					mml.Nop(_left, _right)
+					return mml.BinaryOp(13, _left, _right)
+				},
+				FixedArgs: 2,
+			}}).(*mml.Function).Call([]interface{}{mml.Ref(_deadcode, "builtins").(*mml.Function).Call([]interface{}{_modules})})
+		}
+		_builtins = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_builtins(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_builtinDefinition = func(_name interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _formats.(*mml.Function).Call([]interface{}{"var _%s interface{} = mml.%s", _name, mml.Ref(mml.Ref(_code, "builtin"), _name)})
+		}
+		_builtinDefinition = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_builtinDefinition(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_moduleCode = func(_m interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return direct_do(mml.Ref(_types, "do").(*mml.Function).Call([]interface{}{mml.Ref(_tailcalls, "do").(*mml.Function).Call([]interface{}{_m})}))
+		}
+		_moduleCode = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_moduleCode(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_interopImports = func(_modules interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _join.(*mml.Function).Call([]interface{}{""}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _p = a[0]
+					mml.Nop(_p)
+					return _formats.(*mml.Function).Call([]interface{}{"import _ \"%s\"\n", _p})
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{mml.Ref(_signatures, "packages").(*mml.Function).Call([]interface{}{_modules})})})
+		}
+		_interopImports = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_interopImports(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_modulesToGo = func(_modules interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _moduleCodes interface{}
+			mml.Nop(_moduleCodes)
+			_moduleCodes = mml.Ref(_tasks, "map").(*mml.Function).Call([]interface{}{_moduleCode, _modules})
+			if v := _moduleCodes; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			return _joins.(*mml.Function).Call([]interface{}{"", direct_interopImports(_modules), _join.(*mml.Function).Call([]interface{}{";\n"}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_builtinDefinition}).(*mml.Function).Call([]interface{}{direct_builtins(_modules)})}), mml.Ref(_snippets, "initHead"), _join.(*mml.Function).Call([]interface{}{"\n", _moduleCodes}), mml.Ref(_snippets, "initFooter")})
+		}
+		_modulesToGo = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_modulesToGo(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_toGo = func(_module interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _modulesCode interface{}
+			mml.Nop(_modulesCode)
+			_modulesCode = direct_modulesToGo(mml.Ref(_deadcode, "do").(*mml.Function).Call([]interface{}{mml.Ref(_constants, "do").(*mml.Function).Call([]interface{}{direct_allModules(_module)})}))
+			if v := _modulesCode; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			return _joins.(*mml.Function).Call([]interface{}{"", mml.Ref(_snippets, "head"), _modulesCode, mml.Ref(_snippets, "mainHead"), mml.Ref(_module, "path"), mml.Ref(_snippets, "mainFooter")})
+		}
+		_toGo = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_toGo(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["toGo"] = _toGo
+		_goKeywords = (&mml.List{}).Append("break", "case", "chan", "const", "continue", "default", "defer", "else", "fallthrough", "for", "func", "go", "goto", "if", "import", "interface", "map", "package", "range", "return", "select", "struct", "switch", "type", "var")
+		_zeroValues = func() interface{} {
+			s := &mml.Struct{}
+			s.Set("int", "0")
+			s.Set("float", "0")
+			s.Set("string", "\"\"")
+			s.Set("bool", "false")
+			return s
+		}()
+		_lowerCase = (&mml.List{}).Append("a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l", "m", "n", "o", "p", "q", "r", "s", "t", "u", "v", "w", "x", "y", "z")
+		_upperCase = (&mml.List{}).Append("A", "B", "C", "D", "E", "F", "G", "H", "I", "J", "K", "L", "M", "N", "O", "P", "Q", "R", "S", "T", "U", "V", "W", "X", "Y", "Z")
+		direct_exportedName = func(_name interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _i interface{}
+			mml.Nop(_i)
+			_i = _filter.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _i = a[0]
+					mml.Nop(_i)
+					return mml.BinaryOp(11, mml.Ref(_lowerCase, _i), mml.Ref(_name, 0))
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{mml.Ref(_lists, "indexes").(*mml.Function).Call([]interface{}{_lowerCase})})
+			switch {
+			case mml.BinaryOp(15, _len.(*mml.Function).Call([]interface{}{_i}), 0):
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"%s%s", mml.Ref(_upperCase, mml.Ref(_i, 0)), mml.RefRange(_name, 1, nil)})
+			case _contains.(*mml.Function).Call([]interface{}{mml.Ref(_name, 0), _upperCase}):
+
+				mml.Nop()
+				return _name
+			default:
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"X%s", _name})
+			}
+		}
+		_exportedName = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_exportedName(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_goParam = func(_name interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				c = _contains.(*mml.Function).Call([]interface{}{_name, (&mml.List{}).Concat(_goKeywords.(*mml.List)).Append("mml")})
+				if c.(bool) {
+					return _formats.(*mml.Function).Call([]interface{}{"%s_", _name})
+				} else {
+					return _name
+				}
+			}()
+		}
+		_goParam = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_goParam(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_exportParams = func(_f interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _fixed interface{}
+			mml.Nop(_fixed)
+			_fixed = _map.(*mml.Function).Call([]interface{}{mml.Ref(_strings, "formatOne").(*mml.Function).Call([]interface{}{"%s interface{}"})}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_goParam}).(*mml.Function).Call([]interface{}{mml.Ref(_f, "params")})})
+			return func() interface{} {
+				c = mml.BinaryOp(11, mml.Ref(_f, "collectParam"), "")
+				if c.(bool) {
+					return _join.(*mml.Function).Call([]interface{}{", ", _fixed})
+				} else {
+					return _join.(*mml.Function).Call([]interface{}{", ", (&mml.List{}).Concat(_fixed.(*mml.List)).Append(_formats.(*mml.Function).Call([]interface{}{"%s ...interface{}", direct_goParam(mml.Ref(_f, "collectParam"))}))})
+				}
+			}()
+		}
+		_exportParams = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_exportParams(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_exportArgs = func(_f interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _fixed interface{}
+			mml.Nop(_fixed)
+			_fixed = _map.(*mml.Function).Call([]interface{}{_goParam, mml.Ref(_f, "params")})
+			switch {
+			case mml.BinaryOp(11, mml.Ref(_f, "collectParam"), ""):
+
+				mml.Nop()
+				return _join.(*mml.Function).Call([]interface{}{""}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{mml.Ref(_strings, "formatOne").(*mml.Function).Call([]interface{}{", %s"})}).(*mml.Function).Call([]interface{}{_fixed})})
+			case mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_fixed}), 0):
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{", %s...", direct_goParam(mml.Ref(_f, "collectParam"))})
+			default:
+
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{", append([]interface{}{%s}, %s...)...", _join.(*mml.Function).Call([]interface{}{", ", _fixed}), direct_goParam(mml.Ref(_f, "collectParam"))})
+			}
+		}
+		_exportArgs = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_exportArgs(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_exportResult = func(_t, _call interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				c = mml.BinaryOp(11, _t, "")
+				if c.(bool) {
+					return _formats.(*mml.Function).Call([]interface{}{"return %s", _call})
+				} else {
+					return _formats.(*mml.Function).Call([]interface{}{"v, err := %s\n\t\tif err != nil {\n\t\t\treturn %s, err\n\t\t}\n\n\t\treturn v.(%s), nil", _call, mml.Ref(_zeroValues, _t), mml.Ref(_goTypes, _t)})
+				}
+			}()
+		}
+		_exportResult = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_exportResult(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_exportFunction = func(_path, _d interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _resultType interface{}
+			mml.Nop(_resultType)
+			_resultType = func() interface{} {
+				c = _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "statement-list"); ; return s }(), mml.Ref(mml.Ref(_d, "expression"), "body")})
+				if c.(bool) {
+					return ""
+				} else {
+					return mml.Ref(_types, "of").(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_d, "expression"), "body")})
+				}
+			}()
+			return _formats.(*mml.Function).Call([]interface{}{"// %s calls the function %s of the module %s.\n\t\tfunc %s(%s) (%s, error) {\n\t\t\t%s\n\t\t}", direct_exportedName(mml.Ref(_d, "symbol")), mml.Ref(_d, "symbol"), _path, direct_exportedName(mml.Ref(_d, "symbol")), direct_exportParams(mml.Ref(_d, "expression")), func() interface{} {
+				c = mml.BinaryOp(11, _resultType, "")
+				if c.(bool) {
+					return "interface{}"
+				} else {
+					return mml.Ref(_goTypes, _resultType)
+				}
+			}(), direct_exportResult(_resultType, _formats.(*mml.Function).Call([]interface{}{"mml.CallExport(\"%s\", \"%s\"%s)", _path, mml.Ref(_d, "symbol"), direct_exportArgs(mml.Ref(_d, "expression"))}))})
+		}
+		_exportFunction = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_exportFunction(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_exportValue = func(_path, _d interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _resultType interface{}
+			mml.Nop(_resultType)
+			_resultType = func() interface{} {
+				if _has.(*mml.Function).Call([]interface{}{"valueType", _d}).(bool) && !mml.Ref(_d, "mutable").(bool) {
+					return mml.Ref(_d, "valueType")
+				} else {
+					return ""
+				}
+			}()
+			return _formats.(*mml.Function).Call([]interface{}{"// %s returns the value of %s in the module %s.\n\t\tfunc %s() (%s, error) {\n\t\t\t%s\n\t\t}", direct_exportedName(mml.Ref(_d, "symbol")), mml.Ref(_d, "symbol"), _path, direct_exportedName(mml.Ref(_d, "symbol")), func() interface{} {
+				c = mml.BinaryOp(11, _resultType, "")
+				if c.(bool) {
+					return "interface{}"
+				} else {
+					return mml.Ref(_goTypes, _resultType)
+				}
+			}(), direct_exportResult(_resultType, _formats.(*mml.Function).Call([]interface{}{"mml.Export(\"%s\", \"%s\")", _path, mml.Ref(_d, "symbol")}))})
+		}
+		_exportValue = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_exportValue(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_exports = func(_m interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _join.(*mml.Function).Call([]interface{}{"\n\n"}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _d = a[0]
+					mml.Nop(_d)
+					return func() interface{} {
+						c = _is.(*mml.Function).Call([]interface{}{func() interface{} {
+							s := &mml.Struct{}
+							s.Set("expression", func() interface{} { s := &mml.Struct{}; s.Set("type", "function"); ; return s }())
+							s.Set("mutable", false)
+							return s
+						}(), _d})
+						if c.(bool) {
+							return direct_exportFunction(mml.Ref(_m, "path"), _d)
+						} else {
+							return direct_exportValue(mml.Ref(_m, "path"), _d)
+						}
+					}()
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_sort.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _left = a[0]
+					var _right = a[1]
+					mml.Nop(_left, _right)
+					return mml.BinaryOp(13, mml.Ref(_left, "symbol"), mml.Ref(_right, "symbol"))
+				},
+				FixedArgs: 2,
+			}}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("exported", true); ; return s }()})}).(*mml.Function).Call([]interface{}{mml.Ref(_code, "getDefinitions").(*mml.Function).Call([]interface{}{mml.Ref(_structs, "get").(*mml.Function).Call([]interface{}{"body"}).(*mml.Function).Call([]interface{}{mml.Ref(_types, "do").(*mml.Function).Call([]interface{}{_m})})})})})})})
+		}
+		_exports = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_exports(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_libraryToGo = func(_packageName, _module interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _modules interface{}
+			var _modulesCode interface{}
+			mml.Nop(_modules, _modulesCode)
+			_modules = mml.Ref(_deadcode, "keepExportedOf").(*mml.Function).Call([]interface{}{mml.Ref(_module, "path")}).(*mml.Function).Call([]interface{}{mml.Ref(_constants, "keepExported").(*mml.Function).Call([]interface{}{direct_allModules(_module)})})
+			_modulesCode = direct_modulesToGo(_modules)
+			if v := _modulesCode; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			return _joins.(*mml.Function).Call([]interface{}{"", mml.Ref(_snippets, "packageHead"), _packageName, mml.Ref(_snippets, "packageImport"), _modulesCode, "\n", _join.(*mml.Function).Call([]interface{}{""}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_exports}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _m = a[0]
+					mml.Nop(_m)
+					return mml.BinaryOp(11, mml.Ref(_m, "path"), mml.Ref(_module, "path"))
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_modules})})}), "\n"})
+		}
+		_libraryToGo = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_libraryToGo(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		exports["libraryToGo"] = _libraryToGo
+		direct_moduleToGo = func(_m interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _names interface{}
+			mml.Nop(_names)
+			_names = direct_builtins((&mml.List{}).Append(_m))
+			return _joins.(*mml.Function).Call([]interface{}{"", mml.Ref(_snippets, "head"), direct_interopImports((&mml.List{}).Append(_m)), mml.Ref(_snippets, "initHead"), _join.(*mml.Function).Call([]interface{}{";\n"}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_builtinDefinition}).(*mml.Function).Call([]interface{}{_names})}), _formats.(*mml.Function).Call([]interface{}{"\nmml.Nop(%s)\n", _join.(*mml.Function).Call([]interface{}{", "}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _name = a[0]
+					mml.Nop(_name)
+					return _formats.(*mml.Function).Call([]interface{}{"_%s", _name})
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_names})})}), direct_moduleCode(_m), mml.Ref(_snippets, "initFooter")})
+		}
+		_moduleToGo = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_moduleToGo(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["moduleToGo"] = _moduleToGo
+		direct_mainToGo = func(_path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _joins.(*mml.Function).Call([]interface{}{"", mml.Ref(_snippets, "head"), mml.Ref(_snippets, "mainHead"), _path, mml.Ref(_snippets, "mainFooter")})
+		}
+		_mainToGo = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_mainToGo(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["mainToGo"] = _mainToGo
+
+		return exports
+	})
+
+	modulePath = "snippets"
+
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
+		exports := make(map[string]interface{})
+
+		var c interface{}
+		mml.Nop(c)
+
+		var _head string
+		var _packageHead string
+		var _packageImport string
+		var _initHead string
+		var _initFooter string
+		var _moduleHead string
+		var _moduleFooter string
+		var _mainHead string
+		var _mainFooter string
+		mml.Nop(_head, _packageHead, _packageImport, _initHead, _initFooter, _moduleHead, _moduleFooter, _mainHead, _mainFooter)
+		_head = "// Generated code\npackage main\n\nimport \"github.com/aryszka/mml\"\n"
+		exports["head"] = _head
+		_packageHead = "// Generated code\npackage "
+		exports["packageHead"] = _packageHead
+		_packageImport = "\n\nimport \"github.com/aryszka/mml\"\n"
+		exports["packageImport"] = _packageImport
+		_initHead = "\nfunc init() {\n\tvar modulePath string\n"
+		exports["initHead"] = _initHead
+		_initFooter = "\n}\n"
+		exports["initFooter"] = _initFooter
+		_moduleHead = "\n\tmml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {\n\t\texports := make(map[string]interface{})\n\n\t\tvar c interface{}\n\t\tmml.Nop(c)\n"
+		exports["moduleHead"] = _moduleHead
+		_moduleFooter = "\n\t\treturn exports\n\t})\n"
+		exports["moduleFooter"] = _moduleFooter
+		_mainHead = "\nfunc main() {\n\tmml.Modules.MustUse(\""
+		exports["mainHead"] = _mainHead
+		_mainFooter = "\")\n}\n"
+		exports["mainFooter"] = _mainFooter
+
+		return exports
+	})
+
+	modulePath = "tailcalls"
+
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
+		exports := make(map[string]interface{})
+
+		var c interface{}
+		mml.Nop(c)
+
+		var _hasTailCall interface{}
+		var _isSelfCall interface{}
+		var _tailExpression interface{}
+		var _tailStatement interface{}
+		var _checkReturns interface{}
+		var _blocks interface{}
+		var _returns interface{}
+		var _shadows interface{}
+		var _functionDefinition interface{}
+		var _definition interface{}
+		var _do interface{}
+		var _codetree interface{}
+		var _lists interface{}
+		var _fold interface{}
+		var _foldr interface{}
+		var _map interface{}
+		var _filter interface{}
+		var _contains interface{}
+		var _sort interface{}
+		var _flat interface{}
+		var _flats interface{}
+		var _uniq interface{}
+		var _every interface{}
+		var _some interface{}
+		var _join interface{}
+		var _joins interface{}
+		var _formats interface{}
+		var _enum interface{}
+		var _log interface{}
+		var _fatal interface{}
+		var _bind interface{}
+		var _identity interface{}
+		var _eq interface{}
+		var _any interface{}
+		var _function interface{}
+		var _channel interface{}
+		var _natural interface{}
+		var _type interface{}
+		var _listOf interface{}
+		var _structOf interface{}
+		var _range interface{}
+		var _rangeMin interface{}
+		var _listLength interface{}
+		var _or interface{}
+		var _and interface{}
+		var _not interface{}
+		var _predicate interface{}
+		var _is interface{}
+		var direct_hasTailCall func(interface{}) interface{}
+		mml.Nop(direct_hasTailCall)
+		var direct_isSelfCall func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_isSelfCall)
+		var direct_tailExpression func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_tailExpression)
+		var direct_tailStatement func(interface{}, interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_tailStatement)
+		var direct_checkReturns func(interface{}) interface{}
+		mml.Nop(direct_checkReturns)
+		var direct_blocks func(interface{}) interface{}
+		mml.Nop(direct_blocks)
+		var direct_returns func(interface{}) interface{}
+		mml.Nop(direct_returns)
+		var direct_shadows func(interface{}, interface{}) interface{}
+		mml.Nop(direct_shadows)
+		var direct_functionDefinition func(interface{}) interface{}
+		mml.Nop(direct_functionDefinition)
+		var direct_definition func(interface{}) interface{}
+		mml.Nop(direct_definition)
+		var direct_do func(interface{}) interface{}
+		mml.Nop(direct_do)
+		mml.Nop(_hasTailCall, _isSelfCall, _tailExpression, _tailStatement, _checkReturns, _blocks, _returns, _shadows, _functionDefinition, _definition, _do, _codetree, _lists, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _flats, _uniq, _every, _some, _join, _joins, _formats, _enum, _log, _fatal, _bind, _identity, _eq, _any, _function, _channel, _natural, _type, _listOf, _structOf, _range, _rangeMin, _listLength, _or, _and, _not, _predicate, _is)
+		var __lang = use("lang")
+		_fold = __lang.Get("fold")
+		_foldr = __lang.Get("foldr")
+		_map = __lang.Get("map")
+		_filter = __lang.Get("filter")
+		_contains = __lang.Get("contains")
+		_sort = __lang.Get("sort")
+		_flat = __lang.Get("flat")
+		_flats = __lang.Get("flats")
+		_uniq = __lang.Get("uniq")
+		_every = __lang.Get("every")
+		_some = __lang.Get("some")
+		_join = __lang.Get("join")
+		_joins = __lang.Get("joins")
+		_formats = __lang.Get("formats")
+		_enum = __lang.Get("enum")
+		_log = __lang.Get("log")
+		_fatal = __lang.Get("fatal")
+		_bind = __lang.Get("bind")
+		_identity = __lang.Get("identity")
+		_eq = __lang.Get("eq")
+		_any = __lang.Get("any")
+		_function = __lang.Get("function")
+		_channel = __lang.Get("channel")
+		_natural = __lang.Get("natural")
+		_type = __lang.Get("type")
+		_listOf = __lang.Get("listOf")
+		_structOf = __lang.Get("structOf")
+		_range = __lang.Get("range")
+		_rangeMin = __lang.Get("rangeMin")
+		_listLength = __lang.Get("listLength")
+		_or = __lang.Get("or")
+		_and = __lang.Get("and")
+		_not = __lang.Get("not")
+		_predicate = __lang.Get("predicate")
+		_is = __lang.Get("is")
+		_codetree = use("codetree")
+		_lists = use("lists")
+		direct_hasTailCall = func(_code interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _is.(*mml.Function).Call([]interface{}{_or.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "tail-call"); ; return s }(), func() interface{} { s := &mml.Struct{}; s.Set("tailCall", true); ; return s }()}), _code})
+		}
+		_hasTailCall = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_hasTailCall(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_isSelfCall = func(_name, _f, _code interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return ((_is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("type", "application")
+				s.Set("function", func() interface{} { s := &mml.Struct{}; s.Set("type", "symbol"); s.Set("name", _name); ; return s }())
+				return s
+			}(), _code}).(bool) && !_some.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "spread"); ; return s }()}), mml.Ref(_code, "args")}).(bool)) && mml.BinaryOp(16, _len.(*mml.Function).Call([]interface{}{mml.Ref(_code, "args")}), _len.(*mml.Function).Call([]interface{}{mml.Ref(_f, "params")})).(bool))
+		}
+		_isSelfCall = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_isSelfCall(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_tailExpression = func(_name, _f, _code interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			switch {
+			case direct_isSelfCall(_name, _f, _code):
+
+				mml.Nop()
+				return func() interface{} {
+					s := &mml.Struct{}
+					s.Set("type", "tail-call")
+					s.Set("args", mml.Ref(_code, "args"))
+					return s
+				}()
+			case _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "cond"); s.Set("ternary", true); ; return s }(), _code}):
+				var _consequent interface{}
+				var _alternative interface{}
+				mml.Nop(_consequent, _alternative)
+				_consequent = direct_tailExpression(_name, _f, mml.Ref(_code, "consequent"))
+				_alternative = direct_tailExpression(_name, _f, mml.Ref(_code, "alternative"))
+				return func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(_code.(*mml.Struct))
+					s.Set("consequent", _consequent)
+					s.Set("alternative", _alternative)
+					s.Set("tailCall", (direct_hasTailCall(_consequent).(bool) || direct_hasTailCall(_alternative).(bool)))
+					return s
+				}()
+			default:
+
+				mml.Nop()
+				return _code
+			}
+		}
+		_tailExpression = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_tailExpression(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_tailStatement = func(_name, _f, _last, _code interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _tail interface{}
+			var _tailCase interface{}
+			mml.Nop(_tail, _tailCase)
+			_tail = _tailStatement.(*mml.Function).Call([]interface{}{_name, _f, _last})
+			_tailCase = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _c = a[0]
+					mml.Nop(_c)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_c.(*mml.Struct))
+						s.Set("body", _tail.(*mml.Function).Call([]interface{}{mml.Ref(_c, "body")}))
+						return s
+					}()
+				},
+				FixedArgs: 1,
+			}
+			switch mml.Ref(_code, "type") {
+			case "ret":
+
+				mml.Nop()
+				return func() interface{} {
+					c = _has.(*mml.Function).Call([]interface{}{"value", _code})
+					if c.(bool) {
+						return func() interface{} {
+							s := &mml.Struct{}
+							s.Merge(_code.(*mml.Struct))
+							s.Set("value", direct_tailExpression(_name, _f, mml.Ref(_code, "value")))
+							return s
+						}()
+					} else {
+						return _code
+					}
+				}()
+			case "check-ret":
+
+				mml.Nop()
+				return func() interface{} {
+					c = _last
+					if c.(bool) {
+						return func() interface{} {
+							s := &mml.Struct{}
+							s.Merge(_code.(*mml.Struct))
+							s.Set("value", direct_tailExpression(_name, _f, mml.Ref(_code, "value")))
+							return s
+						}()
+					} else {
+						return _code
+					}
+				}()
+			case "statement-list":
+				var _indexes interface{}
+				var _nonComments interface{}
+				var _lastIndex interface{}
+				mml.Nop(_indexes, _nonComments, _lastIndex)
+				_indexes = mml.Ref(_lists, "indexes").(*mml.Function).Call([]interface{}{mml.Ref(_code, "statements")})
+				_nonComments = _filter.(*mml.Function).Call([]interface{}{&mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _i = a[0]
+						mml.Nop(_i)
+						return mml.BinaryOp(12, mml.Ref(mml.Ref(mml.Ref(_code, "statements"), _i), "type"), "comment")
+					},
+					FixedArgs: 1,
+				}, _indexes})
+				_lastIndex = func() interface{} {
+					c = mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_nonComments}), 0)
+					if c.(bool) {
+						return -(1)
+					} else {
+						return mml.Ref(_nonComments, mml.BinaryOp(10, _len.(*mml.Function).Call([]interface{}{_nonComments}), 1))
+					}
+				}()
+				return func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(_code.(*mml.Struct))
+					s.Set("statements", _map.(*mml.Function).Call([]interface{}{&mml.Function{
+						F: func(a []interface{}) interface{} {
+							var c interface{}
+							mml.Nop(c)
+							var _i = a[0]
+							mml.Nop(_i)
+							return direct_tailStatement(_name, _f, (_last.(bool) && mml.BinaryOp(11, _i, _lastIndex).(bool)), mml.Ref(mml.Ref(_code, "statements"), _i))
+						},
+						FixedArgs: 1,
+					}, _indexes}))
+					return s
+				}()
+			case "cond":
+
+				mml.Nop()
+				c = mml.Ref(_code, "ternary")
+				if c.(bool) {
+					mml.Nop()
+					return _code
+				}
+				return func() interface{} {
+					c = _has.(*mml.Function).Call([]interface{}{"alternative", _code})
+					if c.(bool) {
+						return func() interface{} {
+							s := &mml.Struct{}
+							s.Merge(_code.(*mml.Struct))
+							s.Set("consequent", _tail.(*mml.Function).Call([]interface{}{mml.Ref(_code, "consequent")}))
+							s.Set("alternative", _tail.(*mml.Function).Call([]interface{}{mml.Ref(_code, "alternative")}))
+							return s
+						}()
+					} else {
+						return func() interface{} {
+							s := &mml.Struct{}
+							s.Merge(_code.(*mml.Struct))
+							s.Set("consequent", _tail.(*mml.Function).Call([]interface{}{mml.Ref(_code, "consequent")}))
+							return s
+						}()
+					}
+				}()
+			case "switch-statement":
+
+				mml.Nop()
+				return func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(_code.(*mml.Struct))
+					s.Set("cases", _map.(*mml.Function).Call([]interface{}{_tailCase, mml.Ref(_code, "cases")}))
+					s.Set("defaultStatements", _tail.(*mml.Function).Call([]interface{}{mml.Ref(_code, "defaultStatements")}))
+					return s
+				}()
+			case "select-statement":
+
+				mml.Nop()
+				return func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(_code.(*mml.Struct))
+					s.Set("cases", _map.(*mml.Function).Call([]interface{}{_tailCase, mml.Ref(_code, "cases")}))
+					s.Set("defaultStatements", _tail.(*mml.Function).Call([]interface{}{mml.Ref(_code, "defaultStatements")}))
+					return s
+				}()
+			case "loop":
+
+				mml.Nop()
+				return func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(_code.(*mml.Struct))
+					s.Set("body", direct_tailStatement(_name, _f, false, mml.Ref(_code, "body")))
+					return s
+				}()
+			default:
+
+				mml.Nop()
+				return _code
+			}
+		}
+		_tailStatement = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_tailStatement(a[0], a[1], a[2], a[3])
+			},
+			FixedArgs: 4,
+		}
+		direct_checkReturns = func(_code interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _checkCase interface{}
+			mml.Nop(_checkCase)
+			_checkCase = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _c = a[0]
+					mml.Nop(_c)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_c.(*mml.Struct))
+						s.Set("body", direct_checkReturns(mml.Ref(_c, "body")))
+						return s
+					}()
+				},
+				FixedArgs: 1,
+			}
+			switch mml.Ref(_code, "type") {
+			case "ret":
+
+				mml.Nop()
+				return func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(_code.(*mml.Struct))
+					s.Set("checked", true)
+					return s
+				}()
+			case "statement-list":
+
+				mml.Nop()
+				return func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(_code.(*mml.Struct))
+					s.Set("statements", _map.(*mml.Function).Call([]interface{}{_checkReturns, mml.Ref(_code, "statements")}))
+					return s
+				}()
+			case "cond":
+
+				mml.Nop()
+				c = mml.Ref(_code, "ternary")
+				if c.(bool) {
+					mml.Nop()
+					return _code
+				}
+				return func() interface{} {
+					c = _has.(*mml.Function).Call([]interface{}{"alternative", _code})
+					if c.(bool) {
+						return func() interface{} {
+							s := &mml.Struct{}
+							s.Merge(_code.(*mml.Struct))
+							s.Set("consequent", direct_checkReturns(mml.Ref(_code, "consequent")))
+							s.Set("alternative", direct_checkReturns(mml.Ref(_code, "alternative")))
+							return s
+						}()
+					} else {
+						return func() interface{} {
+							s := &mml.Struct{}
+							s.Merge(_code.(*mml.Struct))
+							s.Set("consequent", direct_checkReturns(mml.Ref(_code, "consequent")))
+							return s
+						}()
+					}
+				}()
+			case "switch-statement":
+
+				mml.Nop()
+				return func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(_code.(*mml.Struct))
+					s.Set("cases", _map.(*mml.Function).Call([]interface{}{_checkCase, mml.Ref(_code, "cases")}))
+					s.Set("defaultStatements", direct_checkReturns(mml.Ref(_code, "defaultStatements")))
+					return s
+				}()
+			case "select-statement":
+
+				mml.Nop()
+				return func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(_code.(*mml.Struct))
+					s.Set("cases", _map.(*mml.Function).Call([]interface{}{_checkCase, mml.Ref(_code, "cases")}))
+					s.Set("defaultStatements", direct_checkReturns(mml.Ref(_code, "defaultStatements")))
+					return s
+				}()
+			case "loop":
+
+				mml.Nop()
+				return func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(_code.(*mml.Struct))
+					s.Set("body", direct_checkReturns(mml.Ref(_code, "body")))
+					return s
+				}()
+			default:
+
+				mml.Nop()
+				return _code
+			}
+		}
+		_checkReturns = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_checkReturns(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_blocks = func(_code interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _body interface{}
+			mml.Nop(_body)
+			_body = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _c = a[0]
+					mml.Nop(_c)
+					return mml.Ref(_c, "body")
+				},
+				FixedArgs: 1,
+			}
+			switch mml.Ref(_code, "type") {
+			case "statement-list":
+
+				mml.Nop()
+				return mml.Ref(_code, "statements")
+			case "cond":
+
+				mml.Nop()
+				return func() interface{} {
+					c = mml.Ref(_code, "ternary")
+					if c.(bool) {
+						return (&mml.List{})
+					} else {
+						return func() interface{} {
+							c = _has.(*mml.Function).Call([]interface{}{"alternative", _code})
+							if c.(bool) {
+								return (&mml.List{}).Append(mml.Ref(_code, "consequent"), mml.Ref(_code, "alternative"))
+							} else {
+								return (&mml.List{}).Append(mml.Ref(_code, "consequent"))
+							}
+						}()
+					}
+				}()
+			case "switch-statement":
+
+				mml.Nop()
+				return (&mml.List{}).Concat(_map.(*mml.Function).Call([]interface{}{_body, mml.Ref(_code, "cases")}).(*mml.List)).Append(mml.Ref(_code, "defaultStatements"))
+			case "select-statement":
+
+				mml.Nop()
+				return (&mml.List{}).Concat(_map.(*mml.Function).Call([]interface{}{_body, mml.Ref(_code, "cases")}).(*mml.List)).Append(mml.Ref(_code, "defaultStatements"))
+			case "loop":
+
+				mml.Nop()
+				return (&mml.List{}).Append(mml.Ref(_code, "body"))
+			default:
+
+				mml.Nop()
+				return (&mml.List{})
+			}
+		}
+		_blocks = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_blocks(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_returns = func(_code interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				c = _is.(*mml.Function).Call([]interface{}{_or.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "ret"); ; return s }(), func() interface{} { s := &mml.Struct{}; s.Set("type", "check-ret"); ; return s }()}), _code})
+				if c.(bool) {
+					return (&mml.List{}).Append(_code)
+				} else {
+					return _flat.(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_returns}).(*mml.Function).Call([]interface{}{direct_blocks(_code)})})
+				}
+			}()
+		}
+		_returns = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_returns(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_shadows = func(_name, _f interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _namedBy interface{}
+			var _shadowing interface{}
+			mml.Nop(_namedBy, _shadowing)
+			_namedBy = _or.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("type", "definition")
+				s.Set("symbol", _name)
+				return s
+			}(), func() interface{} {
+				s := &mml.Struct{}
+				s.Set("type", "range-over")
+				s.Set("symbol", _name)
+				return s
+			}(), func() interface{} { s := &mml.Struct{}; s.Set("type", "use"); s.Set("capture", _name); ; return s }()})
+			_shadowing = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _code = a[0]
+					mml.Nop(_code)
+					return (_is.(*mml.Function).Call([]interface{}{_namedBy, _code}).(bool) || (_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "function"); ; return s }(), _code}).(bool) && (_contains.(*mml.Function).Call([]interface{}{_name, mml.Ref(_code, "params")}).(bool) || mml.BinaryOp(11, mml.Ref(_code, "collectParam"), _name).(bool))))
+				},
+				FixedArgs: 1,
+			}
+			return (_shadowing.(*mml.Function).Call([]interface{}{_f}).(bool) || mml.BinaryOp(15, _len.(*mml.Function).Call([]interface{}{mml.Ref(_codetree, "filter").(*mml.Function).Call([]interface{}{_shadowing, mml.Ref(_f, "body")})}), 0).(bool))
+		}
+		_shadows = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_shadows(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_functionDefinition = func(_d interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _tailBody interface{}
+			var _tailReturns interface{}
+			var _checked interface{}
+			var _f interface{}
+			var _body interface{}
+			mml.Nop(_tailBody, _tailReturns, _checked, _f, _body)
+			_f = mml.Ref(_d, "expression")
+			_body = func() interface{} {
+				c = _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "statement-list"); ; return s }(), mml.Ref(_f, "body")})
+				if c.(bool) {
+					return mml.Ref(_f, "body")
+				} else {
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Set("type", "statement-list")
+						s.Set("ast", mml.Ref(mml.Ref(_f, "body"), "ast"))
+						s.Set("statements", (&mml.List{}).Append(func() interface{} {
+							s := &mml.Struct{}
+							s.Set("type", "ret")
+							s.Set("ast", mml.Ref(mml.Ref(_f, "body"), "ast"))
+							s.Set("value", mml.Ref(_f, "body"))
+							return s
+						}()))
+						return s
+					}()
+				}
+			}()
+			_tailBody = direct_tailStatement(mml.Ref(_d, "symbol"), _f, true, _body)
+			_tailReturns = _filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("value", _predicate.(*mml.Function).Call([]interface{}{_hasTailCall}))
+				return s
+			}()})}).(*mml.Function).Call([]interface{}{direct_returns(_tailBody)})
+			if mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_tailReturns}), 0).(bool) || direct_shadows(mml.Ref(_d, "symbol"), _f).(bool) {
+				mml.Nop()
+				return _d
+			}
+			_checked = _some.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "check-ret"); ; return s }()}), _tailReturns})
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Merge(_d.(*mml.Struct))
+				s.Set("expression", func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(_f.(*mml.Struct))
+					s.Set("body", func() interface{} {
+						c = _checked
+						if c.(bool) {
+							return direct_checkReturns(_tailBody)
+						} else {
+							return _tailBody
+						}
+					}())
+					s.Set("tailCalls", true)
+					s.Set("checkTailCalls", _checked)
+					return s
+				}())
+				return s
+			}()
+		}
+		_functionDefinition = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_functionDefinition(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_definition = func(_code interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				c = _is.(*mml.Function).Call([]interface{}{func() interface{} {
+					s := &mml.Struct{}
+					s.Set("type", "definition")
+					s.Set("mutable", false)
+					s.Set("expression", func() interface{} { s := &mml.Struct{}; s.Set("type", "function"); ; return s }())
+					return s
+				}(), _code})
+				if c.(bool) {
+					return direct_functionDefinition(_code)
+				} else {
+					return _code
+				}
+			}()
+		}
+		_definition = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_definition(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_do = func(_module interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return mml.Ref(_codetree, "edit").(*mml.Function).Call([]interface{}{_definition, _module})
+		}
+		_do = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_do(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["do"] = _do
+
+		return exports
+	})
+
+	modulePath = "constants"
+
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
+		exports := make(map[string]interface{})
+
+		var c interface{}
+		mml.Nop(c)
+
+		var _returning interface{}
+		var _none interface{}
+		var _id interface{}
+		var _fail interface{}
+		var _step interface{}
+		var _newCell interface{}
+		var _valueCell interface{}
+		var _read interface{}
+		var _write interface{}
+		var _data interface{}
+		var _size interface{}
+		var _kind interface{}
+		var _builtins interface{}
+		var _fixedArgs interface{}
+		var _callBuiltin interface{}
+		var _reverse interface{}
+		var _listFold interface{}
+		var _listMap interface{}
+		var _listFilter interface{}
+		var _partial interface{}
+		var _builtin interface{}
+		var _unary interface{}
+		var _binary interface{}
+		var _logical interface{}
+		var _condition interface{}
+		var _values interface{}
+		var _struct interface{}
+		var _member interface{}
+		var _index interface{}
+		var _rangeIndex interface{}
+		var _apply interface{}
+		var _closure interface{}
+		var _call interface{}
+		var _callBody interface{}
+		var _indexes interface{}
+		var _eval interface{}
+		var _define interface{}
+		var _assign interface{}
+		var _statementList interface{}
+		var _ifStatement interface{}
+		var _switchStatement interface{}
+		var _loop interface{}
+		var _exec interface{}
+		var _uses interface{}
+		var _initOrder interface{}
+		var _useModule interface{}
+		var _literal interface{}
+		var _foldable interface{}
+		var _evaluateDefinition interface{}
+		var _evaluateModule interface{}
+		var _literalCode interface{}
+		var _replace interface{}
+		var _safe interface{}
+		var _evaluate interface{}
+		var _maxSteps int
+		var _maxNodes int
+		var _normal interface{}
+		var _breaking interface{}
+		var _continuing interface{}
+		var _do interface{}
+		var _keepExported interface{}
+		var _code interface{}
+		var _deadcode interface{}
+		var _structs interface{}
+		var _fold interface{}
+		var _foldr interface{}
+		var _map interface{}
+		var _filter interface{}
+		var _contains interface{}
+		var _sort interface{}
+		var _flat interface{}
+		var _flats interface{}
+		var _uniq interface{}
+		var _every interface{}
+		var _some interface{}
+		var _join interface{}
+		var _joins interface{}
+		var _formats interface{}
+		var _enum interface{}
+		var _log interface{}
+		var _fatal interface{}
+		var _bind interface{}
+		var _identity interface{}
+		var _eq interface{}
+		var _any interface{}
+		var _function interface{}
+		var _channel interface{}
+		var _natural interface{}
+		var _type interface{}
+		var _listOf interface{}
+		var _structOf interface{}
+		var _range interface{}
+		var _rangeMin interface{}
+		var _listLength interface{}
+		var _or interface{}
+		var _and interface{}
+		var _not interface{}
+		var _predicate interface{}
+		var _is interface{}
+		var direct_returning func(interface{}) interface{}
+		mml.Nop(direct_returning)
+		var direct_none func() interface{}
+		mml.Nop(direct_none)
+		var direct_id func(interface{}, interface{}) interface{}
+		mml.Nop(direct_id)
+		var direct_fail func(interface{}, interface{}) interface{}
+		mml.Nop(direct_fail)
+		var direct_step func(interface{}) interface{}
+		mml.Nop(direct_step)
+		var direct_newCell func(interface{}, interface{}) interface{}
+		mml.Nop(direct_newCell)
+		var direct_valueCell func(interface{}) interface{}
+		mml.Nop(direct_valueCell)
+		var direct_read func(interface{}, interface{}) interface{}
+		mml.Nop(direct_read)
+		var direct_write func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_write)
+		var direct_data func(interface{}) interface{}
+		mml.Nop(direct_data)
+		var direct_size func(interface{}) interface{}
+		mml.Nop(direct_size)
+		var direct_kind func(interface{}) interface{}
+		mml.Nop(direct_kind)
+		var direct_callBuiltin func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_callBuiltin)
+		var direct_reverse func(interface{}) interface{}
+		mml.Nop(direct_reverse)
+		var direct_listFold func(interface{}, interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_listFold)
+		var direct_listMap func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_listMap)
+		var direct_listFilter func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_listFilter)
+		var direct_partial func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_partial)
+		var direct_builtin func(interface{}, interface{}) interface{}
+		mml.Nop(direct_builtin)
+		var direct_unary func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_unary)
+		var direct_binary func(interface{}, interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_binary)
+		var direct_logical func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_logical)
+		var direct_condition func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_condition)
+		var direct_values func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_values)
+		var direct_struct func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_struct)
+		var direct_member func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_member)
+		var direct_index func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_index)
+		var direct_rangeIndex func(interface{}, interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_rangeIndex)
+		var direct_apply func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_apply)
+		var direct_closure func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_closure)
+		var direct_call func(interface{}, interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_call)
+		var direct_callBody func(interface{}, interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_callBody)
+		var direct_indexes func(interface{}) interface{}
+		mml.Nop(direct_indexes)
+		var direct_eval func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_eval)
+		var direct_define func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_define)
+		var direct_assign func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_assign)
+		var direct_statementList func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_statementList)
+		var direct_ifStatement func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_ifStatement)
+		var direct_switchStatement func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_switchStatement)
+		var direct_loop func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_loop)
+		var direct_exec func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_exec)
+		var direct_uses func(interface{}) interface{}
+		mml.Nop(direct_uses)
+		var direct_initOrder func(interface{}) interface{}
+		mml.Nop(direct_initOrder)
+		var direct_useModule func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_useModule)
+		var direct_literal func(interface{}) interface{}
+		mml.Nop(direct_literal)
+		var direct_foldable func(interface{}, interface{}) interface{}
+		mml.Nop(direct_foldable)
+		var direct_evaluateDefinition func(interface{}, interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_evaluateDefinition)
+		var direct_evaluateModule func(interface{}, interface{}) interface{}
+		mml.Nop(direct_evaluateModule)
+		var direct_literalCode func(interface{}) interface{}
+		mml.Nop(direct_literalCode)
+		var direct_replace func(interface{}, interface{}) interface{}
+		mml.Nop(direct_replace)
+		var direct_safe func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_safe)
+		var direct_evaluate func(interface{}, interface{}) interface{}
+		mml.Nop(direct_evaluate)
+		var direct_do func(interface{}) interface{}
+		mml.Nop(direct_do)
+		var direct_keepExported func(interface{}) interface{}
+		mml.Nop(direct_keepExported)
+		mml.Nop(_returning, _none, _id, _fail, _step, _newCell, _valueCell, _read, _write, _data, _size, _kind, _builtins, _fixedArgs, _callBuiltin, _reverse, _listFold, _listMap, _listFilter, _partial, _builtin, _unary, _binary, _logical, _condition, _values, _struct, _member, _index, _rangeIndex, _apply, _closure, _call, _callBody, _indexes, _eval, _define, _assign, _statementList, _ifStatement, _switchStatement, _loop, _exec, _uses, _initOrder, _useModule, _literal, _foldable, _evaluateDefinition, _evaluateModule, _literalCode, _replace, _safe, _evaluate, _maxSteps, _maxNodes, _normal, _breaking, _continuing, _do, _keepExported, _code, _deadcode, _structs, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _flats, _uniq, _every, _some, _join, _joins, _formats, _enum, _log, _fatal, _bind, _identity, _eq, _any, _function, _channel, _natural, _type, _listOf, _structOf, _range, _rangeMin, _listLength, _or, _and, _not, _predicate, _is)
+		var __lang = use("lang")
+		_fold = __lang.Get("fold")
+		_foldr = __lang.Get("foldr")
+		_map = __lang.Get("map")
+		_filter = __lang.Get("filter")
+		_contains = __lang.Get("contains")
+		_sort = __lang.Get("sort")
+		_flat = __lang.Get("flat")
+		_flats = __lang.Get("flats")
+		_uniq = __lang.Get("uniq")
+		_every = __lang.Get("every")
+		_some = __lang.Get("some")
+		_join = __lang.Get("join")
+		_joins = __lang.Get("joins")
+		_formats = __lang.Get("formats")
+		_enum = __lang.Get("enum")
+		_log = __lang.Get("log")
+		_fatal = __lang.Get("fatal")
+		_bind = __lang.Get("bind")
+		_identity = __lang.Get("identity")
+		_eq = __lang.Get("eq")
+		_any = __lang.Get("any")
+		_function = __lang.Get("function")
+		_channel = __lang.Get("channel")
+		_natural = __lang.Get("natural")
+		_type = __lang.Get("type")
+		_listOf = __lang.Get("listOf")
+		_structOf = __lang.Get("structOf")
+		_range = __lang.Get("range")
+		_rangeMin = __lang.Get("rangeMin")
+		_listLength = __lang.Get("listLength")
+		_or = __lang.Get("or")
+		_and = __lang.Get("and")
+		_not = __lang.Get("not")
+		_predicate = __lang.Get("predicate")
+		_is = __lang.Get("is")
+		_code = use("code")
+		_deadcode = use("deadcode")
+		_structs = use("structs")
+		_maxSteps = 100000
+		_maxNodes = 10000
+		_normal = func() interface{} { s := &mml.Struct{}; ; return s }()
+		_breaking = func() interface{} { s := &mml.Struct{}; s.Set("control", "break"); ; return s }()
+		_continuing = func() interface{} { s := &mml.Struct{}; s.Set("control", "continue"); ; return s }()
+		direct_returning = func(_value interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} { s := &mml.Struct{}; s.Set("control", "return"); s.Set("value", _value); ; return s }()
+		}
+		_returning = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_returning(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_none = func() interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} { s := &mml.Struct{}; ; return s }()
+		}
+		_none = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_none()
+			},
+			FixedArgs: 0,
+		}
+		direct_id = func(_path, _name interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _formats.(*mml.Function).Call([]interface{}{"%s:%s", _path, _name})
+		}
+		_id = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_id(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_fail = func(_st, _message interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			mml.SetRef(_st, "failed", true)
+			return _error.(*mml.Function).Call([]interface{}{_message})
+		}
+		_fail = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_fail(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_step = func(_st interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			mml.SetRef(_st, "steps", mml.BinaryOp(9, mml.Ref(_st, "steps"), 1))
+			return func() interface{} {
+				c = mml.BinaryOp(15, mml.Ref(_st, "steps"), _maxSteps)
+				if c.(bool) {
+					return direct_fail(_st, "too many steps")
+				} else {
+					return mml.Ref(_st, "steps")
+				}
+			}()
+		}
+		_step = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_step(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_newCell = func(_owner, _mutable interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Set("known", false)
+				s.Set("mutable", _mutable)
+				s.Set("owner", _owner)
+				s.Set("deps", func() interface{} { s := &mml.Struct{}; ; return s }())
+				return s
+			}()
+		}
+		_newCell = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_newCell(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_valueCell = func(_value interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Set("known", true)
+				s.Set("value", _value)
+				s.Set("mutable", false)
+				s.Set("deps", func() interface{} { s := &mml.Struct{}; ; return s }())
+				return s
+			}()
+		}
+		_valueCell = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_valueCell(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_read = func(_st, _cell interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			if !mml.Ref(_cell, "known").(bool) || _has.(*mml.Function).Call([]interface{}{"module", _cell}).(bool) {
+				mml.Nop()
+				return direct_fail(_st, "unknown value")
+			}
+			mml.SetRef(_st, "deps", func() interface{} {
+				s := &mml.Struct{}
+				s.Merge(mml.Ref(_st, "deps").(*mml.Struct))
+				s.Merge(mml.Ref(_cell, "deps").(*mml.Struct))
+				return s
+			}())
+			if mml.Ref(_cell, "mutable").(bool) && mml.BinaryOp(12, mml.Ref(mml.Ref(_cell, "owner"), "id"), mml.Ref(mml.Ref(_st, "owner"), "id")).(bool) {
+				mml.Nop()
+				mml.SetRef(_st, "deps", func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(mml.Ref(_st, "deps").(*mml.Struct))
+					s.Set(mml.Ref(mml.Ref(_cell, "owner"), "id").(string), mml.Ref(_cell, "owner"))
+					return s
+				}())
+			}
+			return mml.Ref(_cell, "value")
+		}
+		_read = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_read(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_write = func(_st, _cell, _value interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			c = mml.BinaryOp(12, mml.Ref(mml.Ref(_cell, "owner"), "id"), mml.Ref(mml.Ref(_st, "owner"), "id"))
+			if c.(bool) {
+				mml.Nop()
+				mml.SetRef(_st, "deps", func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(mml.Ref(_st, "deps").(*mml.Struct))
+					s.Set(mml.Ref(mml.Ref(_cell, "owner"), "id").(string), mml.Ref(_cell, "owner"))
+					return s
+				}())
+			}
+			mml.SetRef(_cell, "value", _value)
+			mml.SetRef(_cell, "known", true)
+			mml.SetRef(_cell, "deps", func() interface{} {
+				s := &mml.Struct{}
+				s.Merge(mml.Ref(_cell, "deps").(*mml.Struct))
+				s.Merge(mml.Ref(_st, "deps").(*mml.Struct))
+				return s
+			}())
+			return nil
+		}
+		_write = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_write(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_data = func(_v interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return (((((_isInt.(*mml.Function).Call([]interface{}{_v}).(bool) || (_isFloat.(*mml.Function).Call([]interface{}{_v}).(bool) && mml.BinaryOp(11, mml.BinaryOp(10, _v, _v), float64(0)).(bool))) || _isString.(*mml.Function).Call([]interface{}{_v}).(bool)) || _isBool.(*mml.Function).Call([]interface{}{_v}).(bool)) || (_isList.(*mml.Function).Call([]interface{}{_v}).(bool) && _every.(*mml.Function).Call([]interface{}{_data, _v}).(bool))) || (_isStruct.(*mml.Function).Call([]interface{}{_v}).(bool) && _every.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _k = a[0]
+					mml.Nop(_k)
+					return direct_data(mml.Ref(_v, _k))
+				},
+				FixedArgs: 1,
+			}, _keys.(*mml.Function).Call([]interface{}{_v})}).(bool)))
+		}
+		_data = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_data(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_size = func(_v interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			switch {
+			case _isList.(*mml.Function).Call([]interface{}{_v}):
+
+				mml.Nop()
+				return _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _item = a[0]
+						var _s = a[1]
+						mml.Nop(_item, _s)
+						return mml.BinaryOp(9, _s, direct_size(_item))
+					},
+					FixedArgs: 2,
+				}, 1, _v})
+			case _isStruct.(*mml.Function).Call([]interface{}{_v}):
+
+				mml.Nop()
+				return _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _k = a[0]
+						var _s = a[1]
+						mml.Nop(_k, _s)
+						return mml.BinaryOp(9, _s, direct_size(mml.Ref(_v, _k)))
+					},
+					FixedArgs: 2,
+				}, 1, _keys.(*mml.Function).Call([]interface{}{_v})})
+			default:
+
+				mml.Nop()
+				return 1
+			}
+		}
+		_size = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_size(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_kind = func(_v interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			switch {
+			case _isInt.(*mml.Function).Call([]interface{}{_v}):
+
+				mml.Nop()
+				return "int"
+			case _isFloat.(*mml.Function).Call([]interface{}{_v}):
+
+				mml.Nop()
+				return "float"
+			case _isString.(*mml.Function).Call([]interface{}{_v}):
+
+				mml.Nop()
+				return "string"
+			case _isBool.(*mml.Function).Call([]interface{}{_v}):
+
+				mml.Nop()
+				return "bool"
+			default:
+
+				mml.Nop()
+				return ""
+			}
+		}
+		_kind = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_kind(a[0])
+			},
+			FixedArgs: 1,
+		}
+		_builtins = func() interface{} {
+			s := &mml.Struct{}
+			s.Set("len", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("f", _len)
+				s.Set("valid", &mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _a = a[0]
+						mml.Nop(_a)
+						return ((_isString.(*mml.Function).Call([]interface{}{mml.Ref(_a, 0)}).(bool) || _isList.(*mml.Function).Call([]interface{}{mml.Ref(_a, 0)}).(bool)) || _isStruct.(*mml.Function).Call([]interface{}{mml.Ref(_a, 0)}).(bool))
+					},
+					FixedArgs: 1,
+				})
+				return s
+			}())
+			s.Set("keys", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("f", _keys)
+				s.Set("valid", &mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _a = a[0]
+						mml.Nop(_a)
+						return _isStruct.(*mml.Function).Call([]interface{}{mml.Ref(_a, 0)})
+					},
+					FixedArgs: 1,
+				})
+				return s
+			}())
+			s.Set("has", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("f", _has)
+				s.Set("valid", &mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _a = a[0]
+						mml.Nop(_a)
+						return _isString.(*mml.Function).Call([]interface{}{mml.Ref(_a, 0)})
+					},
+					FixedArgs: 1,
+				})
+				return s
+			}())
+			s.Set("isError", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("f", _isError)
+				s.Set("valid", &mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _a = a[0]
+						mml.Nop(_a)
+						return true
+					},
+					FixedArgs: 1,
+				})
+				return s
+			}())
+			s.Set("isBool", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("f", _isBool)
+				s.Set("valid", &mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _a = a[0]
+						mml.Nop(_a)
+						return true
+					},
+					FixedArgs: 1,
+				})
+				return s
+			}())
+			s.Set("isInt", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("f", _isInt)
+				s.Set("valid", &mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _a = a[0]
+						mml.Nop(_a)
+						return true
+					},
+					FixedArgs: 1,
+				})
+				return s
+			}())
+			s.Set("isFloat", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("f", _isFloat)
+				s.Set("valid", &mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _a = a[0]
+						mml.Nop(_a)
+						return true
+					},
+					FixedArgs: 1,
+				})
+				return s
+			}())
+			s.Set("isString", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("f", _isString)
+				s.Set("valid", &mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _a = a[0]
+						mml.Nop(_a)
+						return true
+					},
+					FixedArgs: 1,
+				})
+				return s
+			}())
+			s.Set("isList", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("f", _isList)
+				s.Set("valid", &mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _a = a[0]
+						mml.Nop(_a)
+						return true
+					},
+					FixedArgs: 1,
+				})
+				return s
+			}())
+			s.Set("isStruct", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("f", _isStruct)
+				s.Set("valid", &mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _a = a[0]
+						mml.Nop(_a)
+						return true
+					},
+					FixedArgs: 1,
+				})
+				return s
+			}())
+			s.Set("isFunction", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("f", _isFunction)
+				s.Set("valid", &mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _a = a[0]
+						mml.Nop(_a)
+						return true
+					},
+					FixedArgs: 1,
+				})
+				return s
+			}())
+			s.Set("isChannel", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("f", _isChannel)
+				s.Set("valid", &mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _a = a[0]
+						mml.Nop(_a)
+						return true
+					},
+					FixedArgs: 1,
+				})
+				return s
+			}())
+			s.Set("int", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("f", _int)
+				s.Set("valid", &mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _a = a[0]
+						mml.Nop(_a)
+						return mml.BinaryOp(12, direct_kind(mml.Ref(_a, 0)), "")
+					},
+					FixedArgs: 1,
+				})
+				return s
+			}())
+			s.Set("float", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("f", _float)
+				s.Set("valid", &mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _a = a[0]
+						mml.Nop(_a)
+						return mml.BinaryOp(12, direct_kind(mml.Ref(_a, 0)), "")
+					},
+					FixedArgs: 1,
+				})
+				return s
+			}())
+			s.Set("bool", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("f", _bool)
+				s.Set("valid", &mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _a = a[0]
+						mml.Nop(_a)
+						return mml.BinaryOp(12, direct_kind(mml.Ref(_a, 0)), "")
+					},
+					FixedArgs: 1,
+				})
+				return s
+			}())
+			s.Set("string", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("f", _string)
+				s.Set("valid", &mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _a = a[0]
+						mml.Nop(_a)
+						return direct_data(mml.Ref(_a, 0))
+					},
+					FixedArgs: 1,
+				})
+				return s
+			}())
+			s.Set("format", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("f", _format)
+				s.Set("valid", &mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _a = a[0]
+						mml.Nop(_a)
+						return ((_isString.(*mml.Function).Call([]interface{}{mml.Ref(_a, 0)}).(bool) && _isList.(*mml.Function).Call([]interface{}{mml.Ref(_a, 1)}).(bool)) && _every.(*mml.Function).Call([]interface{}{_data, mml.Ref(_a, 1)}).(bool))
+					},
+					FixedArgs: 1,
+				})
+				return s
+			}())
+			s.Set("parseInt", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("f", _parseInt)
+				s.Set("valid", &mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _a = a[0]
+						mml.Nop(_a)
+						return _isString.(*mml.Function).Call([]interface{}{mml.Ref(_a, 0)})
+					},
+					FixedArgs: 1,
+				})
+				return s
+			}())
+			s.Set("parseFloat", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("f", _parseFloat)
+				s.Set("valid", &mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _a = a[0]
+						mml.Nop(_a)
+						return _isString.(*mml.Function).Call([]interface{}{mml.Ref(_a, 0)})
+					},
+					FixedArgs: 1,
+				})
+				return s
+			}())
+			s.Set("stringJoin", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("f", _stringJoin)
+				s.Set("valid", &mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _a = a[0]
+						mml.Nop(_a)
+						return ((_isString.(*mml.Function).Call([]interface{}{mml.Ref(_a, 0)}).(bool) && _isList.(*mml.Function).Call([]interface{}{mml.Ref(_a, 1)}).(bool)) && _every.(*mml.Function).Call([]interface{}{_isString, mml.Ref(_a, 1)}).(bool))
+					},
+					FixedArgs: 1,
+				})
+				return s
+			}())
+			s.Set("stringEscape", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("f", _stringEscape)
+				s.Set("valid", &mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _a = a[0]
+						mml.Nop(_a)
+						return _isString.(*mml.Function).Call([]interface{}{mml.Ref(_a, 0)})
+					},
+					FixedArgs: 1,
+				})
+				return s
+			}())
+			s.Set("stringUnescape", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("f", _stringUnescape)
+				s.Set("valid", &mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _a = a[0]
+						mml.Nop(_a)
+						return _isString.(*mml.Function).Call([]interface{}{mml.Ref(_a, 0)})
+					},
+					FixedArgs: 1,
+				})
+				return s
+			}())
+			s.Set("stringSplit", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("f", _stringSplit)
+				s.Set("valid", &mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _a = a[0]
+						mml.Nop(_a)
+						return (_isString.(*mml.Function).Call([]interface{}{mml.Ref(_a, 0)}).(bool) && _isString.(*mml.Function).Call([]interface{}{mml.Ref(_a, 1)}).(bool))
+					},
+					FixedArgs: 1,
+				})
+				return s
+			}())
+			s.Set("stringHash", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("f", _stringHash)
+				s.Set("valid", &mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _a = a[0]
+						mml.Nop(_a)
+						return _isString.(*mml.Function).Call([]interface{}{mml.Ref(_a, 0)})
+					},
+					FixedArgs: 1,
+				})
+				return s
+			}())
+			return s
+		}()
+		_fixedArgs = func() interface{} {
+			s := &mml.Struct{}
+			s.Set("len", 1)
+			s.Set("keys", 1)
+			s.Set("has", 2)
+			s.Set("isError", 1)
+			s.Set("isBool", 1)
+			s.Set("isInt", 1)
+			s.Set("isFloat", 1)
+			s.Set("isString", 1)
+			s.Set("isList", 1)
+			s.Set("isStruct", 1)
+			s.Set("isFunction", 1)
+			s.Set("isChannel", 1)
+			s.Set("int", 1)
+			s.Set("float", 1)
+			s.Set("bool", 1)
+			s.Set("string", 1)
+			s.Set("format", 2)
+			s.Set("parseInt", 1)
+			s.Set("parseFloat", 1)
+			s.Set("stringJoin", 2)
+			s.Set("stringEscape", 1)
+			s.Set("stringUnescape", 1)
+			s.Set("stringSplit", 2)
+			s.Set("stringHash", 1)
+			s.Set("listFold", 3)
+			s.Set("listFoldr", 3)
+			s.Set("listMap", 2)
+			s.Set("listFilter", 2)
+			return s
+		}()
+		direct_callBuiltin = func(_st, _name, _args interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			switch _name {
+			case "listFold":
+
+				mml.Nop()
+				return direct_listFold(_st, mml.Ref(_args, 0), mml.Ref(_args, 1), mml.Ref(_args, 2))
+			case "listFoldr":
+
+				mml.Nop()
+				return direct_listFold(_st, mml.Ref(_args, 0), mml.Ref(_args, 1), func() interface{} {
+					c = _isList.(*mml.Function).Call([]interface{}{mml.Ref(_args, 2)})
+					if c.(bool) {
+						return direct_reverse(mml.Ref(_args, 2))
+					} else {
+						return mml.Ref(_args, 2)
+					}
+				}())
+			case "listMap":
+
+				mml.Nop()
+				return direct_listMap(_st, mml.Ref(_args, 0), mml.Ref(_args, 1))
+			case "listFilter":
+
+				mml.Nop()
+				return direct_listFilter(_st, mml.Ref(_args, 0), mml.Ref(_args, 1))
+			default:
+
+				mml.Nop()
+				return func() interface{} {
+					c = mml.Ref(mml.Ref(_builtins, _name), "valid").(*mml.Function).Call([]interface{}{_args})
+					if c.(bool) {
+						return mml.Ref(mml.Ref(_builtins, _name), "f").(*mml.Function).Call(append([]interface{}{}, _args.(*mml.List).Values()...))
+					} else {
+						return direct_fail(_st, "invalid argument")
+					}
+				}()
+			}
+		}
+		_callBuiltin = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_callBuiltin(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_reverse = func(_l interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _item = a[0]
+					var _r = a[1]
+					mml.Nop(_item, _r)
+					return (&mml.List{}).Append(_item).Concat(_r.(*mml.List))
+				},
+				FixedArgs: 2,
+			}, (&mml.List{}), _l})
+		}
+		_reverse = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_reverse(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_listFold = func(_st, _f, _init, _l interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _result interface{}
+			mml.Nop(_result)
+			if !_isFunction.(*mml.Function).Call([]interface{}{_f}).(bool) || !_isList.(*mml.Function).Call([]interface{}{_l}).(bool) {
+				mml.Nop()
+				return direct_fail(_st, "invalid argument")
+			}
+			_result = _init
+			for _, _item := range _l.(*mml.List).Values() {
+
+				mml.Nop()
+				_result = _f.(*mml.Function).Call([]interface{}{_item, _result})
+				if v := _result; mml.IsError.F([]interface{}{v}).(bool) {
+					return v
+				}
+			}
+			return _result
+		}
+		_listFold = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_listFold(a[0], a[1], a[2], a[3])
+			},
+			FixedArgs: 4,
+		}
+		direct_listMap = func(_st, _f, _l interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _result interface{}
+			mml.Nop(_result)
+			if !_isFunction.(*mml.Function).Call([]interface{}{_f}).(bool) || !_isList.(*mml.Function).Call([]interface{}{_l}).(bool) {
+				mml.Nop()
+				return direct_fail(_st, "invalid argument")
+			}
+			_result = (&mml.List{})
+			for _, _item := range _l.(*mml.List).Values() {
+				var _v interface{}
+				mml.Nop(_v)
+				_v = _f.(*mml.Function).Call([]interface{}{_item})
+				if v := _v; mml.IsError.F([]interface{}{v}).(bool) {
+					return v
+				}
+				_result = (&mml.List{}).Concat(_result.(*mml.List)).Append(_v)
+			}
+			return _result
+		}
+		_listMap = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_listMap(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_listFilter = func(_st, _f, _l interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _result interface{}
+			mml.Nop(_result)
+			if !_isFunction.(*mml.Function).Call([]interface{}{_f}).(bool) || !_isList.(*mml.Function).Call([]interface{}{_l}).(bool) {
+				mml.Nop()
+				return direct_fail(_st, "invalid argument")
+			}
+			_result = (&mml.List{})
+			for _, _item := range _l.(*mml.List).Values() {
+				var _keep interface{}
+				mml.Nop(_keep)
+				_keep = _f.(*mml.Function).Call([]interface{}{_item})
+				if v := _keep; mml.IsError.F([]interface{}{v}).(bool) {
+					return v
+				}
+				if !_isBool.(*mml.Function).Call([]interface{}{_keep}).(bool) {
+					mml.Nop()
+					return direct_fail(_st, "invalid predicate")
+				}
+				c = _keep
+				if c.(bool) {
+					mml.Nop()
+					_result = (&mml.List{}).Concat(_result.(*mml.List)).Append(_item)
+				}
+			}
+			return _result
+		}
+		_listFilter = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_listFilter(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_partial = func(_arity, _args, _call interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _more interface{}
+					_more = mml.NewList(a[0:])
+					mml.Nop(_more)
+					var _all interface{}
+					mml.Nop(_all)
+					_all = (&mml.List{}).Concat(_args.(*mml.List)).Concat(_more.(*mml.List))
+					return func() interface{} {
+						c = mml.BinaryOp(13, _len.(*mml.Function).Call([]interface{}{_all}), _arity)
+						if c.(bool) {
+							return direct_partial(_arity, _all, _call)
+						} else {
+							return _call.(*mml.Function).Call([]interface{}{_all})
+						}
+					}()
+				},
+				FixedArgs: 0,
+			}
+		}
+		_partial = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_partial(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_builtin = func(_st, _name interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			if !_has.(*mml.Function).Call([]interface{}{_name, mml.Ref(_st, "builtins")}).(bool) {
+				mml.Nop()
+				mml.SetRef(mml.Ref(_st, "builtins"), _name, direct_partial(mml.Ref(_fixedArgs, _name), (&mml.List{}), _callBuiltin.(*mml.Function).Call([]interface{}{_st, _name})))
+			}
+			return mml.Ref(mml.Ref(_st, "builtins"), _name)
+		}
+		_builtin = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_builtin(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_unary = func(_st, _op, _arg interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			switch {
+			case (mml.BinaryOp(11, _op, mml.Ref(_code, "logicalNot")).(bool) && _isBool.(*mml.Function).Call([]interface{}{_arg}).(bool)):
+
+				mml.Nop()
+				return !_arg.(bool)
+			case (mml.BinaryOp(11, _op, mml.Ref(_code, "plus")).(bool) && (_isInt.(*mml.Function).Call([]interface{}{_arg}).(bool) || _isFloat.(*mml.Function).Call([]interface{}{_arg}).(bool))):
+
+				mml.Nop()
+				return _arg
+			case (mml.BinaryOp(11, _op, mml.Ref(_code, "minus")).(bool) && (_isInt.(*mml.Function).Call([]interface{}{_arg}).(bool) || _isFloat.(*mml.Function).Call([]interface{}{_arg}).(bool))):
+
+				mml.Nop()
+				return mml.UnaryOp(2, _arg)
+			default:
+
+				mml.Nop()
+				return direct_fail(_st, "unsupported unary operation")
+			}
+		}
+		_unary = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_unary(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_binary = func(_st, _op, _left, _right interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _k interface{}
+			var _same bool
+			var _ints bool
+			var _nums bool
+			var _ords bool
+			mml.Nop(_k, _same, _ints, _nums, _ords)
+			_k = direct_kind(_left)
+			_same = (mml.BinaryOp(12, _k, "").(bool) && mml.BinaryOp(11, _k, direct_kind(_right)).(bool))
+			_ints = (_same && mml.BinaryOp(11, _k, "int").(bool))
+			_nums = (_same && (mml.BinaryOp(11, _k, "int").(bool) || mml.BinaryOp(11, _k, "float").(bool)))
+			_ords = (_same && mml.BinaryOp(12, _k, "bool").(bool))
+			switch {
+			case mml.BinaryOp(11, _op, mml.Ref(_code, "equals")):
+
+				mml.Nop()
+				return mml.BinaryOp(11, _left, _right)
+			case mml.BinaryOp(11, _op, mml.Ref(_code, "notEq")):
+
+				mml.Nop()
+				return mml.BinaryOp(12, _left, _right)
+			case (mml.BinaryOp(11, _op, mml.Ref(_code, "add")).(bool) && _ords):
+
+				mml.Nop()
+				return mml.BinaryOp(9, _left, _right)
+			case (mml.BinaryOp(11, _op, mml.Ref(_code, "sub")).(bool) && _nums):
+
+				mml.Nop()
+				return mml.BinaryOp(10, _left, _right)
+			case (mml.BinaryOp(11, _op, mml.Ref(_code, "mul")).(bool) && _nums):
+
+				mml.Nop()
+				return mml.BinaryOp(6, _left, _right)
+			case ((mml.BinaryOp(11, _op, mml.Ref(_code, "div")).(bool) && _nums) && !(_ints && mml.BinaryOp(11, _right, 0).(bool))):
+
+				mml.Nop()
+				return mml.BinaryOp(7, _left, _right)
+			case ((mml.BinaryOp(11, _op, mml.Ref(_code, "mod")).(bool) && _ints) && mml.BinaryOp(12, _right, 0).(bool)):
+
+				mml.Nop()
+				return mml.BinaryOp(8, _left, _right)
+			case (mml.BinaryOp(11, _op, mml.Ref(_code, "binaryAnd")).(bool) && _ints):
+
+				mml.Nop()
+				return mml.BinaryOp(0, _left, _right)
+			case (mml.BinaryOp(11, _op, mml.Ref(_code, "xor")).(bool) && _ints):
+
+				mml.Nop()
+				return mml.BinaryOp(2, _left, _right)
+			case (mml.BinaryOp(11, _op, mml.Ref(_code, "andNot")).(bool) && _ints):
+
+				mml.Nop()
+				return mml.BinaryOp(3, _left, _right)
+			case (mml.BinaryOp(11, _op, mml.Ref(_code, "less")).(bool) && _ords):
+
+				mml.Nop()
+				return mml.BinaryOp(13, _left, _right)
+			case (mml.BinaryOp(11, _op, mml.Ref(_code, "lessOrEq")).(bool) && _ords):
+
+				mml.Nop()
+				return mml.BinaryOp(14, _left, _right)
+			case (mml.BinaryOp(11, _op, mml.Ref(_code, "greater")).(bool) && _ords):
+
+				mml.Nop()
+				return mml.BinaryOp(15, _left, _right)
+			case (mml.BinaryOp(11, _op, mml.Ref(_code, "greaterOrEq")).(bool) && _ords):
+
+				mml.Nop()
+				return mml.BinaryOp(16, _left, _right)
+			default:
+
+				mml.Nop()
+				return direct_fail(_st, "unsupported binary operation")
+			}
+		}
+		_binary = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_binary(a[0], a[1], a[2], a[3])
+			},
+			FixedArgs: 4,
+		}
+		direct_logical = func(_st, _env, _b interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _left interface{}
+			var _right interface{}
+			mml.Nop(_left, _right)
+			_left = direct_eval(_st, _env, mml.Ref(_b, "left"))
+			if v := _left; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			if !_isBool.(*mml.Function).Call([]interface{}{_left}).(bool) {
+				mml.Nop()
+				return direct_fail(_st, "invalid logical operand")
+			}
+			if (mml.BinaryOp(11, mml.Ref(_b, "op"), mml.Ref(_code, "logicalAnd")).(bool) && !_left.(bool)) || (mml.BinaryOp(11, mml.Ref(_b, "op"), mml.Ref(_code, "logicalOr")).(bool) && _left.(bool)) {
+				mml.Nop()
+				return _left
+			}
+			_right = direct_eval(_st, _env, mml.Ref(_b, "right"))
+			if v := _right; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			return func() interface{} {
+				c = _isBool.(*mml.Function).Call([]interface{}{_right})
+				if c.(bool) {
+					return _right
+				} else {
+					return direct_fail(_st, "invalid logical operand")
+				}
+			}()
+		}
+		_logical = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_logical(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_condition = func(_st, _env, _c interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _v interface{}
+			mml.Nop(_v)
+			_v = direct_eval(_st, _env, _c)
+			if v := _v; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			return func() interface{} {
+				c = _isBool.(*mml.Function).Call([]interface{}{_v})
+				if c.(bool) {
+					return _v
+				} else {
+					return direct_fail(_st, "invalid condition")
+				}
+			}()
+		}
+		_condition = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_condition(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_values = func(_st, _env, _items interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _result interface{}
+			mml.Nop(_result)
+			_result = (&mml.List{})
+			for _, _item := range _items.(*mml.List).Values() {
+				var _v interface{}
+				mml.Nop(_v)
+				c = _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "spread"); ; return s }(), _item})
+				if c.(bool) {
+					var _l interface{}
+					mml.Nop(_l)
+					_l = direct_eval(_st, _env, mml.Ref(_item, "value"))
+					if v := _l; mml.IsError.F([]interface{}{v}).(bool) {
+						return v
+					}
+					if !_isList.(*mml.Function).Call([]interface{}{_l}).(bool) {
+						mml.Nop()
+						return direct_fail(_st, "invalid spread")
+					}
+					_result = (&mml.List{}).Concat(_result.(*mml.List)).Concat(_l.(*mml.List))
+					continue
+				}
+				_v = direct_eval(_st, _env, _item)
+				if v := _v; mml.IsError.F([]interface{}{v}).(bool) {
+					return v
+				}
+				_result = (&mml.List{}).Concat(_result.(*mml.List)).Append(_v)
+			}
+			return _result
+		}
+		_values = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_values(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_struct = func(_st, _env, _s interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _result interface{}
+			mml.Nop(_result)
+			_result = func() interface{} { s := &mml.Struct{}; ; return s }()
+			for _, _e := range mml.Ref(_s, "entries").(*mml.List).Values() {
+				var _key interface{}
+				var _v interface{}
+				mml.Nop(_key, _v)
+				c = _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "spread"); ; return s }(), _e})
+				if c.(bool) {
+					var _v interface{}
+					mml.Nop(_v)
+					_v = direct_eval(_st, _env, mml.Ref(_e, "value"))
+					if v := _v; mml.IsError.F([]interface{}{v}).(bool) {
+						return v
+					}
+					if !_isStruct.(*mml.Function).Call([]interface{}{_v}).(bool) {
+						mml.Nop()
+						return direct_fail(_st, "invalid spread")
+					}
+					_result = func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_result.(*mml.Struct))
+						s.Merge(_v.(*mml.Struct))
+						return s
+					}()
+					continue
+				}
+				_key = func() interface{} {
+					c = mml.BinaryOp(11, mml.Ref(mml.Ref(_e, "key"), "type"), "symbol")
+					if c.(bool) {
+						return mml.Ref(mml.Ref(_e, "key"), "name")
+					} else {
+						return direct_eval(_st, _env, mml.Ref(_e, "key"))
+					}
+				}()
+				if v := _key; mml.IsError.F([]interface{}{v}).(bool) {
+					return v
+				}
+				if !_isString.(*mml.Function).Call([]interface{}{_key}).(bool) {
+					mml.Nop()
+					return direct_fail(_st, "invalid key")
+				}
+				_v = direct_eval(_st, _env, mml.Ref(_e, "value"))
+				if v := _v; mml.IsError.F([]interface{}{v}).(bool) {
+					return v
+				}
+				_result = func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(_result.(*mml.Struct))
+					s.Set(_key.(string), _v)
+					return s
+				}()
+			}
+			return _result
+		}
+		_struct = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_struct(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_member = func(_st, _env, _i interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _cell interface{}
+			var _exported interface{}
+			var _name interface{}
+			mml.Nop(_cell, _exported, _name)
+			_cell = mml.Ref(_env, mml.Ref(mml.Ref(_i, "expression"), "name"))
+			if !_has.(*mml.Function).Call([]interface{}{mml.Ref(_cell, "module"), mml.Ref(_st, "modules")}).(bool) {
+				mml.Nop()
+				return direct_fail(_st, "unknown module")
+			}
+			_exported = mml.Ref(mml.Ref(_st, "modules"), mml.Ref(_cell, "module"))
+			_name = mml.Ref(mml.Ref(mml.Ref(_i, "index"), "symbol"), "name")
+			return func() interface{} {
+				c = _has.(*mml.Function).Call([]interface{}{_name, _exported})
+				if c.(bool) {
+					return direct_read(_st, mml.Ref(_exported, _name))
+				} else {
+					return direct_fail(_st, "unknown member")
+				}
+			}()
+		}
+		_member = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_member(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_index = func(_st, _env, _i interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _v interface{}
+			var _k interface{}
+			mml.Nop(_v, _k)
+			if (_is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("expression", func() interface{} { s := &mml.Struct{}; s.Set("type", "symbol"); ; return s }())
+				s.Set("index", func() interface{} { s := &mml.Struct{}; s.Set("type", "symbol-index"); ; return s }())
+				return s
+			}(), _i}).(bool) && _has.(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_i, "expression"), "name"), _env}).(bool)) && _has.(*mml.Function).Call([]interface{}{"module", mml.Ref(_env, mml.Ref(mml.Ref(_i, "expression"), "name"))}).(bool) {
+				mml.Nop()
+				return direct_member(_st, _env, _i)
+			}
+			_v = direct_eval(_st, _env, mml.Ref(_i, "expression"))
+			if v := _v; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			switch {
+			case _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "symbol-index"); ; return s }(), mml.Ref(_i, "index")}):
+
+				mml.Nop()
+				return func() interface{} {
+					if _isStruct.(*mml.Function).Call([]interface{}{_v}).(bool) && _has.(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(mml.Ref(_i, "index"), "symbol"), "name"), _v}).(bool) {
+						return mml.Ref(_v, mml.Ref(mml.Ref(mml.Ref(_i, "index"), "symbol"), "name"))
+					} else {
+						return direct_fail(_st, "invalid index")
+					}
+				}()
+			case _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "range"); ; return s }(), mml.Ref(_i, "index")}):
+
+				mml.Nop()
+				return direct_rangeIndex(_st, _env, _v, mml.Ref(_i, "index"))
+			}
+			_k = direct_eval(_st, _env, mml.Ref(_i, "index"))
+			if v := _k; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			switch {
+			case ((_isStruct.(*mml.Function).Call([]interface{}{_v}).(bool) && _isString.(*mml.Function).Call([]interface{}{_k}).(bool)) && _has.(*mml.Function).Call([]interface{}{_k, _v}).(bool)):
+
+				mml.Nop()
+				return mml.Ref(_v, _k)
+			case ((((_isList.(*mml.Function).Call([]interface{}{_v}).(bool) || _isString.(*mml.Function).Call([]interface{}{_v}).(bool)) && _isInt.(*mml.Function).Call([]interface{}{_k}).(bool)) && mml.BinaryOp(16, _k, 0).(bool)) && mml.BinaryOp(13, _k, _len.(*mml.Function).Call([]interface{}{_v})).(bool)):
+
+				mml.Nop()
+				return mml.Ref(_v, _k)
+			default:
+
+				mml.Nop()
+				return direct_fail(_st, "invalid index")
+			}
+		}
+		_index = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_index(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_rangeIndex = func(_st, _env, _v, _r interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _from interface{}
+			var _to interface{}
+			mml.Nop(_from, _to)
+			if !_isList.(*mml.Function).Call([]interface{}{_v}).(bool) && !_isString.(*mml.Function).Call([]interface{}{_v}).(bool) {
+				mml.Nop()
+				return direct_fail(_st, "invalid range")
+			}
+			_from = func() interface{} {
+				c = _has.(*mml.Function).Call([]interface{}{"from", _r})
+				if c.(bool) {
+					return direct_eval(_st, _env, mml.Ref(_r, "from"))
+				} else {
+					return 0
+				}
+			}()
+			if v := _from; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_to = func() interface{} {
+				c = _has.(*mml.Function).Call([]interface{}{"to", _r})
+				if c.(bool) {
+					return direct_eval(_st, _env, mml.Ref(_r, "to"))
+				} else {
+					return _len.(*mml.Function).Call([]interface{}{_v})
+				}
+			}()
+			if v := _to; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			return func() interface{} {
+				if (((_isInt.(*mml.Function).Call([]interface{}{_from}).(bool) && _isInt.(*mml.Function).Call([]interface{}{_to}).(bool)) && mml.BinaryOp(16, _from, 0).(bool)) && mml.BinaryOp(14, _from, _to).(bool)) && mml.BinaryOp(14, _to, _len.(*mml.Function).Call([]interface{}{_v})).(bool) {
+					return mml.RefRange(_v, _from, _to)
+				} else {
+					return direct_fail(_st, "invalid range")
+				}
+			}()
+		}
+		_rangeIndex = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_rangeIndex(a[0], a[1], a[2], a[3])
+			},
+			FixedArgs: 4,
+		}
+		direct_apply = func(_st, _env, _a interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _f interface{}
+			var _args interface{}
+			mml.Nop(_f, _args)
+			_f = direct_eval(_st, _env, mml.Ref(_a, "function"))
+			if v := _f; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			if !_isFunction.(*mml.Function).Call([]interface{}{_f}).(bool) {
+				mml.Nop()
+				return direct_fail(_st, "not a function")
+			}
+			_args = direct_values(_st, _env, mml.Ref(_a, "args"))
+			if v := _args; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			return _f.(*mml.Function).Call(append([]interface{}{}, _args.(*mml.List).Values()...))
+		}
+		_apply = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_apply(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_closure = func(_st, _env, _f interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return direct_partial(_len.(*mml.Function).Call([]interface{}{mml.Ref(_f, "params")}), (&mml.List{}), _call.(*mml.Function).Call([]interface{}{_st, _env, _f}))
+		}
+		_closure = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_closure(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_call = func(_st, _env, _f, _args interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _writes interface{}
+			var _result interface{}
+			mml.Nop(_writes, _result)
+			_writes = mml.Ref(_st, "writes")
+			_result = direct_callBody(_st, _env, _f, _args)
+			return func() interface{} {
+				if (mml.Ref(_f, "effect").(bool) && !_isError.(*mml.Function).Call([]interface{}{_result}).(bool)) && mml.BinaryOp(11, mml.Ref(_st, "writes"), _writes).(bool) {
+					return direct_fail(_st, "unknown effect")
+				} else {
+					return _result
+				}
+			}()
+		}
+		_call = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_call(a[0], a[1], a[2], a[3])
+			},
+			FixedArgs: 4,
+		}
+		direct_callBody = func(_st, _env, _f, _args interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _params interface{}
+			var _callEnv interface{}
+			var _result interface{}
+			mml.Nop(_params, _callEnv, _result)
+			_params = _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _i = a[0]
+					var _e = a[1]
+					mml.Nop(_i, _e)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_e.(*mml.Struct))
+						s.Set(mml.Ref(mml.Ref(_f, "params"), _i).(string), direct_valueCell(mml.Ref(_args, _i)))
+						return s
+					}()
+				},
+				FixedArgs: 2,
+			}, _env, direct_indexes(_len.(*mml.Function).Call([]interface{}{mml.Ref(_f, "params")}))})
+			_callEnv = func() interface{} {
+				c = mml.BinaryOp(11, mml.Ref(_f, "collectParam"), "")
+				if c.(bool) {
+					return _params
+				} else {
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_params.(*mml.Struct))
+						s.Set(mml.Ref(_f, "collectParam").(string), direct_valueCell(mml.RefRange(_args, _len.(*mml.Function).Call([]interface{}{mml.Ref(_f, "params")}), nil)))
+						return s
+					}()
+				}
+			}()
+			if !_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "statement-list"); ; return s }(), mml.Ref(_f, "body")}).(bool) {
+				mml.Nop()
+				return direct_eval(_st, _callEnv, mml.Ref(_f, "body"))
+			}
+			_result = direct_exec(_st, _callEnv, mml.Ref(_f, "body"))
+			if v := _result; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			return func() interface{} {
+				c = _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("control", "return"); ; return s }(), _result})
+				if c.(bool) {
+					return mml.Ref(_result, "value")
+				} else {
+					return direct_none()
+				}
+			}()
+		}
+		_callBody = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_callBody(a[0], a[1], a[2], a[3])
+			},
+			FixedArgs: 4,
+		}
+		direct_indexes = func(_n interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				c = mml.BinaryOp(11, _n, 0)
+				if c.(bool) {
+					return (&mml.List{})
+				} else {
+					return (&mml.List{}).Concat(direct_indexes(mml.BinaryOp(10, _n, 1)).(*mml.List)).Append(mml.BinaryOp(10, _n, 1))
+				}
+			}()
+		}
+		_indexes = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_indexes(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_eval = func(_st, _env, _c interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			a := []interface{}{_st, _env, _c}
+			_ = a
+		tailcall:
+			for {
+				var _st = a[0]
+				var _env = a[1]
+				var _c = a[2]
+				mml.Nop(_st, _env, _c)
+
+				mml.Nop()
+				if v := direct_step(_st); mml.IsError.F([]interface{}{v}).(bool) {
+					return v
+				}
+				switch mml.Ref(_c, "type") {
+				case "int":
+
+					mml.Nop()
+					return mml.Ref(_c, "value")
+				case "float":
+
+					mml.Nop()
+					return mml.Ref(_c, "value")
+				case "string":
+
+					mml.Nop()
+					return mml.Ref(_c, "value")
+				case "bool":
+
+					mml.Nop()
+					return mml.Ref(_c, "value")
+				case "symbol":
+
+					mml.Nop()
+					switch {
+					case _has.(*mml.Function).Call([]interface{}{mml.Ref(_c, "name"), _env}):
+
+						mml.Nop()
+						return direct_read(_st, mml.Ref(_env, mml.Ref(_c, "name")))
+					case _has.(*mml.Function).Call([]interface{}{mml.Ref(_c, "name"), _fixedArgs}):
+
+						mml.Nop()
+						return direct_builtin(_st, mml.Ref(_c, "name"))
+					default:
+
+						mml.Nop()
+						return direct_fail(_st, "unknown symbol")
+					}
+				case "list":
+
+					mml.Nop()
+					return func() interface{} {
+						c = mml.Ref(_c, "mutable")
+						if c.(bool) {
+							return direct_fail(_st, "mutable value")
+						} else {
+							return direct_values(_st, _env, mml.Ref(_c, "values"))
+						}
+					}()
+				case "struct":
+
+					mml.Nop()
+					return func() interface{} {
+						c = mml.Ref(_c, "mutable")
+						if c.(bool) {
+							return direct_fail(_st, "mutable value")
+						} else {
+							return direct_struct(_st, _env, _c)
+						}
+					}()
+				case "expression-key":
+
+					mml.Nop()
+					a = []interface{}{_st, _env, mml.Ref(_c, "value")}
+					continue tailcall
+				case "function":
+
+					mml.Nop()
+					return direct_closure(_st, _env, _c)
+				case "indexer":
+
+					mml.Nop()
+					return direct_index(_st, _env, _c)
+				case "application":
+
+					mml.Nop()
+					return direct_apply(_st, _env, _c)
+				case "unary":
+					var _arg interface{}
+					mml.Nop(_arg)
+					_arg = direct_eval(_st, _env, mml.Ref(_c, "arg"))
+					if v := _arg; mml.IsError.F([]interface{}{v}).(bool) {
+						return v
+					}
+					return direct_unary(_st, mml.Ref(_c, "op"), _arg)
+				case "binary":
+					var _left interface{}
+					var _right interface{}
+					mml.Nop(_left, _right)
+					if mml.BinaryOp(11, mml.Ref(_c, "op"), mml.Ref(_code, "logicalAnd")).(bool) || mml.BinaryOp(11, mml.Ref(_c, "op"), mml.Ref(_code, "logicalOr")).(bool) {
+						mml.Nop()
+						return direct_logical(_st, _env, _c)
+					}
+					_left = direct_eval(_st, _env, mml.Ref(_c, "left"))
+					if v := _left; mml.IsError.F([]interface{}{v}).(bool) {
+						return v
+					}
+					_right = direct_eval(_st, _env, mml.Ref(_c, "right"))
+					if v := _right; mml.IsError.F([]interface{}{v}).(bool) {
+						return v
+					}
+					return direct_binary(_st, mml.Ref(_c, "op"), _left, _right)
+				case "cond":
+					var _cond interface{}
+					mml.Nop(_cond)
+					if !mml.Ref(_c, "ternary").(bool) {
+						mml.Nop()
+						return direct_fail(_st, "unsupported expression")
+					}
+					_cond = direct_condition(_st, _env, mml.Ref(_c, "condition"))
+					if v := _cond; mml.IsError.F([]interface{}{v}).(bool) {
+						return v
+					}
+					c = _cond
+					if c.(bool) {
+						a = []interface{}{_st, _env, mml.Ref(_c, "consequent")}
+						continue tailcall
+					} else {
+						a = []interface{}{_st, _env, mml.Ref(_c, "alternative")}
+						continue tailcall
+					}
+				default:
+
+					mml.Nop()
+					return direct_fail(_st, "unsupported expression")
+				}
+			}
+		}
+		_eval = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_eval(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_define = func(_st, _env, _d interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _v interface{}
+			mml.Nop(_v)
+			_v = direct_eval(_st, _env, mml.Ref(_d, "expression"))
+			if v := _v; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			direct_write(_st, mml.Ref(_env, mml.Ref(_d, "symbol")), _v)
+			return _normal
+		}
+		_define = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_define(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_assign = func(_st, _env, _a interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _v interface{}
+			mml.Nop(_v)
+			if (!_is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("capture", func() interface{} { s := &mml.Struct{}; s.Set("type", "symbol"); ; return s }())
+				return s
+			}(), _a}).(bool) || !_has.(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_a, "capture"), "name"), _env}).(bool)) || !mml.Ref(mml.Ref(_env, mml.Ref(mml.Ref(_a, "capture"), "name")), "mutable").(bool) {
+				mml.Nop()
+				return direct_fail(_st, "unsupported assignment")
+			}
+			_v = direct_eval(_st, _env, mml.Ref(_a, "value"))
+			if v := _v; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			direct_write(_st, mml.Ref(_env, mml.Ref(mml.Ref(_a, "capture"), "name")), _v)
+			mml.SetRef(_st, "writes", mml.BinaryOp(9, mml.Ref(_st, "writes"), 1))
+			return _normal
+		}
+		_assign = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_assign(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_statementList = func(_st, _env, _l interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _scopeEnv interface{}
+			mml.Nop(_scopeEnv)
+			_scopeEnv = _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _name = a[0]
+					var _e = a[1]
+					mml.Nop(_name, _e)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_e.(*mml.Struct))
+						s.Set(_name.(string), direct_newCell(mml.Ref(_st, "owner"), false))
+						return s
+					}()
+				},
+				FixedArgs: 2,
+			}, _env, mml.Ref(_code, "getScope").(*mml.Function).Call([]interface{}{_l})})
+			for _, _d := range mml.Ref(_code, "getDefinitions").(*mml.Function).Call([]interface{}{_l}).(*mml.List).Values() {
+
+				mml.Nop()
+				mml.SetRef(mml.Ref(_scopeEnv, mml.Ref(_d, "symbol")), "mutable", mml.Ref(_d, "mutable"))
+			}
+			for _, _s := range mml.Ref(_l, "statements").(*mml.List).Values() {
+				var _result interface{}
+				mml.Nop(_result)
+				_result = direct_exec(_st, _scopeEnv, _s)
+				if v := _result; mml.IsError.F([]interface{}{v}).(bool) {
+					return v
+				}
+				c = _has.(*mml.Function).Call([]interface{}{"control", _result})
+				if c.(bool) {
+					mml.Nop()
+					return _result
+				}
+			}
+			return _normal
+		}
+		_statementList = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_statementList(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_ifStatement = func(_st, _env, _c interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _cond interface{}
+			mml.Nop(_cond)
+			_cond = direct_condition(_st, _env, mml.Ref(_c, "condition"))
+			if v := _cond; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			switch {
+			case _cond:
+
+				mml.Nop()
+				return direct_exec(_st, _env, mml.Ref(_c, "consequent"))
+			case _has.(*mml.Function).Call([]interface{}{"alternative", _c}):
+
+				mml.Nop()
+				return direct_exec(_st, _env, mml.Ref(_c, "alternative"))
+			default:
+
+				mml.Nop()
+				return _normal
+			}
+		}
+		_ifStatement = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_ifStatement(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_switchStatement = func(_st, _env, _s interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _value interface{}
+			var _result interface{}
+			mml.Nop(_value, _result)
+			_value = func() interface{} {
+				c = _has.(*mml.Function).Call([]interface{}{"expression", _s})
+				if c.(bool) {
+					return direct_eval(_st, _env, mml.Ref(_s, "expression"))
+				} else {
+					return true
+				}
+			}()
+			if v := _value; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			for _, _c := range mml.Ref(_s, "cases").(*mml.List).Values() {
+				var _v interface{}
+				mml.Nop(_v)
+				_v = direct_eval(_st, _env, mml.Ref(_c, "expression"))
+				if v := _v; mml.IsError.F([]interface{}{v}).(bool) {
+					return v
+				}
+				if !_has.(*mml.Function).Call([]interface{}{"expression", _s}).(bool) && !_isBool.(*mml.Function).Call([]interface{}{_v}).(bool) {
+					mml.Nop()
+					return direct_fail(_st, "invalid condition")
+				}
+				c = mml.BinaryOp(11, _v, _value)
+				if c.(bool) {
+					var _result interface{}
+					mml.Nop(_result)
+					_result = direct_exec(_st, _env, mml.Ref(_c, "body"))
+					if v := _result; mml.IsError.F([]interface{}{v}).(bool) {
+						return v
+					}
+					return func() interface{} {
+						c = _is.(*mml.Function).Call([]interface{}{_breaking, _result})
+						if c.(bool) {
+							return _normal
+						} else {
+							return _result
+						}
+					}()
+				}
+			}
+			_result = direct_exec(_st, _env, mml.Ref(_s, "defaultStatements"))
+			if v := _result; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			return func() interface{} {
+				c = _is.(*mml.Function).Call([]interface{}{_breaking, _result})
+				if c.(bool) {
+					return _normal
+				} else {
+					return _result
+				}
+			}()
+		}
+		_switchStatement = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_switchStatement(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_loop = func(_st, _env, _l interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _items interface{}
+			var _from interface{}
+			var _to interface{}
+			var _i interface{}
+			var _rangeOver interface{}
+			var _hasSymbol bool
+			var _overList bool
+			var _overRange bool
+			mml.Nop(_items, _from, _to, _i, _rangeOver, _hasSymbol, _overList, _overRange)
+			_rangeOver = _is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("expression", func() interface{} { s := &mml.Struct{}; s.Set("type", "range-over"); ; return s }())
+				return s
+			}(), _l})
+			_hasSymbol = (_rangeOver.(bool) && _has.(*mml.Function).Call([]interface{}{"symbol", mml.Ref(_l, "expression")}).(bool))
+			_overList = ((_rangeOver.(bool) && _has.(*mml.Function).Call([]interface{}{"expression", mml.Ref(_l, "expression")}).(bool)) && !_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "range"); ; return s }(), mml.Ref(mml.Ref(_l, "expression"), "expression")}).(bool))
+			_overRange = (_rangeOver.(bool) && _is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("expression", func() interface{} { s := &mml.Struct{}; s.Set("type", "range"); ; return s }())
+				return s
+			}(), mml.Ref(_l, "expression")}).(bool))
+			_items = (&mml.List{})
+			if _overList {
+				var _v interface{}
+				mml.Nop(_v)
+				_v = direct_eval(_st, _env, mml.Ref(mml.Ref(_l, "expression"), "expression"))
+				if v := _v; mml.IsError.F([]interface{}{v}).(bool) {
+					return v
+				}
+				if !_isList.(*mml.Function).Call([]interface{}{_v}).(bool) {
+					mml.Nop()
+					return direct_fail(_st, "invalid range")
+				}
+				_items = _v
+			}
+			_from = 0
+			_to = 0
+			if _overRange && _has.(*mml.Function).Call([]interface{}{"from", mml.Ref(mml.Ref(_l, "expression"), "expression")}).(bool) {
+				var _v interface{}
+				mml.Nop(_v)
+				_v = direct_eval(_st, _env, mml.Ref(mml.Ref(mml.Ref(_l, "expression"), "expression"), "from"))
+				if v := _v; mml.IsError.F([]interface{}{v}).(bool) {
+					return v
+				}
+				_from = _v
+			}
+			if _overRange && _has.(*mml.Function).Call([]interface{}{"to", mml.Ref(mml.Ref(_l, "expression"), "expression")}).(bool) {
+				var _v interface{}
+				mml.Nop(_v)
+				_v = direct_eval(_st, _env, mml.Ref(mml.Ref(mml.Ref(_l, "expression"), "expression"), "to"))
+				if v := _v; mml.IsError.F([]interface{}{v}).(bool) {
+					return v
+				}
+				_to = _v
+			}
+			if !_isInt.(*mml.Function).Call([]interface{}{_from}).(bool) || !_isInt.(*mml.Function).Call([]interface{}{_to}).(bool) {
+				mml.Nop()
+				return direct_fail(_st, "invalid range")
+			}
+			_i = func() interface{} {
+				if _overList {
+					return 0
+				} else {
+					return _from
+				}
+			}()
+			for {
+				var _value interface{}
+				var _bodyEnv interface{}
+				var _result interface{}
+				mml.Nop(_value, _bodyEnv, _result)
+				if v := direct_step(_st); mml.IsError.F([]interface{}{v}).(bool) {
+					return v
+				}
+				switch {
+				case (_overList && mml.BinaryOp(16, _i, _len.(*mml.Function).Call([]interface{}{_items})).(bool)):
+
+					mml.Nop()
+					return _normal
+				case ((_overRange && _has.(*mml.Function).Call([]interface{}{"to", mml.Ref(mml.Ref(_l, "expression"), "expression")}).(bool)) && mml.BinaryOp(16, _i, _to).(bool)):
+
+					mml.Nop()
+					return _normal
+				case (_has.(*mml.Function).Call([]interface{}{"expression", _l}).(bool) && !_rangeOver.(bool)):
+					var _cond interface{}
+					mml.Nop(_cond)
+					_cond = direct_condition(_st, _env, mml.Ref(_l, "expression"))
+					if v := _cond; mml.IsError.F([]interface{}{v}).(bool) {
+						return v
+					}
+					if !_cond.(bool) {
+						mml.Nop()
+						return _normal
+					}
+				}
+				_value = func() interface{} {
+					if _overList {
+						return mml.Ref(_items, _i)
+					} else {
+						return _i
+					}
+				}()
+				_bodyEnv = func() interface{} {
+					if _hasSymbol {
+						return func() interface{} {
+							s := &mml.Struct{}
+							s.Merge(_env.(*mml.Struct))
+							s.Set(mml.Ref(mml.Ref(_l, "expression"), "symbol").(string), direct_valueCell(_value))
+							return s
+						}()
+					} else {
+						return _env
+					}
+				}()
+				_result = direct_exec(_st, _bodyEnv, mml.Ref(_l, "body"))
+				if v := _result; mml.IsError.F([]interface{}{v}).(bool) {
+					return v
+				}
+				c = _is.(*mml.Function).Call([]interface{}{_breaking, _result})
+				if c.(bool) {
+					mml.Nop()
+					return _normal
+				}
+				c = _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("control", "return"); ; return s }(), _result})
+				if c.(bool) {
+					mml.Nop()
+					return _result
+				}
+				_i = mml.BinaryOp(9, _i, 1)
+			}
+		}
+		_loop = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_loop(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_exec = func(_st, _env, _c interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			if v := direct_step(_st); mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			switch mml.Ref(_c, "type") {
+			case "statement-list":
+
+				mml.Nop()
+				return direct_statementList(_st, _env, _c)
+			case "definition":
+
+				mml.Nop()
+				return direct_define(_st, _env, _c)
+			case "definition-group":
+
+				mml.Nop()
+				for _, _d := range mml.Ref(_c, "definitions").(*mml.List).Values() {
+
+					mml.Nop()
+					if v := direct_define(_st, _env, _d); mml.IsError.F([]interface{}{v}).(bool) {
+						return v
+					}
+				}
+				return _normal
+			case "assign":
+
+				mml.Nop()
+				return direct_assign(_st, _env, _c)
+			case "ret":
+				var _v interface{}
+				mml.Nop(_v)
+				if !_has.(*mml.Function).Call([]interface{}{"value", _c}).(bool) {
+					mml.Nop()
+					return direct_returning(direct_none())
+				}
+				_v = direct_eval(_st, _env, mml.Ref(_c, "value"))
+				if v := _v; mml.IsError.F([]interface{}{v}).(bool) {
+					return v
+				}
+				return direct_returning(_v)
+			case "cond":
+				var _v interface{}
+				mml.Nop(_v)
+				if !mml.Ref(_c, "ternary").(bool) {
+					mml.Nop()
+					return direct_ifStatement(_st, _env, _c)
+				}
+				_v = direct_eval(_st, _env, _c)
+				if v := _v; mml.IsError.F([]interface{}{v}).(bool) {
+					return v
+				}
+				return _normal
+			case "switch-statement":
+
+				mml.Nop()
+				return direct_switchStatement(_st, _env, _c)
+			case "loop":
+
+				mml.Nop()
+				return direct_loop(_st, _env, _c)
+			case "break":
+
+				mml.Nop()
+				return _breaking
+			case "continue":
+
+				mml.Nop()
+				return _continuing
+			case "comment":
+
+				mml.Nop()
+				return _normal
+			case "check-ret":
+				var _v interface{}
+				mml.Nop(_v)
+				_v = direct_eval(_st, _env, mml.Ref(_c, "value"))
+				if v := _v; mml.IsError.F([]interface{}{v}).(bool) {
+					return v
+				}
+				return _normal
+			default:
+				var _v interface{}
+				mml.Nop(_v)
+				_v = direct_eval(_st, _env, _c)
+				if v := _v; mml.IsError.F([]interface{}{v}).(bool) {
+					return v
+				}
+				return _normal
+			}
+		}
+		_exec = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_exec(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_uses = func(_m interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _flat.(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{mml.Ref(_structs, "get").(*mml.Function).Call([]interface{}{"uses"})}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "use-list"); ; return s }()})}).(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_m, "body"), "statements")})})})
+		}
+		_uses = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_uses(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_initOrder = func(_modules interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _ordered interface{}
+			var _visit interface{}
+			var _byPath interface{}
+			var _visited interface{}
+			mml.Nop(_ordered, _visit, _byPath, _visited)
+			_byPath = _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _m = a[0]
+					var _p = a[1]
+					mml.Nop(_m, _p)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_p.(*mml.Struct))
+						s.Set(mml.Ref(_m, "path").(string), _m)
+						return s
+					}()
+				},
+				FixedArgs: 2,
+			}, func() interface{} { s := &mml.Struct{}; ; return s }(), _modules})
+			_visited = func() interface{} { s := &mml.Struct{}; ; return s }()
+			_ordered = (&mml.List{})
+			_visit = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _m = a[0]
+					mml.Nop(_m)
+
+					mml.Nop()
+					c = _has.(*mml.Function).Call([]interface{}{mml.Ref(_m, "path"), _visited})
+					if c.(bool) {
+						mml.Nop()
+						return nil
+					}
+					mml.SetRef(_visited, mml.Ref(_m, "path"), true)
+					for _, _u := range direct_uses(_m).(*mml.List).Values() {
+
+						mml.Nop()
+						c = _has.(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_u, "path"), "value"), _byPath})
+						if c.(bool) {
+							mml.Nop()
+							_visit.(*mml.Function).Call([]interface{}{mml.Ref(_byPath, mml.Ref(mml.Ref(_u, "path"), "value"))})
+						}
+					}
+					_ordered = (&mml.List{}).Concat(_ordered.(*mml.List)).Append(_m)
+					return nil
+				},
+				FixedArgs: 1,
+			}
+			for _, _m := range _modules.(*mml.List).Values() {
+
+				mml.Nop()
+				_visit.(*mml.Function).Call([]interface{}{_m})
+			}
+			return _ordered
+		}
+		_initOrder = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_initOrder(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_useModule = func(_st, _env, _u interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _path interface{}
+			var _exported interface{}
+			mml.Nop(_path, _exported)
+			_path = mml.Ref(mml.Ref(_u, "path"), "value")
+			if !_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("capture", "."); ; return s }(), _u}).(bool) {
+				var _cell interface{}
+				mml.Nop(_cell)
+				_cell = mml.Ref(_env, func() interface{} {
+					c = _has.(*mml.Function).Call([]interface{}{"capture", _u})
+					if c.(bool) {
+						return mml.Ref(_u, "capture")
+					} else {
+						return mml.Ref(_code, "getModuleName").(*mml.Function).Call([]interface{}{_path})
+					}
+				}())
+				mml.SetRef(_cell, "module", _path)
+				return nil
+			}
+			if !_has.(*mml.Function).Call([]interface{}{_path, mml.Ref(_st, "modules")}).(bool) {
+				mml.Nop()
+				return nil
+			}
+			_exported = mml.Ref(mml.Ref(_st, "modules"), _path)
+			for _, _name := range _keys.(*mml.Function).Call([]interface{}{_exported}).(*mml.List).Values() {
+				var _source interface{}
+				var _cell interface{}
+				mml.Nop(_source, _cell)
+				_source = mml.Ref(_exported, _name)
+				_cell = mml.Ref(_env, _name)
+				c = mml.Ref(_source, "known")
+				if c.(bool) {
+					mml.Nop()
+					mml.SetRef(_cell, "value", mml.Ref(_source, "value"))
+					mml.SetRef(_cell, "known", true)
+					mml.SetRef(_cell, "deps", func() interface{} {
+						c = mml.Ref(_source, "mutable")
+						if c.(bool) {
+							return func() interface{} {
+								s := &mml.Struct{}
+								s.Merge(mml.Ref(_source, "deps").(*mml.Struct))
+								s.Set(mml.Ref(mml.Ref(_source, "owner"), "id").(string), mml.Ref(_source, "owner"))
+								return s
+							}()
+						} else {
+							return mml.Ref(_source, "deps")
+						}
+					}())
+				}
+				c = mml.Ref(_source, "mutable")
+				if c.(bool) {
+					mml.Nop()
+					mml.SetRef(_st, "mutableCells", (&mml.List{}).Concat(mml.Ref(_st, "mutableCells").(*mml.List)).Append(_cell))
+				}
+			}
+			return nil
+		}
+		_useModule = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_useModule(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_literal = func(_c interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			switch mml.Ref(_c, "type") {
+			case "int":
+
+				mml.Nop()
+				return true
+			case "float":
+
+				mml.Nop()
+				return true
+			case "string":
+
+				mml.Nop()
+				return true
+			case "bool":
+
+				mml.Nop()
+				return true
+			case "list":
+
+				mml.Nop()
+				return _every.(*mml.Function).Call([]interface{}{_literal, mml.Ref(_c, "values")})
+			case "struct":
+
+				mml.Nop()
+				return _every.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} {
+					s := &mml.Struct{}
+					s.Set("type", "entry")
+					s.Set("key", func() interface{} {
+						s := &mml.Struct{}
+						s.Set("type", _or.(*mml.Function).Call([]interface{}{"symbol", "string"}))
+						return s
+					}())
+					s.Set("value", _predicate.(*mml.Function).Call([]interface{}{_literal}))
+					return s
+				}()}), mml.Ref(_c, "entries")})
+			default:
+
+				mml.Nop()
+				return false
+			}
+		}
+		_literal = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_literal(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_foldable = func(_d, _v interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return ((((!mml.Ref(_d, "mutable").(bool) && !direct_literal(mml.Ref(_d, "expression")).(bool)) && !(_is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("type", _or.(*mml.Function).Call([]interface{}{"symbol", "indexer"}))
+				return s
+			}(), mml.Ref(_d, "expression")}).(bool) && (_isList.(*mml.Function).Call([]interface{}{_v}).(bool) || _isStruct.(*mml.Function).Call([]interface{}{_v}).(bool)))) && direct_data(_v).(bool)) && mml.BinaryOp(14, direct_size(_v), _maxNodes).(bool))
+		}
+		_foldable = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_foldable(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_evaluateDefinition = func(_st, _env, _path, _d interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _v interface{}
+			var _cell interface{}
+			mml.Nop(_v, _cell)
+			mml.SetRef(_st, "owner", func() interface{} {
+				s := &mml.Struct{}
+				s.Set("id", direct_id(_path, mml.Ref(_d, "symbol")))
+				s.Set("path", _path)
+				s.Set("name", mml.Ref(_d, "symbol"))
+				return s
+			}())
+			mml.SetRef(_st, "steps", 0)
+			mml.SetRef(_st, "failed", false)
+			mml.SetRef(_st, "deps", func() interface{} { s := &mml.Struct{}; ; return s }())
+			_v = direct_eval(_st, _env, mml.Ref(_d, "expression"))
+			if _isError.(*mml.Function).Call([]interface{}{_v}).(bool) || mml.Ref(_st, "failed").(bool) {
+				mml.Nop()
+				return func() interface{} { s := &mml.Struct{}; ; return s }()
+			}
+			_cell = mml.Ref(_env, mml.Ref(_d, "symbol"))
+			mml.SetRef(_cell, "value", _v)
+			mml.SetRef(_cell, "known", true)
+			mml.SetRef(_cell, "deps", mml.Ref(_st, "deps"))
+			return func() interface{} {
+				c = direct_foldable(_d, _v)
+				if c.(bool) {
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Set(mml.Ref(mml.Ref(_st, "owner"), "id").(string), func() interface{} {
+							s := &mml.Struct{}
+							s.Set("path", _path)
+							s.Set("name", mml.Ref(_d, "symbol"))
+							s.Set("value", _v)
+							s.Set("deps", mml.Ref(_st, "deps"))
+							return s
+						}())
+						return s
+					}()
+				} else {
+					return func() interface{} { s := &mml.Struct{}; ; return s }()
+				}
+			}()
+		}
+		_evaluateDefinition = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_evaluateDefinition(a[0], a[1], a[2], a[3])
+			},
+			FixedArgs: 4,
+		}
+		direct_evaluateModule = func(_st, _m interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _folded interface{}
+			var _definitions interface{}
+			var _env interface{}
+			mml.Nop(_folded, _definitions, _env)
+			_definitions = mml.Ref(_code, "getDefinitions").(*mml.Function).Call([]interface{}{mml.Ref(_m, "body")})
+			_env = _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _name = a[0]
+					var _e = a[1]
+					mml.Nop(_name, _e)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_e.(*mml.Struct))
+						s.Set(_name.(string), direct_newCell(func() interface{} {
+							s := &mml.Struct{}
+							s.Set("id", direct_id(mml.Ref(_m, "path"), _name))
+							s.Set("path", mml.Ref(_m, "path"))
+							s.Set("name", _name)
+							return s
+						}(), false))
+						return s
+					}()
+				},
+				FixedArgs: 2,
+			}, func() interface{} { s := &mml.Struct{}; ; return s }(), mml.Ref(_code, "getScope").(*mml.Function).Call([]interface{}{mml.Ref(_m, "body")})})
+			for _, _d := range _definitions.(*mml.List).Values() {
+
+				mml.Nop()
+				mml.SetRef(mml.Ref(_env, mml.Ref(_d, "symbol")), "mutable", mml.Ref(_d, "mutable"))
+				mml.SetRef(mml.Ref(_env, mml.Ref(_d, "symbol")), "owner", func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(mml.Ref(mml.Ref(_env, mml.Ref(_d, "symbol")), "owner").(*mml.Struct))
+					s.Set("exported", mml.Ref(_d, "exported"))
+					return s
+				}())
+				c = mml.Ref(_d, "mutable")
+				if c.(bool) {
+					mml.Nop()
+					mml.SetRef(_st, "mutableCells", (&mml.List{}).Concat(mml.Ref(_st, "mutableCells").(*mml.List)).Append(mml.Ref(_env, mml.Ref(_d, "symbol"))))
+				}
+			}
+			_folded = func() interface{} { s := &mml.Struct{}; ; return s }()
+			for _, _s := range mml.Ref(mml.Ref(_m, "body"), "statements").(*mml.List).Values() {
+
+				mml.Nop()
+				switch mml.Ref(_s, "type") {
+				case "use-list":
+
+					mml.Nop()
+					for _, _u := range mml.Ref(_s, "uses").(*mml.List).Values() {
+
+						mml.Nop()
+						direct_useModule(_st, _env, _u)
+					}
+				case "definition":
+
+					mml.Nop()
+					_folded = func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_folded.(*mml.Struct))
+						s.Merge(direct_evaluateDefinition(_st, _env, mml.Ref(_m, "path"), _s).(*mml.Struct))
+						return s
+					}()
+				case "definition-group":
+
+					mml.Nop()
+					for _, _d := range mml.Ref(_s, "definitions").(*mml.List).Values() {
+
+						mml.Nop()
+						_folded = func() interface{} {
+							s := &mml.Struct{}
+							s.Merge(_folded.(*mml.Struct))
+							s.Merge(direct_evaluateDefinition(_st, _env, mml.Ref(_m, "path"), _d).(*mml.Struct))
+							return s
+						}()
+					}
+				case "comment":
+
+					mml.Nop()
+					continue
+				default:
+
+					mml.Nop()
+					for _, _cell := range mml.Ref(_st, "mutableCells").(*mml.List).Values() {
+
+						mml.Nop()
+						mml.SetRef(_cell, "known", false)
+					}
+				}
+			}
+			mml.SetRef(mml.Ref(_st, "modules"), mml.Ref(_m, "path"), _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _d = a[0]
+					var _e = a[1]
+					mml.Nop(_d, _e)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_e.(*mml.Struct))
+						s.Set(mml.Ref(_d, "symbol").(string), mml.Ref(_env, mml.Ref(_d, "symbol")))
+						return s
+					}()
+				},
+				FixedArgs: 2,
+			}, func() interface{} { s := &mml.Struct{}; ; return s }()}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("exported", true); ; return s }()})}).(*mml.Function).Call([]interface{}{_definitions})}))
+			return _folded
+		}
+		_evaluateModule = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_evaluateModule(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_literalCode = func(_v interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			switch {
+			case _isInt.(*mml.Function).Call([]interface{}{_v}):
+
+				mml.Nop()
+				return func() interface{} { s := &mml.Struct{}; s.Set("type", "int"); s.Set("value", _v); ; return s }()
+			case _isFloat.(*mml.Function).Call([]interface{}{_v}):
+
+				mml.Nop()
+				return func() interface{} { s := &mml.Struct{}; s.Set("type", "float"); s.Set("value", _v); ; return s }()
+			case _isString.(*mml.Function).Call([]interface{}{_v}):
+
+				mml.Nop()
+				return func() interface{} { s := &mml.Struct{}; s.Set("type", "string"); s.Set("value", _v); ; return s }()
+			case _isBool.(*mml.Function).Call([]interface{}{_v}):
+
+				mml.Nop()
+				return func() interface{} { s := &mml.Struct{}; s.Set("type", "bool"); s.Set("value", _v); ; return s }()
+			case _isList.(*mml.Function).Call([]interface{}{_v}):
+
+				mml.Nop()
+				return func() interface{} {
+					s := &mml.Struct{}
+					s.Set("type", "list")
+					s.Set("values", _map.(*mml.Function).Call([]interface{}{_literalCode, _v}))
+					return s
+				}()
+			default:
+
+				mml.Nop()
+				return func() interface{} {
+					s := &mml.Struct{}
+					s.Set("type", "struct")
+					s.Set("entries", _map.(*mml.Function).Call([]interface{}{&mml.Function{
+						F: func(a []interface{}) interface{} {
+							var c interface{}
+							mml.Nop(c)
+							var _k = a[0]
+							mml.Nop(_k)
+							return func() interface{} {
+								s := &mml.Struct{}
+								s.Set("type", "entry")
+								s.Set("key", func() interface{} { s := &mml.Struct{}; s.Set("type", "string"); s.Set("value", _k); ; return s }())
+								s.Set("value", direct_literalCode(mml.Ref(_v, _k)))
+								return s
+							}()
+						},
+						FixedArgs: 1,
+					}}).(*mml.Function).Call([]interface{}{_sort.(*mml.Function).Call([]interface{}{&mml.Function{
+						F: func(a []interface{}) interface{} {
+							var c interface{}
+							mml.Nop(c)
+							var _left = a[0]
+							var _right = a[1]
+							mml.Nop(_left, _right)
+							return mml.BinaryOp(13, _left, _right)
+						},
+						FixedArgs: 2,
+					}}).(*mml.Function).Call([]interface{}{_keys.(*mml.Function).Call([]interface{}{_v})})}))
+					return s
+				}()
+			}
+		}
+		_literalCode = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_literalCode(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_replace = func(_folded, _m interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _definition interface{}
+			var _statement interface{}
+			mml.Nop(_definition, _statement)
+			_definition = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _d = a[0]
+					mml.Nop(_d)
+					var _k interface{}
+					mml.Nop(_k)
+					_k = direct_id(mml.Ref(_m, "path"), mml.Ref(_d, "symbol"))
+					return func() interface{} {
+						c = _has.(*mml.Function).Call([]interface{}{_k, _folded})
+						if c.(bool) {
+							return func() interface{} {
+								s := &mml.Struct{}
+								s.Merge(_d.(*mml.Struct))
+								s.Set("expression", direct_literalCode(mml.Ref(mml.Ref(_folded, _k), "value")))
+								s.Set("folded", true)
+								return s
+							}()
+						} else {
+							return _d
+						}
+					}()
+				},
+				FixedArgs: 1,
+			}
+			_statement = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _s = a[0]
+					mml.Nop(_s)
+
+					mml.Nop()
+					switch mml.Ref(_s, "type") {
+					case "definition":
+
+						mml.Nop()
+						return _definition.(*mml.Function).Call([]interface{}{_s})
+					case "definition-group":
+
+						mml.Nop()
+						return func() interface{} {
+							s := &mml.Struct{}
+							s.Merge(_s.(*mml.Struct))
+							s.Set("definitions", _map.(*mml.Function).Call([]interface{}{_definition, mml.Ref(_s, "definitions")}))
+							return s
+						}()
+					default:
+
+						mml.Nop()
+						return _s
+					}
+				},
+				FixedArgs: 1,
+			}
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Merge(_m.(*mml.Struct))
+				s.Set("body", func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(mml.Ref(_m, "body").(*mml.Struct))
+					s.Set("statements", _map.(*mml.Function).Call([]interface{}{_statement, mml.Ref(mml.Ref(_m, "body"), "statements")}))
+					return s
+				}())
+				return s
+			}()
+		}
+		_replace = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_replace(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_safe = func(_exported, _modules, _folded interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			a := []interface{}{_exported, _modules, _folded}
+			_ = a
+		tailcall:
+			for {
+				var _exported = a[0]
+				var _modules = a[1]
+				var _folded = a[2]
+				mml.Nop(_exported, _modules, _folded)
+				var _refs interface{}
+				var _referenced interface{}
+				var _unsafe interface{}
+				mml.Nop(_refs, _referenced, _unsafe)
+				if !_some.(*mml.Function).Call([]interface{}{&mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _k = a[0]
+						mml.Nop(_k)
+						return mml.BinaryOp(15, _len.(*mml.Function).Call([]interface{}{_keys.(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_folded, _k), "deps")})}), 0)
+					},
+					FixedArgs: 1,
+				}, _keys.(*mml.Function).Call([]interface{}{_folded})}).(bool) {
+					mml.Nop()
+					return _folded
+				}
+				_refs = mml.Ref(_deadcode, "referenced").(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_replace.(*mml.Function).Call([]interface{}{_folded}), _modules})})
+				_referenced = &mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _owner = a[0]
+						mml.Nop(_owner)
+						return ((_exported.(bool) && _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("exported", true); ; return s }(), _owner}).(bool)) || (_has.(*mml.Function).Call([]interface{}{mml.Ref(_owner, "path"), _refs}).(bool) && _has.(*mml.Function).Call([]interface{}{mml.Ref(_owner, "name"), mml.Ref(_refs, mml.Ref(_owner, "path"))}).(bool)))
+					},
+					FixedArgs: 1,
+				}
+				_unsafe = _filter.(*mml.Function).Call([]interface{}{&mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _k = a[0]
+						mml.Nop(_k)
+						return _some.(*mml.Function).Call([]interface{}{_referenced, mml.Ref(_structs, "values").(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_folded, _k), "deps")})})
+					},
+					FixedArgs: 1,
+				}}).(*mml.Function).Call([]interface{}{_keys.(*mml.Function).Call([]interface{}{_folded})})
+				c = mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_unsafe}), 0)
+				if c.(bool) {
+					return _folded
+				} else {
+					a = []interface{}{_exported, _modules, _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+						F: func(a []interface{}) interface{} {
+							var c interface{}
+							mml.Nop(c)
+							var _k = a[0]
+							var _f = a[1]
+							mml.Nop(_k, _f)
+							return func() interface{} {
+								c = _contains.(*mml.Function).Call([]interface{}{_k, _unsafe})
+								if c.(bool) {
+									return _f
+								} else {
+									return func() interface{} {
+										s := &mml.Struct{}
+										s.Merge(_f.(*mml.Struct))
+										s.Set(_k.(string), mml.Ref(_folded, _k))
+										return s
+									}()
+								}
+							}()
+						},
+						FixedArgs: 2,
+					}, func() interface{} { s := &mml.Struct{}; ; return s }(), _keys.(*mml.Function).Call([]interface{}{_folded})})}
+					continue tailcall
+				}
+			}
+		}
+		_safe = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_safe(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_evaluate = func(_exported, _modules interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _st interface{}
+			var _folded interface{}
+			mml.Nop(_st, _folded)
+			_st = func() interface{} {
+				s := &mml.Struct{}
+				s.Set("modules", func() interface{} { s := &mml.Struct{}; ; return s }())
+				s.Set("builtins", func() interface{} { s := &mml.Struct{}; ; return s }())
+				s.Set("owner", func() interface{} { s := &mml.Struct{}; ; return s }())
+				s.Set("steps", 0)
+				s.Set("failed", false)
+				s.Set("deps", func() interface{} { s := &mml.Struct{}; ; return s }())
+				s.Set("writes", 0)
+				s.Set("mutableCells", (&mml.List{}))
+				return s
+			}()
+			_folded = _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _f = a[0]
+					var _all = a[1]
+					mml.Nop(_f, _all)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_all.(*mml.Struct))
+						s.Merge(_f.(*mml.Struct))
+						return s
+					}()
+				},
+				FixedArgs: 2,
+			}, func() interface{} { s := &mml.Struct{}; ; return s }()}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_evaluateModule.(*mml.Function).Call([]interface{}{_st})}).(*mml.Function).Call([]interface{}{direct_initOrder(_modules)})})
+			return _map.(*mml.Function).Call([]interface{}{_replace.(*mml.Function).Call([]interface{}{direct_safe(_exported, _modules, _folded)}), _modules})
+		}
+		_evaluate = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_evaluate(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_do = func(_modules interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return direct_evaluate(false, _modules)
+		}
+		_do = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_do(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["do"] = _do
+		direct_keepExported = func(_modules interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return direct_evaluate(true, _modules)
+		}
+		_keepExported = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_keepExported(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["keepExported"] = _keepExported
+
+		return exports
+	})
+
+	modulePath = "deadcode"
+
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
+		exports := make(map[string]interface{})
+
+		var c interface{}
+		mml.Nop(c)
+
+		var _uses interface{}
+		var _exportedNames interface{}
+		var _moduleNames interface{}
+		var _pure interface{}
+		var _bindNames interface{}
+		var _references interface{}
+		var _resolve interface{}
+		var _definitionsByName interface{}
+		var _prune interface{}
+		var _setUsedModules interface{}
+		var _removeUnreachable interface{}
+		var _memberAccess interface{}
+		var _symbolKey interface{}
+		var _do interface{}
+		var _keepExported interface{}
+		var _keepExportedOf interface{}
+		var _referenced interface{}
+		var _builtins interface{}
+		var _code interface{}
+		var _codetree interface{}
+		var _structs interface{}
+		var _fold interface{}
+		var _foldr interface{}
+		var _map interface{}
+		var _filter interface{}
+		var _contains interface{}
+		var _sort interface{}
+		var _flat interface{}
+		var _flats interface{}
+		var _uniq interface{}
+		var _every interface{}
+		var _some interface{}
+		var _join interface{}
+		var _joins interface{}
+		var _formats interface{}
+		var _enum interface{}
+		var _log interface{}
+		var _fatal interface{}
+		var _bind interface{}
+		var _identity interface{}
+		var _eq interface{}
+		var _any interface{}
+		var _function interface{}
+		var _channel interface{}
+		var _natural interface{}
+		var _type interface{}
+		var _listOf interface{}
+		var _structOf interface{}
+		var _range interface{}
+		var _rangeMin interface{}
+		var _listLength interface{}
+		var _or interface{}
+		var _and interface{}
+		var _not interface{}
+		var _predicate interface{}
+		var _is interface{}
+		var direct_uses func(interface{}) interface{}
+		mml.Nop(direct_uses)
+		var direct_exportedNames func(interface{}) interface{}
+		mml.Nop(direct_exportedNames)
+		var direct_moduleNames func(interface{}) interface{}
+		mml.Nop(direct_moduleNames)
+		var direct_pure func(interface{}, interface{}) interface{}
+		mml.Nop(direct_pure)
+		var direct_bindNames func(interface{}, interface{}) interface{}
+		mml.Nop(direct_bindNames)
+		var direct_references func(interface{}) interface{}
+		mml.Nop(direct_references)
+		var direct_resolve func(interface{}, interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_resolve)
+		var direct_definitionsByName func(interface{}) interface{}
+		mml.Nop(direct_definitionsByName)
+		var direct_prune func(interface{}, interface{}) interface{}
+		mml.Nop(direct_prune)
+		var direct_setUsedModules func(interface{}, interface{}) interface{}
+		mml.Nop(direct_setUsedModules)
+		var direct_removeUnreachable func(interface{}, interface{}) interface{}
+		mml.Nop(direct_removeUnreachable)
+		var direct_do func(interface{}) interface{}
+		mml.Nop(direct_do)
+		var direct_keepExported func(interface{}) interface{}
+		mml.Nop(direct_keepExported)
+		var direct_keepExportedOf func(interface{}, interface{}) interface{}
+		mml.Nop(direct_keepExportedOf)
+		var direct_referenced func(interface{}) interface{}
+		mml.Nop(direct_referenced)
+		var direct_builtins func(interface{}) interface{}
+		mml.Nop(direct_builtins)
+		mml.Nop(_uses, _exportedNames, _moduleNames, _pure, _bindNames, _references, _resolve, _definitionsByName, _prune, _setUsedModules, _removeUnreachable, _memberAccess, _symbolKey, _do, _keepExported, _keepExportedOf, _referenced, _builtins, _code, _codetree, _structs, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _flats, _uniq, _every, _some, _join, _joins, _formats, _enum, _log, _fatal, _bind, _identity, _eq, _any, _function, _channel, _natural, _type, _listOf, _structOf, _range, _rangeMin, _listLength, _or, _and, _not, _predicate, _is)
+		var __lang = use("lang")
+		_fold = __lang.Get("fold")
+		_foldr = __lang.Get("foldr")
+		_map = __lang.Get("map")
+		_filter = __lang.Get("filter")
+		_contains = __lang.Get("contains")
+		_sort = __lang.Get("sort")
+		_flat = __lang.Get("flat")
+		_flats = __lang.Get("flats")
+		_uniq = __lang.Get("uniq")
+		_every = __lang.Get("every")
+		_some = __lang.Get("some")
+		_join = __lang.Get("join")
+		_joins = __lang.Get("joins")
+		_formats = __lang.Get("formats")
+		_enum = __lang.Get("enum")
+		_log = __lang.Get("log")
+		_fatal = __lang.Get("fatal")
+		_bind = __lang.Get("bind")
+		_identity = __lang.Get("identity")
+		_eq = __lang.Get("eq")
+		_any = __lang.Get("any")
+		_function = __lang.Get("function")
+		_channel = __lang.Get("channel")
+		_natural = __lang.Get("natural")
+		_type = __lang.Get("type")
+		_listOf = __lang.Get("listOf")
+		_structOf = __lang.Get("structOf")
+		_range = __lang.Get("range")
+		_rangeMin = __lang.Get("rangeMin")
+		_listLength = __lang.Get("listLength")
+		_or = __lang.Get("or")
+		_and = __lang.Get("and")
+		_not = __lang.Get("not")
+		_predicate = __lang.Get("predicate")
+		_is = __lang.Get("is")
+		_code = use("code")
+		_codetree = use("codetree")
+		_structs = use("structs")
+		_memberAccess = func() interface{} {
+			s := &mml.Struct{}
+			s.Set("type", "indexer")
+			s.Set("expression", func() interface{} { s := &mml.Struct{}; s.Set("type", "symbol"); ; return s }())
+			s.Set("index", func() interface{} { s := &mml.Struct{}; s.Set("type", "symbol-index"); ; return s }())
+			return s
+		}()
+		_symbolKey = func() interface{} {
+			s := &mml.Struct{}
+			s.Set("type", "entry")
+			s.Set("key", func() interface{} { s := &mml.Struct{}; s.Set("type", "symbol"); ; return s }())
+			return s
+		}()
+		direct_uses = func(_m interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _flat.(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{mml.Ref(_structs, "get").(*mml.Function).Call([]interface{}{"uses"})}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "use-list"); ; return s }()})}).(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_m, "body"), "statements")})})})
+		}
+		_uses = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_uses(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_exportedNames = func(_m interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _map.(*mml.Function).Call([]interface{}{mml.Ref(_structs, "get").(*mml.Function).Call([]interface{}{"symbol"})}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("exported", true); ; return s }()})}).(*mml.Function).Call([]interface{}{mml.Ref(_code, "getDefinitions").(*mml.Function).Call([]interface{}{mml.Ref(_m, "body")})})})
+		}
+		_exportedNames = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_exportedNames(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_moduleNames = func(_m interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _named interface{}
+			var _inline interface{}
+			mml.Nop(_named, _inline)
+			_named = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _u = a[0]
+					var _n = a[1]
+					mml.Nop(_u, _n)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_n.(*mml.Struct))
+						s.Set(func() interface{} {
+							c = _has.(*mml.Function).Call([]interface{}{"capture", _u})
+							if c.(bool) {
+								return mml.Ref(_u, "capture")
+							} else {
+								return mml.Ref(_code, "getModuleName").(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_u, "path"), "value")})
+							}
+						}().(string), mml.Ref(mml.Ref(_u, "path"), "value"))
+						return s
+					}()
+				},
+				FixedArgs: 2,
+			}
+			_inline = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _u = a[0]
+					var _n = a[1]
+					mml.Nop(_u, _n)
+					return _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+						F: func(a []interface{}) interface{} {
+							var c interface{}
+							mml.Nop(c)
+							var _name = a[0]
+							var _n = a[1]
+							mml.Nop(_name, _n)
+							return func() interface{} {
+								s := &mml.Struct{}
+								s.Merge(_n.(*mml.Struct))
+								s.Set(_name.(string), mml.Ref(mml.Ref(_u, "path"), "value"))
+								return s
+							}()
+						},
+						FixedArgs: 2,
+					}, _n, direct_exportedNames(mml.Ref(_u, "module"))})
+				},
+				FixedArgs: 2,
+			}
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Set("named", _fold.(*mml.Function).Call([]interface{}{_named, func() interface{} { s := &mml.Struct{}; ; return s }()}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{_not.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("capture", "."); ; return s }()})})}).(*mml.Function).Call([]interface{}{direct_uses(_m)})}))
+				s.Set("inline", _fold.(*mml.Function).Call([]interface{}{_inline, func() interface{} { s := &mml.Struct{}; ; return s }()}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("capture", "."); ; return s }()})}).(*mml.Function).Call([]interface{}{direct_uses(_m)})}))
+				return s
+			}()
+		}
+		_moduleNames = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_moduleNames(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_pure = func(_names, _c interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _p interface{}
+			mml.Nop(_p)
+			_p = _pure.(*mml.Function).Call([]interface{}{_names})
+			switch {
+			case _is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("type", _or.(*mml.Function).Call([]interface{}{"int", "float", "string", "bool", "symbol", "function"}))
+				return s
+			}(), _c}):
+
+				mml.Nop()
+				return true
+			case _is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("type", _or.(*mml.Function).Call([]interface{}{"list", "mutable-list"}))
+				return s
+			}(), _c}):
+
+				mml.Nop()
+				return _every.(*mml.Function).Call([]interface{}{_p, mml.Ref(_c, "values")})
+			case _is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("type", _or.(*mml.Function).Call([]interface{}{"struct", "mutable-struct"}))
+				return s
+			}(), _c}):
+
+				mml.Nop()
+				return _every.(*mml.Function).Call([]interface{}{_p, mml.Ref(_c, "entries")})
+			case _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "entry"); ; return s }(), _c}):
+
+				mml.Nop()
+				return (_p.(*mml.Function).Call([]interface{}{mml.Ref(_c, "key")}).(bool) && _p.(*mml.Function).Call([]interface{}{mml.Ref(_c, "value")}).(bool))
+			case _is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("type", _or.(*mml.Function).Call([]interface{}{"spread", "expression-key"}))
+				return s
+			}(), _c}):
+
+				mml.Nop()
+				return _p.(*mml.Function).Call([]interface{}{mml.Ref(_c, "value")})
+			case _is.(*mml.Function).Call([]interface{}{_memberAccess, _c}):
+
+				mml.Nop()
+				return _has.(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_c, "expression"), "name"), mml.Ref(_names, "named")})
+			default:
+
+				mml.Nop()
+				return false
+			}
+		}
+		_pure = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_pure(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_bindNames = func(_bound, _names interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _name = a[0]
+					var _b = a[1]
+					mml.Nop(_name, _b)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_b.(*mml.Struct))
+						s.Set(_name.(string), true)
+						return s
+					}()
+				},
+				FixedArgs: 2,
+			}, _bound, _names})
+		}
+		_bindNames = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_bindNames(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_references = func(_c interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _add interface{}
+			var _walk interface{}
+			var _symbols interface{}
+			var _members interface{}
+			mml.Nop(_add, _walk, _symbols, _members)
+			_symbols = func() interface{} { s := &mml.Struct{}; ; return s }()
+			_members = func() interface{} { s := &mml.Struct{}; ; return s }()
+			_add = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _name = a[0]
+					mml.Nop(_name)
+
+					mml.Nop()
+					mml.SetRef(_symbols, _name, func() interface{} {
+						c = _has.(*mml.Function).Call([]interface{}{_name, _symbols})
+						if c.(bool) {
+							return mml.BinaryOp(9, mml.Ref(_symbols, _name), 1)
+						} else {
+							return 1
+						}
+					}())
+					return nil
+				},
+				FixedArgs: 1,
+			}
+			_walk = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _bound = a[0]
+					var _c = a[1]
+					mml.Nop(_bound, _c)
+
+					mml.Nop()
+					switch {
+					case (mml.BinaryOp(11, mml.Ref(_c, "type"), "symbol").(bool) && !_has.(*mml.Function).Call([]interface{}{mml.Ref(_c, "name"), _bound}).(bool)):
+
+						mml.Nop()
+						_add.(*mml.Function).Call([]interface{}{mml.Ref(_c, "name")})
+					case (mml.BinaryOp(11, mml.Ref(_c, "type"), "entry").(bool) && _is.(*mml.Function).Call([]interface{}{_symbolKey, _c}).(bool)):
+
+						mml.Nop()
+						_walk.(*mml.Function).Call([]interface{}{_bound, mml.Ref(_c, "value")})
+					case ((mml.BinaryOp(11, mml.Ref(_c, "type"), "indexer").(bool) && _is.(*mml.Function).Call([]interface{}{_memberAccess, _c}).(bool)) && !_has.(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_c, "expression"), "name"), _bound}).(bool)):
+						var _name interface{}
+						mml.Nop(_name)
+						_name = mml.Ref(mml.Ref(_c, "expression"), "name")
+						_add.(*mml.Function).Call([]interface{}{_name})
+						mml.SetRef(_members, _name, func() interface{} {
+							c = _has.(*mml.Function).Call([]interface{}{_name, _members})
+							if c.(bool) {
+								return (&mml.List{}).Concat(mml.Ref(_members, _name).(*mml.List)).Append(mml.Ref(mml.Ref(mml.Ref(_c, "index"), "symbol"), "name"))
+							} else {
+								return (&mml.List{}).Append(mml.Ref(mml.Ref(mml.Ref(_c, "index"), "symbol"), "name"))
+							}
+						}())
+					case mml.BinaryOp(11, mml.Ref(_c, "type"), "function"):
+
+						mml.Nop()
+						_walk.(*mml.Function).Call([]interface{}{direct_bindNames(_bound, (&mml.List{}).Concat(mml.Ref(_c, "params").(*mml.List)).Append(mml.Ref(_c, "collectParam"))), mml.Ref(_c, "body")})
+					case mml.BinaryOp(11, mml.Ref(_c, "type"), "statement-list"):
+
+						mml.Nop()
+						mml.Ref(_codetree, "each").(*mml.Function).Call([]interface{}{_walk.(*mml.Function).Call([]interface{}{direct_bindNames(_bound, mml.Ref(_code, "getScope").(*mml.Function).Call([]interface{}{_c}))}), _c})
+					case (mml.BinaryOp(11, mml.Ref(_c, "type"), "loop").(bool) && _is.(*mml.Function).Call([]interface{}{func() interface{} {
+						s := &mml.Struct{}
+						s.Set("expression", func() interface{} { s := &mml.Struct{}; s.Set("type", "range-over"); s.Set("symbol", _any); ; return s }())
+						return s
+					}(), _c}).(bool)):
+
+						mml.Nop()
+						_walk.(*mml.Function).Call([]interface{}{_bound, mml.Ref(_c, "expression")})
+						_walk.(*mml.Function).Call([]interface{}{direct_bindNames(_bound, (&mml.List{}).Append(mml.Ref(mml.Ref(_c, "expression"), "symbol"))), mml.Ref(_c, "body")})
+					case (mml.BinaryOp(11, mml.Ref(_c, "type"), "select-case").(bool) && _is.(*mml.Function).Call([]interface{}{func() interface{} {
+						s := &mml.Struct{}
+						s.Set("expression", func() interface{} { s := &mml.Struct{}; s.Set("type", "definition"); ; return s }())
+						return s
+					}(), _c}).(bool)):
+
+						mml.Nop()
+						_walk.(*mml.Function).Call([]interface{}{_bound, mml.Ref(_c, "expression")})
+						_walk.(*mml.Function).Call([]interface{}{direct_bindNames(_bound, (&mml.List{}).Append(mml.Ref(mml.Ref(_c, "expression"), "symbol"))), mml.Ref(_c, "body")})
+					default:
+
+						mml.Nop()
+						mml.Ref(_codetree, "each").(*mml.Function).Call([]interface{}{_walk.(*mml.Function).Call([]interface{}{_bound}), _c})
+					}
+					return _c
+				},
+				FixedArgs: 2,
+			}
+			_walk.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; ; return s }(), _c})
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Set("symbols", _symbols)
+				s.Set("members", _members)
+				return s
+			}()
+		}
+		_references = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_references(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_resolve = func(_names, _exports, _path, _c interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _resolveName interface{}
+			var _refs interface{}
+			var _bound interface{}
+			mml.Nop(_resolveName, _refs, _bound)
+			_refs = direct_references(_c)
+			_bound = mml.Ref(_names, _path)
+			_resolveName = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _name = a[0]
+					mml.Nop(_name)
+					var _own interface{}
+					var _inline interface{}
+					var _used interface{}
+					var _accessed interface{}
+					var _members interface{}
+					mml.Nop(_own, _inline, _used, _accessed, _members)
+					_own = (&mml.List{}).Append(func() interface{} { s := &mml.Struct{}; s.Set("path", _path); s.Set("name", _name); ; return s }())
+					_inline = func() interface{} {
+						c = _has.(*mml.Function).Call([]interface{}{_name, mml.Ref(_bound, "inline")})
+						if c.(bool) {
+							return (&mml.List{}).Append(func() interface{} {
+								s := &mml.Struct{}
+								s.Set("path", mml.Ref(mml.Ref(_bound, "inline"), _name))
+								s.Set("name", _name)
+								return s
+							}())
+						} else {
+							return (&mml.List{})
+						}
+					}()
+					if !_has.(*mml.Function).Call([]interface{}{_name, mml.Ref(_bound, "named")}).(bool) {
+						mml.Nop()
+						return (&mml.List{}).Concat(_own.(*mml.List)).Concat(_inline.(*mml.List))
+					}
+					_used = mml.Ref(mml.Ref(_bound, "named"), _name)
+					_accessed = func() interface{} {
+						c = _has.(*mml.Function).Call([]interface{}{_name, mml.Ref(_refs, "members")})
+						if c.(bool) {
+							return mml.Ref(mml.Ref(_refs, "members"), _name)
+						} else {
+							return (&mml.List{})
+						}
+					}()
+					_members = func() interface{} {
+						if mml.BinaryOp(15, mml.Ref(mml.Ref(_refs, "symbols"), _name), _len.(*mml.Function).Call([]interface{}{_accessed})).(bool) && _has.(*mml.Function).Call([]interface{}{_used, _exports}).(bool) {
+							return mml.Ref(_exports, _used)
+						} else {
+							return _accessed
+						}
+					}()
+					return (&mml.List{}).Concat(_own.(*mml.List)).Concat(_inline.(*mml.List)).Concat(_map.(*mml.Function).Call([]interface{}{&mml.Function{
+						F: func(a []interface{}) interface{} {
+							var c interface{}
+							mml.Nop(c)
+							var _member = a[0]
+							mml.Nop(_member)
+							return func() interface{} { s := &mml.Struct{}; s.Set("path", _used); s.Set("name", _member); ; return s }()
+						},
+						FixedArgs: 1,
+					}, _members}).(*mml.List))
+				},
+				FixedArgs: 1,
+			}
+			return _flat.(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_resolveName}).(*mml.Function).Call([]interface{}{_keys.(*mml.Function).Call([]interface{}{mml.Ref(_refs, "symbols")})})})
+		}
+		_resolve = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_resolve(a[0], a[1], a[2], a[3])
+			},
+			FixedArgs: 4,
+		}
+		direct_definitionsByName = func(_m interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _d = a[0]
+					var _defs = a[1]
+					mml.Nop(_d, _defs)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_defs.(*mml.Struct))
+						s.Set(mml.Ref(_d, "symbol").(string), _d)
+						return s
+					}()
+				},
+				FixedArgs: 2,
+			}, func() interface{} { s := &mml.Struct{}; ; return s }()}).(*mml.Function).Call([]interface{}{mml.Ref(_code, "getDefinitions").(*mml.Function).Call([]interface{}{mml.Ref(_m, "body")})})
+		}
+		_definitionsByName = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_definitionsByName(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_prune = func(_reachable, _m interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _keep interface{}
+			var _statement interface{}
+			mml.Nop(_keep, _statement)
+			_keep = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _d = a[0]
+					mml.Nop(_d)
+					return _has.(*mml.Function).Call([]interface{}{mml.Ref(_d, "symbol"), mml.Ref(_reachable, mml.Ref(_m, "path"))})
+				},
+				FixedArgs: 1,
+			}
+			_statement = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _s = a[0]
+					mml.Nop(_s)
+
+					mml.Nop()
+					switch mml.Ref(_s, "type") {
+					case "definition":
+
+						mml.Nop()
+						return func() interface{} {
+							c = _keep.(*mml.Function).Call([]interface{}{_s})
+							if c.(bool) {
+								return (&mml.List{}).Append(_s)
+							} else {
+								return (&mml.List{})
+							}
+						}()
+					case "definition-group":
+						var _definitions interface{}
+						mml.Nop(_definitions)
+						_definitions = _filter.(*mml.Function).Call([]interface{}{_keep, mml.Ref(_s, "definitions")})
+						return func() interface{} {
+							c = mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_definitions}), 0)
+							if c.(bool) {
+								return (&mml.List{})
+							} else {
+								return (&mml.List{}).Append(func() interface{} {
+									s := &mml.Struct{}
+									s.Merge(_s.(*mml.Struct))
+									s.Set("definitions", _definitions)
+									return s
+								}())
+							}
+						}()
+					default:
+
+						mml.Nop()
+						return (&mml.List{}).Append(_s)
+					}
+				},
+				FixedArgs: 1,
+			}
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Merge(_m.(*mml.Struct))
+				s.Set("body", func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(mml.Ref(_m, "body").(*mml.Struct))
+					s.Set("statements", _flat.(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_statement}).(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_m, "body"), "statements")})}))
+					return s
+				}())
+				return s
+			}()
+		}
+		_prune = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_prune(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_setUsedModules = func(_pruned, _m interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _usedModule interface{}
+			var _useList interface{}
+			mml.Nop(_usedModule, _useList)
+			_usedModule = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _u = a[0]
+					mml.Nop(_u)
+					return func() interface{} {
+						c = _has.(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_u, "path"), "value"), _pruned})
+						if c.(bool) {
+							return func() interface{} {
+								s := &mml.Struct{}
+								s.Merge(_u.(*mml.Struct))
+								s.Set("module", mml.Ref(_pruned, mml.Ref(mml.Ref(_u, "path"), "value")))
+								return s
+							}()
+						} else {
+							return _u
+						}
+					}()
+				},
+				FixedArgs: 1,
+			}
+			_useList = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _s = a[0]
+					mml.Nop(_s)
+					return func() interface{} {
+						c = _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "use-list"); ; return s }(), _s})
+						if c.(bool) {
+							return func() interface{} {
+								s := &mml.Struct{}
+								s.Merge(_s.(*mml.Struct))
+								s.Set("uses", _map.(*mml.Function).Call([]interface{}{_usedModule, mml.Ref(_s, "uses")}))
+								return s
+							}()
+						} else {
+							return _s
+						}
+					}()
+				},
+				FixedArgs: 1,
+			}
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Merge(_m.(*mml.Struct))
+				s.Set("body", func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(mml.Ref(_m, "body").(*mml.Struct))
+					s.Set("statements", _map.(*mml.Function).Call([]interface{}{_useList, mml.Ref(mml.Ref(_m, "body"), "statements")}))
+					return s
+				}())
+				return s
+			}()
+		}
+		_setUsedModules = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_setUsedModules(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_removeUnreachable = func(_rootExports, _modules interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _pending interface{}
+			var _reach interface{}
+			var _visit interface{}
+			var _pruned interface{}
+			var _byPath interface{}
+			var _definitions interface{}
+			var _names interface{}
+			var _exports interface{}
+			var _reachable interface{}
+			mml.Nop(_pending, _reach, _visit, _pruned, _byPath, _definitions, _names, _exports, _reachable)
+			_definitions = _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _m = a[0]
+					var _d = a[1]
+					mml.Nop(_m, _d)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_d.(*mml.Struct))
+						s.Set(mml.Ref(_m, "path").(string), direct_definitionsByName(_m))
+						return s
+					}()
+				},
+				FixedArgs: 2,
+			}, func() interface{} { s := &mml.Struct{}; ; return s }(), _modules})
+			_names = _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _m = a[0]
+					var _n = a[1]
+					mml.Nop(_m, _n)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_n.(*mml.Struct))
+						s.Set(mml.Ref(_m, "path").(string), direct_moduleNames(_m))
+						return s
+					}()
+				},
+				FixedArgs: 2,
+			}, func() interface{} { s := &mml.Struct{}; ; return s }(), _modules})
+			_exports = _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _m = a[0]
+					var _e = a[1]
+					mml.Nop(_m, _e)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_e.(*mml.Struct))
+						s.Set(mml.Ref(_m, "path").(string), direct_exportedNames(_m))
+						return s
+					}()
+				},
+				FixedArgs: 2,
+			}, func() interface{} { s := &mml.Struct{}; ; return s }(), _modules})
+			_reachable = _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _m = a[0]
+					var _r = a[1]
+					mml.Nop(_m, _r)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_r.(*mml.Struct))
+						s.Set(mml.Ref(_m, "path").(string), func() interface{} { s := &mml.Struct{}; ; return s }())
+						return s
+					}()
+				},
+				FixedArgs: 2,
+			}, func() interface{} { s := &mml.Struct{}; ; return s }(), _modules})
+			_pending = (&mml.List{})
+			_reach = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _path = a[0]
+					var _name = a[1]
+					mml.Nop(_path, _name)
+
+					mml.Nop()
+					if (!_has.(*mml.Function).Call([]interface{}{_path, _definitions}).(bool) || !_has.(*mml.Function).Call([]interface{}{_name, mml.Ref(_definitions, _path)}).(bool)) || _has.(*mml.Function).Call([]interface{}{_name, mml.Ref(_reachable, _path)}).(bool) {
+						mml.Nop()
+						return nil
+					}
+					mml.SetRef(mml.Ref(_reachable, _path), _name, true)
+					_pending = (&mml.List{}).Concat(_pending.(*mml.List)).Append(func() interface{} {
+						s := &mml.Struct{}
+						s.Set("path", _path)
+						s.Set("code", mml.Ref(mml.Ref(mml.Ref(_definitions, _path), _name), "expression"))
+						return s
+					}())
+					return nil
+				},
+				FixedArgs: 2,
+			}
+			_visit = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _path = a[0]
+					var _c = a[1]
+					mml.Nop(_path, _c)
+
+					mml.Nop()
+					for _, _r := range direct_resolve(_names, _exports, _path, _c).(*mml.List).Values() {
+
+						mml.Nop()
+						_reach.(*mml.Function).Call([]interface{}{mml.Ref(_r, "path"), mml.Ref(_r, "name")})
+					}
+					return nil
+				},
+				FixedArgs: 2,
+			}
+			for _, _m := range _modules.(*mml.List).Values() {
+				var _root interface{}
+				mml.Nop(_root)
+				_root = &mml.Function{
+					F: func(a []interface{}) interface{} {
+						var c interface{}
+						mml.Nop(c)
+						var _d = a[0]
+						mml.Nop(_d)
+						return (!direct_pure(mml.Ref(_names, mml.Ref(_m, "path")), mml.Ref(_d, "expression")).(bool) || (_rootExports.(*mml.Function).Call([]interface{}{_m}).(bool) && mml.Ref(_d, "exported").(bool)))
+					},
+					FixedArgs: 1,
+				}
+				for _, _s := range mml.Ref(mml.Ref(_m, "body"), "statements").(*mml.List).Values() {
+
+					mml.Nop()
+					switch mml.Ref(_s, "type") {
+					case "definition":
+
+						mml.Nop()
+						c = _root.(*mml.Function).Call([]interface{}{_s})
+						if c.(bool) {
+							mml.Nop()
+							_reach.(*mml.Function).Call([]interface{}{mml.Ref(_m, "path"), mml.Ref(_s, "symbol")})
+						}
+					case "definition-group":
+
+						mml.Nop()
+						for _, _d := range mml.Ref(_s, "definitions").(*mml.List).Values() {
+
+							mml.Nop()
+							c = _root.(*mml.Function).Call([]interface{}{_d})
+							if c.(bool) {
+								mml.Nop()
+								_reach.(*mml.Function).Call([]interface{}{mml.Ref(_m, "path"), mml.Ref(_d, "symbol")})
+							}
+						}
+					default:
+
+						mml.Nop()
+						_visit.(*mml.Function).Call([]interface{}{mml.Ref(_m, "path"), _s})
+					}
+				}
+			}
+			for mml.BinaryOp(15, _len.(*mml.Function).Call([]interface{}{_pending}), 0).(bool) {
+				var _next interface{}
+				mml.Nop(_next)
+				_next = mml.Ref(_pending, mml.BinaryOp(10, _len.(*mml.Function).Call([]interface{}{_pending}), 1))
+				_pending = mml.RefRange(_pending, nil, mml.BinaryOp(10, _len.(*mml.Function).Call([]interface{}{_pending}), 1))
+				_visit.(*mml.Function).Call([]interface{}{mml.Ref(_next, "path"), mml.Ref(_next, "code")})
+			}
+			_pruned = _map.(*mml.Function).Call([]interface{}{_prune.(*mml.Function).Call([]interface{}{_reachable})}).(*mml.Function).Call([]interface{}{_modules})
+			_byPath = _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _m = a[0]
+					var _p = a[1]
+					mml.Nop(_m, _p)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_p.(*mml.Struct))
+						s.Set(mml.Ref(_m, "path").(string), _m)
+						return s
+					}()
+				},
+				FixedArgs: 2,
+			}, func() interface{} { s := &mml.Struct{}; ; return s }(), _pruned})
+			return _map.(*mml.Function).Call([]interface{}{_setUsedModules.(*mml.Function).Call([]interface{}{_byPath}), _pruned})
+		}
+		_removeUnreachable = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_removeUnreachable(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_do = func(_modules interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return direct_removeUnreachable(&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var __ = a[0]
+					mml.Nop(__)
+					return false
+				},
+				FixedArgs: 1,
+			}, _modules)
+		}
+		_do = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_do(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["do"] = _do
+		direct_keepExported = func(_modules interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return direct_removeUnreachable(&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var __ = a[0]
+					mml.Nop(__)
+					return true
+				},
+				FixedArgs: 1,
+			}, _modules)
+		}
+		_keepExported = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_keepExported(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["keepExported"] = _keepExported
+		direct_keepExportedOf = func(_path, _modules interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return direct_removeUnreachable(&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _m = a[0]
+					mml.Nop(_m)
+					return mml.BinaryOp(11, mml.Ref(_m, "path"), _path)
+				},
+				FixedArgs: 1,
+			}, _modules)
+		}
+		_keepExportedOf = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_keepExportedOf(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		exports["keepExportedOf"] = _keepExportedOf
+		direct_referenced = func(_modules interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _names interface{}
+			var _exports interface{}
+			var _refs interface{}
+			mml.Nop(_names, _exports, _refs)
+			_names = _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _m = a[0]
+					var _n = a[1]
+					mml.Nop(_m, _n)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_n.(*mml.Struct))
+						s.Set(mml.Ref(_m, "path").(string), direct_moduleNames(_m))
+						return s
+					}()
+				},
+				FixedArgs: 2,
+			}, func() interface{} { s := &mml.Struct{}; ; return s }(), _modules})
+			_exports = _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _m = a[0]
+					var _e = a[1]
+					mml.Nop(_m, _e)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_e.(*mml.Struct))
+						s.Set(mml.Ref(_m, "path").(string), direct_exportedNames(_m))
+						return s
+					}()
+				},
+				FixedArgs: 2,
+			}, func() interface{} { s := &mml.Struct{}; ; return s }(), _modules})
+			_refs = _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _m = a[0]
+					var _r = a[1]
+					mml.Nop(_m, _r)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_r.(*mml.Struct))
+						s.Set(mml.Ref(_m, "path").(string), func() interface{} { s := &mml.Struct{}; ; return s }())
+						return s
+					}()
+				},
+				FixedArgs: 2,
+			}, func() interface{} { s := &mml.Struct{}; ; return s }(), _modules})
+			for _, _m := range _modules.(*mml.List).Values() {
+
+				mml.Nop()
+				for _, _s := range mml.Ref(mml.Ref(_m, "body"), "statements").(*mml.List).Values() {
+
+					mml.Nop()
+					for _, _r := range direct_resolve(_names, _exports, mml.Ref(_m, "path"), _s).(*mml.List).Values() {
+
+						mml.Nop()
+						c = _has.(*mml.Function).Call([]interface{}{mml.Ref(_r, "path"), _refs})
+						if c.(bool) {
+							mml.Nop()
+							mml.SetRef(mml.Ref(_refs, mml.Ref(_r, "path")), mml.Ref(_r, "name"), true)
+						}
+					}
+				}
+			}
+			return _refs
+		}
+		_referenced = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_referenced(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["referenced"] = _referenced
+		direct_builtins = func(_modules interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _filter.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _name = a[0]
+					mml.Nop(_name)
+					return _has.(*mml.Function).Call([]interface{}{_name, mml.Ref(_code, "builtin")})
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_keys.(*mml.Function).Call([]interface{}{_fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _s = a[0]
+					var _names = a[1]
+					mml.Nop(_s, _names)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_names.(*mml.Struct))
+						s.Set(mml.Ref(_s, "name").(string), true)
+						return s
+					}()
+				},
+				FixedArgs: 2,
+			}, func() interface{} { s := &mml.Struct{}; ; return s }()}).(*mml.Function).Call([]interface{}{_flat.(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{mml.Ref(_codetree, "filter").(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "symbol"); ; return s }()})})}).(*mml.Function).Call([]interface{}{_modules})})})})})
+		}
+		_builtins = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_builtins(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["builtins"] = _builtins
+
+		return exports
+	})
+
+	modulePath = "types"
+
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
+		exports := make(map[string]interface{})
+
+		var c interface{}
+		mml.Nop(c)
+
+		var _binaryType interface{}
+		var _binary interface{}
+		var _unary interface{}
+		var _ternary interface{}
+		var _typeOf interface{}
+		var _lookup interface{}
+		var _envType interface{}
+		var _shadow interface{}
+		var _binding interface{}
+		var _keepsType interface{}
+		var _direct interface{}
 		var _statementList interface{}
+		var _loop interface{}
+		var _annotate interface{}
+		var _numeric interface{}
+		var _ordered interface{}
+		var _literal interface{}
+		var _zero interface{}
+		var _intOps interface{}
+		var _numberOps interface{}
+		var _compare interface{}
+		var _of interface{}
 		var _do interface{}
-		var _allModules interface{}
-		var _intLiteral interface{}
-		var _floatLiteral interface{}
-		var _boolLiteral interface{}
-		var _breakStatement interface{}
-		var _continueStatement interface{}
-		var _toGo interface{}
+		var _code interface{}
+		var _codetree interface{}
+		var _fold interface{}
+		var _foldr interface{}
+		var _map interface{}
+		var _filter interface{}
+		var _contains interface{}
+		var _sort interface{}
+		var _flat interface{}
+		var _flats interface{}
+		var _uniq interface{}
+		var _every interface{}
+		var _some interface{}
+		var _join interface{}
+		var _joins interface{}
+		var _formats interface{}
+		var _enum interface{}
+		var _log interface{}
+		var _fatal interface{}
+		var _bind interface{}
+		var _identity interface{}
+		var _eq interface{}
+		var _any interface{}
+		var _function interface{}
+		var _channel interface{}
+		var _natural interface{}
+		var _type interface{}
+		var _listOf interface{}
+		var _structOf interface{}
+		var _range interface{}
+		var _rangeMin interface{}
+		var _listLength interface{}
+		var _or interface{}
+		var _and interface{}
+		var _not interface{}
+		var _predicate interface{}
+		var _is interface{}
+		var direct_binaryType func(interface{}, interface{}) interface{}
+		mml.Nop(direct_binaryType)
+		var direct_binary func(interface{}, interface{}) interface{}
+		mml.Nop(direct_binary)
+		var direct_unary func(interface{}, interface{}) interface{}
+		mml.Nop(direct_unary)
+		var direct_ternary func(interface{}, interface{}) interface{}
+		mml.Nop(direct_ternary)
+		var direct_typeOf func(interface{}, interface{}) interface{}
+		mml.Nop(direct_typeOf)
+		var direct_lookup func(interface{}, interface{}) interface{}
+		mml.Nop(direct_lookup)
+		var direct_envType func(interface{}, interface{}) interface{}
+		mml.Nop(direct_envType)
+		var direct_shadow func(interface{}, interface{}) interface{}
+		mml.Nop(direct_shadow)
+		var direct_binding func(interface{}) interface{}
+		mml.Nop(direct_binding)
+		var direct_keepsType func(interface{}, interface{}) interface{}
+		mml.Nop(direct_keepsType)
+		var direct_direct func(interface{}) interface{}
+		mml.Nop(direct_direct)
+		var direct_statementList func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_statementList)
+		var direct_loop func(interface{}, interface{}) interface{}
+		mml.Nop(direct_loop)
+		var direct_annotate func(interface{}, interface{}) interface{}
+		mml.Nop(direct_annotate)
+		var direct_of func(interface{}) interface{}
+		mml.Nop(direct_of)
+		var direct_do func(interface{}) interface{}
+		mml.Nop(direct_do)
+		mml.Nop(_binaryType, _binary, _unary, _ternary, _typeOf, _lookup, _envType, _shadow, _binding, _keepsType, _direct, _statementList, _loop, _annotate, _numeric, _ordered, _literal, _zero, _intOps, _numberOps, _compare, _of, _do, _code, _codetree, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _flats, _uniq, _every, _some, _join, _joins, _formats, _enum, _log, _fatal, _bind, _identity, _eq, _any, _function, _channel, _natural, _type, _listOf, _structOf, _range, _rangeMin, _listLength, _or, _and, _not, _predicate, _is)
+		var __lang = use("lang")
+		_fold = __lang.Get("fold")
+		_foldr = __lang.Get("foldr")
+		_map = __lang.Get("map")
+		_filter = __lang.Get("filter")
+		_contains = __lang.Get("contains")
+		_sort = __lang.Get("sort")
+		_flat = __lang.Get("flat")
+		_flats = __lang.Get("flats")
+		_uniq = __lang.Get("uniq")
+		_every = __lang.Get("every")
+		_some = __lang.Get("some")
+		_join = __lang.Get("join")
+		_joins = __lang.Get("joins")
+		_formats = __lang.Get("formats")
+		_enum = __lang.Get("enum")
+		_log = __lang.Get("log")
+		_fatal = __lang.Get("fatal")
+		_bind = __lang.Get("bind")
+		_identity = __lang.Get("identity")
+		_eq = __lang.Get("eq")
+		_any = __lang.Get("any")
+		_function = __lang.Get("function")
+		_channel = __lang.Get("channel")
+		_natural = __lang.Get("natural")
+		_type = __lang.Get("type")
+		_listOf = __lang.Get("listOf")
+		_structOf = __lang.Get("structOf")
+		_range = __lang.Get("range")
+		_rangeMin = __lang.Get("rangeMin")
+		_listLength = __lang.Get("listLength")
+		_or = __lang.Get("or")
+		_and = __lang.Get("and")
+		_not = __lang.Get("not")
+		_predicate = __lang.Get("predicate")
+		_is = __lang.Get("is")
+		_code = use("code")
+		_codetree = use("codetree")
+		_numeric = _or.(*mml.Function).Call([]interface{}{"int", "float"})
+		_ordered = _or.(*mml.Function).Call([]interface{}{"int", "float", "string"})
+		_literal = func() interface{} {
+			s := &mml.Struct{}
+			s.Set("type", _or.(*mml.Function).Call([]interface{}{"int", "float", "string", "bool"}))
+			return s
+		}()
+		_zero = _or.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "int"); s.Set("value", 0); ; return s }(), func() interface{} { s := &mml.Struct{}; s.Set("type", "float"); s.Set("value", float64(0)); ; return s }()})
+		_intOps = _or.(*mml.Function).Call([]interface{}{mml.Ref(_code, "binaryAnd"), mml.Ref(_code, "binaryOr"), mml.Ref(_code, "xor"), mml.Ref(_code, "andNot"), mml.Ref(_code, "mod")})
+		_numberOps = _or.(*mml.Function).Call([]interface{}{mml.Ref(_code, "mul"), mml.Ref(_code, "div"), mml.Ref(_code, "sub")})
+		_compare = _or.(*mml.Function).Call([]interface{}{mml.Ref(_code, "less"), mml.Ref(_code, "lessOrEq"), mml.Ref(_code, "greater"), mml.Ref(_code, "greaterOrEq")})
+		direct_binaryType = func(_op, _t interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+
+			mml.Nop()
+			switch {
+			case _is.(*mml.Function).Call([]interface{}{_intOps, _op}):
+
+				mml.Nop()
+				return func() interface{} {
+					c = mml.BinaryOp(11, _t, "int")
+					if c.(bool) {
+						return _t
+					} else {
+						return ""
+					}
+				}()
+			case _is.(*mml.Function).Call([]interface{}{_numberOps, _op}):
+
+				mml.Nop()
+				return func() interface{} {
+					c = _is.(*mml.Function).Call([]interface{}{_numeric, _t})
+					if c.(bool) {
+						return _t
+					} else {
+						return ""
+					}
+				}()
+			case mml.BinaryOp(11, _op, mml.Ref(_code, "add")):
+
+				mml.Nop()
+				return func() interface{} {
+					c = _is.(*mml.Function).Call([]interface{}{_ordered, _t})
+					if c.(bool) {
+						return _t
+					} else {
+						return ""
+					}
+				}()
+			case _is.(*mml.Function).Call([]interface{}{_or.(*mml.Function).Call([]interface{}{mml.Ref(_code, "equals"), mml.Ref(_code, "notEq")}), _op}):
+
+				mml.Nop()
+				return "bool"
+			case _is.(*mml.Function).Call([]interface{}{_compare, _op}):
+
+				mml.Nop()
+				return func() string {
+					c = _is.(*mml.Function).Call([]interface{}{_ordered, _t})
+					if c.(bool) {
+						return "bool"
+					} else {
+						return ""
+					}
+				}()
+			default:
+
+				mml.Nop()
+				return ""
+			}
+		}
+		_binaryType = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_binaryType(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_binary = func(_symbolType, _b interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _left interface{}
+			var _right interface{}
+			mml.Nop(_left, _right)
+			c = _is.(*mml.Function).Call([]interface{}{_or.(*mml.Function).Call([]interface{}{mml.Ref(_code, "logicalAnd"), mml.Ref(_code, "logicalOr")}), mml.Ref(_b, "op")})
+			if c.(bool) {
+				mml.Nop()
+				return "bool"
+			}
+			_left = direct_typeOf(_symbolType, mml.Ref(_b, "left"))
+			_right = direct_typeOf(_symbolType, mml.Ref(_b, "right"))
+			switch {
+			case (mml.BinaryOp(11, _left, "").(bool) || mml.BinaryOp(12, _left, _right).(bool)):
+
+				mml.Nop()
+				return ""
+			case (_is.(*mml.Function).Call([]interface{}{_literal, mml.Ref(_b, "left")}).(bool) && _is.(*mml.Function).Call([]interface{}{_literal, mml.Ref(_b, "right")}).(bool)):
+
+				mml.Nop()
+				return ""
+			case (_is.(*mml.Function).Call([]interface{}{_or.(*mml.Function).Call([]interface{}{mml.Ref(_code, "div"), mml.Ref(_code, "mod")}), mml.Ref(_b, "op")}).(bool) && _is.(*mml.Function).Call([]interface{}{_zero, mml.Ref(_b, "right")}).(bool)):
+
+				mml.Nop()
+				return ""
+			default:
+
+				mml.Nop()
+				return direct_binaryType(mml.Ref(_b, "op"), _left)
+			}
+		}
+		_binary = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_binary(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_unary = func(_symbolType, _u interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _t interface{}
+			mml.Nop(_t)
+			_t = direct_typeOf(_symbolType, mml.Ref(_u, "arg"))
+			switch {
+			case mml.BinaryOp(11, mml.Ref(_u, "op"), mml.Ref(_code, "logicalNot")):
+
+				mml.Nop()
+				return "bool"
+			case (_is.(*mml.Function).Call([]interface{}{_or.(*mml.Function).Call([]interface{}{mml.Ref(_code, "plus"), mml.Ref(_code, "minus")}), mml.Ref(_u, "op")}).(bool) && _is.(*mml.Function).Call([]interface{}{_numeric, _t}).(bool)):
+
+				mml.Nop()
+				return _t
+			default:
+
+				mml.Nop()
+				return ""
+			}
+		}
+		_unary = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_unary(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_ternary = func(_symbolType, _c interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _consequent interface{}
+			var _alternative interface{}
+			mml.Nop(_consequent, _alternative)
+			_consequent = direct_typeOf(_symbolType, mml.Ref(_c, "consequent"))
+			_alternative = direct_typeOf(_symbolType, mml.Ref(_c, "alternative"))
+			return func() interface{} {
+				c = mml.BinaryOp(11, _consequent, _alternative)
+				if c.(bool) {
+					return _consequent
+				} else {
+					return ""
+				}
+			}()
+		}
+		_ternary = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_ternary(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_typeOf = func(_symbolType, _c interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			a := []interface{}{_symbolType, _c}
+			_ = a
+		tailcall:
+			for {
+				var _symbolType = a[0]
+				var _c = a[1]
+				mml.Nop(_symbolType, _c)
+
+				mml.Nop()
+				switch mml.Ref(_c, "type") {
+				case "int":
+
+					mml.Nop()
+					return "int"
+				case "float":
+
+					mml.Nop()
+					return "float"
+				case "string":
+
+					mml.Nop()
+					return "string"
+				case "bool":
+
+					mml.Nop()
+					return "bool"
+				case "symbol":
+
+					mml.Nop()
+					return _symbolType.(*mml.Function).Call([]interface{}{_c})
+				case "unary":
+
+					mml.Nop()
+					return direct_unary(_symbolType, _c)
+				case "binary":
+
+					mml.Nop()
+					return direct_binary(_symbolType, _c)
+				case "cond":
+
+					mml.Nop()
+					return func() interface{} {
+						c = mml.Ref(_c, "ternary")
+						if c.(bool) {
+							return direct_ternary(_symbolType, _c)
+						} else {
+							return ""
+						}
+					}()
+				case "expression-key":
+
+					mml.Nop()
+					a = []interface{}{_symbolType, mml.Ref(_c, "value")}
+					continue tailcall
+				default:
+
+					mml.Nop()
+					return ""
+				}
+			}
+		}
+		_typeOf = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_typeOf(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_lookup = func(_env, _name interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				c = _has.(*mml.Function).Call([]interface{}{_name, _env})
+				if c.(bool) {
+					return mml.Ref(_env, _name)
+				} else {
+					return func() interface{} { s := &mml.Struct{}; ; return s }()
+				}
+			}()
+		}
+		_lookup = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_lookup(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_envType = func(_env, _s interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _b interface{}
+			mml.Nop(_b)
+			_b = direct_lookup(_env, mml.Ref(_s, "name"))
+			return func() interface{} {
+				c = _has.(*mml.Function).Call([]interface{}{"valueType", _b})
+				if c.(bool) {
+					return mml.Ref(_b, "valueType")
+				} else {
+					return ""
+				}
+			}()
+		}
+		_envType = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_envType(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_shadow = func(_env, _names interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _name = a[0]
+					var _e = a[1]
+					mml.Nop(_name, _e)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_e.(*mml.Struct))
+						s.Set(_name.(string), func() interface{} { s := &mml.Struct{}; ; return s }())
+						return s
+					}()
+				},
+				FixedArgs: 2,
+			}, _env, _names})
+		}
+		_shadow = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_shadow(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_binding = func(_t interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				c = mml.BinaryOp(11, _t, "")
+				if c.(bool) {
+					return func() interface{} { s := &mml.Struct{}; ; return s }()
+				} else {
+					return func() interface{} { s := &mml.Struct{}; s.Set("valueType", _t); ; return s }()
+				}
+			}()
+		}
+		_binding = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_binding(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_keepsType = func(_l, _name interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _every.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _a = a[0]
+					mml.Nop(_a)
+					return (!_has.(*mml.Function).Call([]interface{}{"valueType", mml.Ref(_a, "capture")}).(bool) || mml.BinaryOp(11, direct_of(mml.Ref(_a, "value")), mml.Ref(mml.Ref(_a, "capture"), "valueType")).(bool))
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{mml.Ref(_codetree, "filter").(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("type", "assign")
+				s.Set("capture", func() interface{} { s := &mml.Struct{}; s.Set("type", "symbol"); s.Set("name", _name); ; return s }())
+				return s
+			}()}), _l})})
+		}
+		_keepsType = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_keepsType(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_direct = func(_d interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("mutable", false)
+				s.Set("expression", func() interface{} {
+					s := &mml.Struct{}
+					s.Set("type", "function")
+					s.Set("collectParam", "")
+					return s
+				}())
+				return s
+			}(), _d})
+		}
+		_direct = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_direct(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_statementList = func(_env, _topLevel, _l interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _definitions interface{}
+			var _definitionBinding interface{}
+			var _annotateWith interface{}
+			mml.Nop(_definitions, _definitionBinding, _annotateWith)
+			_definitions = mml.Ref(_code, "getDefinitions").(*mml.Function).Call([]interface{}{_l})
+			_definitionBinding = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _boxed = a[0]
+					var _e = a[1]
+					var _d = a[2]
+					mml.Nop(_boxed, _e, _d)
+
+					mml.Nop()
+					switch {
+					case (_topLevel.(bool) && direct_direct(_d).(bool)):
+
+						mml.Nop()
+						return func() interface{} {
+							s := &mml.Struct{}
+							s.Set("directArgs", _len.(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_d, "expression"), "params")}))
+							return s
+						}()
+					case _has.(*mml.Function).Call([]interface{}{mml.Ref(_d, "symbol"), _boxed}):
+
+						mml.Nop()
+						return func() interface{} { s := &mml.Struct{}; ; return s }()
+					default:
+
+						mml.Nop()
+						return direct_binding(direct_typeOf(_envType.(*mml.Function).Call([]interface{}{_e}), mml.Ref(_d, "expression")))
+					}
+				},
+				FixedArgs: 3,
+			}
+			_annotateWith = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+				tailcall:
+					for {
+						var _boxed = a[0]
+						mml.Nop(_boxed)
+						var _scopeEnv interface{}
+						var _annotated interface{}
+						var _changing interface{}
+						mml.Nop(_scopeEnv, _annotated, _changing)
+						_scopeEnv = _fold.(*mml.Function).Call([]interface{}{&mml.Function{
+							F: func(a []interface{}) interface{} {
+								var c interface{}
+								mml.Nop(c)
+								var _d = a[0]
+								var _e = a[1]
+								mml.Nop(_d, _e)
+								return func() interface{} {
+									s := &mml.Struct{}
+									s.Merge(_e.(*mml.Struct))
+									s.Set(mml.Ref(_d, "symbol").(string), _definitionBinding.(*mml.Function).Call([]interface{}{_boxed, _e, _d}))
+									return s
+								}()
+							},
+							FixedArgs: 2,
+						}, direct_shadow(_env, mml.Ref(_code, "getScope").(*mml.Function).Call([]interface{}{_l})), _definitions})
+						_annotated = func() interface{} {
+							s := &mml.Struct{}
+							s.Merge(_l.(*mml.Struct))
+							s.Set("statements", _map.(*mml.Function).Call([]interface{}{_annotate.(*mml.Function).Call([]interface{}{_scopeEnv}), mml.Ref(_l, "statements")}))
+							return s
+						}()
+						_changing = _filter.(*mml.Function).Call([]interface{}{&mml.Function{
+							F: func(a []interface{}) interface{} {
+								var c interface{}
+								mml.Nop(c)
+								var _d = a[0]
+								mml.Nop(_d)
+								return ((mml.Ref(_d, "mutable").(bool) && _has.(*mml.Function).Call([]interface{}{"valueType", mml.Ref(_scopeEnv, mml.Ref(_d, "symbol"))}).(bool)) && !direct_keepsType(_annotated, mml.Ref(_d, "symbol")).(bool))
+							},
+							FixedArgs: 1,
+						}}).(*mml.Function).Call([]interface{}{_definitions})
+						c = mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_changing}), 0)
+						if c.(bool) {
+							return _annotated
+						} else {
+							a = []interface{}{_fold.(*mml.Function).Call([]interface{}{&mml.Function{
+								F: func(a []interface{}) interface{} {
+									var c interface{}
+									mml.Nop(c)
+									var _d = a[0]
+									var _b = a[1]
+									mml.Nop(_d, _b)
+									return func() interface{} {
+										s := &mml.Struct{}
+										s.Merge(_b.(*mml.Struct))
+										s.Set(mml.Ref(_d, "symbol").(string), true)
+										return s
+									}()
+								},
+								FixedArgs: 2,
+							}, _boxed, _changing})}
+							continue tailcall
+						}
+					}
+				},
+				FixedArgs: 1,
+			}
+			return _annotateWith.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; ; return s }()})
+		}
+		_statementList = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_statementList(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_loop = func(_env, _l interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _counter interface{}
+			var _loopEnv interface{}
+			mml.Nop(_counter, _loopEnv)
+			_counter = _or.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("type", "range-over")
+				s.Set("symbol", _any)
+				s.Set("expression", func() interface{} { s := &mml.Struct{}; s.Set("type", "range"); ; return s }())
+				return s
+			}(), _and.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "range-over"); s.Set("symbol", _any); ; return s }(), _not.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("expression", _any); ; return s }()})})})
+			_loopEnv = func() interface{} {
+				c = _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("expression", _counter); ; return s }(), _l})
+				if c.(bool) {
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_env.(*mml.Struct))
+						s.Set(mml.Ref(mml.Ref(_l, "expression"), "symbol").(string), func() interface{} { s := &mml.Struct{}; s.Set("valueType", "int"); ; return s }())
+						return s
+					}()
+				} else {
+					return func() interface{} {
+						c = _is.(*mml.Function).Call([]interface{}{func() interface{} {
+							s := &mml.Struct{}
+							s.Set("expression", func() interface{} { s := &mml.Struct{}; s.Set("type", "range-over"); s.Set("symbol", _any); ; return s }())
+							return s
+						}(), _l})
+						if c.(bool) {
+							return direct_shadow(_env, (&mml.List{}).Append(mml.Ref(mml.Ref(_l, "expression"), "symbol")))
+						} else {
+							return _env
+						}
+					}()
+				}
+			}()
+			return func() interface{} {
+				c = _has.(*mml.Function).Call([]interface{}{"expression", _l})
+				if c.(bool) {
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_l.(*mml.Struct))
+						s.Set("expression", direct_annotate(_env, mml.Ref(_l, "expression")))
+						s.Set("body", direct_annotate(_loopEnv, mml.Ref(_l, "body")))
+						return s
+					}()
+				} else {
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(_l.(*mml.Struct))
+						s.Set("body", direct_annotate(_loopEnv, mml.Ref(_l, "body")))
+						return s
+					}()
+				}
+			}()
+		}
+		_loop = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_loop(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_annotate = func(_env, _c interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _annotateChildren interface{}
+			mml.Nop(_annotateChildren)
+			_annotateChildren = mml.Ref(_codetree, "mapChildren").(*mml.Function).Call([]interface{}{_annotate.(*mml.Function).Call([]interface{}{_env})})
+			switch mml.Ref(_c, "type") {
+			case "symbol":
+
+				mml.Nop()
+				return func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(_c.(*mml.Struct))
+					s.Merge(direct_lookup(_env, mml.Ref(_c, "name")).(*mml.Struct))
+					return s
+				}()
+			case "definition":
+
+				mml.Nop()
+				return func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(_annotateChildren.(*mml.Function).Call([]interface{}{_c}).(*mml.Struct))
+					s.Merge(direct_lookup(_env, mml.Ref(_c, "symbol")).(*mml.Struct))
+					return s
+				}()
+			case "statement-list":
+
+				mml.Nop()
+				return direct_statementList(_env, false, _c)
+			case "module":
+
+				mml.Nop()
+				return func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(_c.(*mml.Struct))
+					s.Set("body", direct_statementList(_env, true, mml.Ref(_c, "body")))
+					return s
+				}()
+			case "function":
+
+				mml.Nop()
+				return mml.Ref(_codetree, "mapChildren").(*mml.Function).Call([]interface{}{_annotate.(*mml.Function).Call([]interface{}{direct_shadow(_env, (&mml.List{}).Concat(mml.Ref(_c, "params").(*mml.List)).Append(mml.Ref(_c, "collectParam")))}), _c})
+			case "loop":
+
+				mml.Nop()
+				return direct_loop(_env, _c)
+			case "select-case":
+
+				mml.Nop()
+				return func() interface{} {
+					c = _is.(*mml.Function).Call([]interface{}{func() interface{} {
+						s := &mml.Struct{}
+						s.Set("expression", func() interface{} { s := &mml.Struct{}; s.Set("type", "definition"); ; return s }())
+						return s
+					}(), _c})
+					if c.(bool) {
+						return mml.Ref(_codetree, "mapChildren").(*mml.Function).Call([]interface{}{_annotate.(*mml.Function).Call([]interface{}{direct_shadow(_env, (&mml.List{}).Append(mml.Ref(mml.Ref(_c, "expression"), "symbol")))}), _c})
+					} else {
+						return _annotateChildren.(*mml.Function).Call([]interface{}{_c})
+					}
+				}()
+			default:
+
+				mml.Nop()
+				return _annotateChildren.(*mml.Function).Call([]interface{}{_c})
+			}
+		}
+		_annotate = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_annotate(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_of = func(_c interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return direct_typeOf(&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _s = a[0]
+					mml.Nop(_s)
+					return func() interface{} {
+						c = _has.(*mml.Function).Call([]interface{}{"valueType", _s})
+						if c.(bool) {
+							return mml.Ref(_s, "valueType")
+						} else {
+							return ""
+						}
+					}()
+				},
+				FixedArgs: 1,
+			}, _c)
+		}
+		_of = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_of(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["of"] = _of
+		direct_do = func(_module interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return direct_annotate(func() interface{} { s := &mml.Struct{}; ; return s }(), _module)
+		}
+		_do = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_do(a[0])
+			},
+			FixedArgs: 1,
+		}
+		exports["do"] = _do
+
+		return exports
+	})
+
+	modulePath = "cache"
+
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
+		exports := make(map[string]interface{})
+
+		var c interface{}
+		mml.Nop(c)
+
+		var _moduleFileSuffix string
+		var _baseName interface{}
+		var _fileName interface{}
+		var _isModuleFile interface{}
+		var _hasHeader interface{}
+		var _headerValue interface{}
+		var _headerValues interface{}
+		var _takeWhile interface{}
+		var _cached interface{}
+		var _formatReexport interface{}
+		var _parseReexport interface{}
+		var _exports interface{}
+		var _scan interface{}
+		var _cacheKey interface{}
+		var _interfaceModule interface{}
+		var _parsedModule interface{}
+		var _implementation interface{}
+		var _linkedModule interface{}
+		var _moduleFile interface{}
+		var _setInterfaces interface{}
+		var _compileModule interface{}
+		var _compilerVersion interface{}
+		var _removeStale interface{}
+		var _sourceHeader string
+		var _keyHeader string
+		var _useHeader string
+		var _exportHeader string
+		var _reexportHeader string
+		var _build interface{}
 		var _strings interface{}
+		var _errors interface{}
+		var _io interface{}
 		var _code interface{}
-		var _lists interface{}
-		var _structs interface{}
-		var _snippets interface{}
 		var _codetree interface{}
+		var _paths interface{}
+		var _parse interface{}
+		var _read interface{}
+		var _compile interface{}
+		var _contracts interface{}
+		var _signatures interface{}
+		var _toolchain interface{}
+		var _constants interface{}
+		var _deadcode interface{}
+		var _tasks interface{}
 		var _fold interface{}
 		var _foldr interface{}
 		var _map interface{}
@@ -6472,8 +16753,6 @@ func init() {
 		var _sort interface{}
 		var _flat interface{}
 		var _flats interface{}
-		var _concat interface{}
-		var _concats interface{}
 		var _uniq interface{}
 		var _every interface{}
 		var _some interface{}
@@ -6500,1552 +16779,1933 @@ func init() {
 		var _and interface{}
 		var _not interface{}
 		var _predicate interface{}
-		var _predicates interface{}
 		var _is interface{}
-		mml.Nop(_primitive, _stringLiteral, _symbol, _spread, _list, _expressionKey, _struct, _getDefinitions, _getScope, _paramList, _functionLiteral, _indexer, _application, _unary, _binary, _ternary, _ifStatement, _cond, _caseBlock, _switchStatement, _sendStatement, _receiveExpression, _goStatement, _deferStatement, _selectStatement, _rangeOver, _loop, _definition, _definitionGroup, _assign, _ret, _checkRet, _useStatement, _useList, _module, _statementList, _do, _allModules, _intLiteral, _floatLiteral, _boolLiteral, _breakStatement, _continueStatement, _toGo, _strings, _code, _lists, _structs, _snippets, _codetree, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _flats, _concat, _concats, _uniq, _every, _some, _join, _joins, _formats, _enum, _log, _fatal, _bind, _identity, _eq, _any, _function, _channel, _natural, _type, _listOf, _structOf, _range, _rangeMin, _listLength, _or, _and, _not, _predicate, _predicates, _is)
-		var __lang = mml.Modules.Use("lang")
-		_fold = __lang.Values["fold"]
-		_foldr = __lang.Values["foldr"]
-		_map = __lang.Values["map"]
-		_filter = __lang.Values["filter"]
-		_contains = __lang.Values["contains"]
-		_sort = __lang.Values["sort"]
-		_flat = __lang.Values["flat"]
-		_flats = __lang.Values["flats"]
-		_concat = __lang.Values["concat"]
-		_concats = __lang.Values["concats"]
-		_uniq = __lang.Values["uniq"]
-		_every = __lang.Values["every"]
-		_some = __lang.Values["some"]
-		_join = __lang.Values["join"]
-		_joins = __lang.Values["joins"]
-		_formats = __lang.Values["formats"]
-		_enum = __lang.Values["enum"]
-		_log = __lang.Values["log"]
-		_fatal = __lang.Values["fatal"]
-		_bind = __lang.Values["bind"]
-		_identity = __lang.Values["identity"]
-		_eq = __lang.Values["eq"]
-		_any = __lang.Values["any"]
-		_function = __lang.Values["function"]
-		_channel = __lang.Values["channel"]
-		_natural = __lang.Values["natural"]
-		_type = __lang.Values["type"]
-		_listOf = __lang.Values["listOf"]
-		_structOf = __lang.Values["structOf"]
-		_range = __lang.Values["range"]
-		_rangeMin = __lang.Values["rangeMin"]
-		_listLength = __lang.Values["listLength"]
-		_or = __lang.Values["or"]
-		_and = __lang.Values["and"]
-		_not = __lang.Values["not"]
-		_predicate = __lang.Values["predicate"]
-		_predicates = __lang.Values["predicates"]
-		_is = __lang.Values["is"]
-		_strings = mml.Modules.Use("strings")
-		_code = mml.Modules.Use("code")
-		_lists = mml.Modules.Use("lists")
-		_structs = mml.Modules.Use("structs")
-		_snippets = mml.Modules.Use("snippets")
-		_codetree = mml.Modules.Use("codetree")
-		_primitive = &mml.Function{
+		var direct_baseName func(interface{}) interface{}
+		mml.Nop(direct_baseName)
+		var direct_fileName func(interface{}, interface{}) interface{}
+		mml.Nop(direct_fileName)
+		var direct_isModuleFile func(interface{}) interface{}
+		mml.Nop(direct_isModuleFile)
+		var direct_hasHeader func(interface{}, interface{}) interface{}
+		mml.Nop(direct_hasHeader)
+		var direct_headerValue func(interface{}, interface{}) interface{}
+		mml.Nop(direct_headerValue)
+		var direct_headerValues func(interface{}, interface{}) interface{}
+		mml.Nop(direct_headerValues)
+		var direct_takeWhile func(interface{}, interface{}) interface{}
+		mml.Nop(direct_takeWhile)
+		var direct_cached func(interface{}, interface{}) interface{}
+		mml.Nop(direct_cached)
+		var direct_formatReexport func(interface{}) interface{}
+		mml.Nop(direct_formatReexport)
+		var direct_parseReexport func(interface{}) interface{}
+		mml.Nop(direct_parseReexport)
+		var direct_exports func(interface{}) interface{}
+		mml.Nop(direct_exports)
+		var direct_scan func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_scan)
+		var direct_cacheKey func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_cacheKey)
+		var direct_interfaceModule func(interface{}, interface{}) interface{}
+		mml.Nop(direct_interfaceModule)
+		var direct_parsedModule func(interface{}, interface{}) interface{}
+		mml.Nop(direct_parsedModule)
+		var direct_implementation func(interface{}, interface{}) interface{}
+		mml.Nop(direct_implementation)
+		var direct_linkedModule func(interface{}, interface{}) interface{}
+		mml.Nop(direct_linkedModule)
+		var direct_moduleFile func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_moduleFile)
+		var direct_setInterfaces func(interface{}) interface{}
+		mml.Nop(direct_setInterfaces)
+		var direct_compileModule func(interface{}, interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_compileModule)
+		var direct_compilerVersion func() interface{}
+		mml.Nop(direct_compilerVersion)
+		var direct_removeStale func(interface{}, interface{}) interface{}
+		mml.Nop(direct_removeStale)
+		var direct_build func(interface{}, interface{}) interface{}
+		mml.Nop(direct_build)
+		mml.Nop(_moduleFileSuffix, _baseName, _fileName, _isModuleFile, _hasHeader, _headerValue, _headerValues, _takeWhile, _cached, _formatReexport, _parseReexport, _exports, _scan, _cacheKey, _interfaceModule, _parsedModule, _implementation, _linkedModule, _moduleFile, _setInterfaces, _compileModule, _compilerVersion, _removeStale, _sourceHeader, _keyHeader, _useHeader, _exportHeader, _reexportHeader, _build, _strings, _errors, _io, _code, _codetree, _paths, _parse, _read, _compile, _contracts, _signatures, _toolchain, _constants, _deadcode, _tasks, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _flats, _uniq, _every, _some, _join, _joins, _formats, _enum, _log, _fatal, _bind, _identity, _eq, _any, _function, _channel, _natural, _type, _listOf, _structOf, _range, _rangeMin, _listLength, _or, _and, _not, _predicate, _is)
+		var __lang = use("lang")
+		_fold = __lang.Get("fold")
+		_foldr = __lang.Get("foldr")
+		_map = __lang.Get("map")
+		_filter = __lang.Get("filter")
+		_contains = __lang.Get("contains")
+		_sort = __lang.Get("sort")
+		_flat = __lang.Get("flat")
+		_flats = __lang.Get("flats")
+		_uniq = __lang.Get("uniq")
+		_every = __lang.Get("every")
+		_some = __lang.Get("some")
+		_join = __lang.Get("join")
+		_joins = __lang.Get("joins")
+		_formats = __lang.Get("formats")
+		_enum = __lang.Get("enum")
+		_log = __lang.Get("log")
+		_fatal = __lang.Get("fatal")
+		_bind = __lang.Get("bind")
+		_identity = __lang.Get("identity")
+		_eq = __lang.Get("eq")
+		_any = __lang.Get("any")
+		_function = __lang.Get("function")
+		_channel = __lang.Get("channel")
+		_natural = __lang.Get("natural")
+		_type = __lang.Get("type")
+		_listOf = __lang.Get("listOf")
+		_structOf = __lang.Get("structOf")
+		_range = __lang.Get("range")
+		_rangeMin = __lang.Get("rangeMin")
+		_listLength = __lang.Get("listLength")
+		_or = __lang.Get("or")
+		_and = __lang.Get("and")
+		_not = __lang.Get("not")
+		_predicate = __lang.Get("predicate")
+		_is = __lang.Get("is")
+		_strings = use("strings")
+		_errors = use("errors")
+		_io = use("io")
+		_code = use("code")
+		_codetree = use("codetree")
+		_paths = use("paths")
+		_parse = use("parse")
+		_read = use("read")
+		_compile = use("compile")
+		_contracts = use("contracts")
+		_signatures = use("signatures")
+		_toolchain = use("toolchain")
+		_constants = use("constants")
+		_deadcode = use("deadcode")
+		_tasks = use("tasks")
+		_sourceHeader = "// source: "
+		_keyHeader = "// key: "
+		_useHeader = "// use: "
+		_exportHeader = "// export: "
+		_reexportHeader = "// reexport: "
+		_moduleFileSuffix = ".mml.go"
+		direct_baseName = func(_path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _formats.(*mml.Function).Call([]interface{}{"%s%s", mml.Ref(_strings, "hash").(*mml.Function).Call([]interface{}{_path}), _moduleFileSuffix})
+		}
+		_baseName = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _code = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_code)
-				return _string.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_code, "value"))}).Values)
+				return direct_baseName(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_intLiteral = _primitive
-		_floatLiteral = _primitive
-		_boolLiteral = _primitive
-		_stringLiteral = &mml.Function{
+		direct_fileName = func(_dir, _path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _formats.(*mml.Function).Call([]interface{}{"%s/%s", _dir, direct_baseName(_path)})
+		}
+		_fileName = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_s)
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "\"%s\"", mml.Ref(_strings, "escape").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_s, "value"))}).Values))}).Values)
+				return direct_fileName(a[0], a[1])
 			},
-			FixedArgs: 1,
+			FixedArgs: 2,
 		}
-		_symbol = &mml.Function{
+		direct_isModuleFile = func(_name interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return (mml.BinaryOp(15, _len.(*mml.Function).Call([]interface{}{_name}), _len.(*mml.Function).Call([]interface{}{_moduleFileSuffix})).(bool) && mml.BinaryOp(11, mml.RefRange(_name, mml.BinaryOp(10, _len.(*mml.Function).Call([]interface{}{_name}), _len.(*mml.Function).Call([]interface{}{_moduleFileSuffix})), nil), _moduleFileSuffix).(bool))
+		}
+		_isModuleFile = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_s)
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "_%s", mml.Ref(_s, "name"))}).Values)
+				return direct_isModuleFile(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_spread = &mml.Function{
+		direct_hasHeader = func(_header, _line interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return (mml.BinaryOp(16, _len.(*mml.Function).Call([]interface{}{_line}), _len.(*mml.Function).Call([]interface{}{_header})).(bool) && mml.BinaryOp(11, mml.RefRange(_line, nil, _len.(*mml.Function).Call([]interface{}{_header})), _header).(bool))
+		}
+		_hasHeader = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_s)
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "%s.(*mml.List).Values...", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_s, "value"))}).Values))}).Values)
+				return direct_hasHeader(a[0], a[1])
 			},
-			FixedArgs: 1,
+			FixedArgs: 2,
 		}
-		_list = &mml.Function{
+		direct_headerValue = func(_header, _line interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return mml.RefRange(_line, _len.(*mml.Function).Call([]interface{}{_header}), nil)
+		}
+		_headerValue = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _l = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_l)
-				var _groupSpread interface{}
-				var _appendGroup interface{}
-				var _isSpread interface{}
-				var _selectSpread interface{}
-				var _appendSimples interface{}
-				var _appendSpread interface{}
-				var _appendSpreads interface{}
-				var _appendGroups interface{}
-				mml.Nop(_groupSpread, _appendGroup, _isSpread, _selectSpread, _appendSimples, _appendSpread, _appendSpreads, _appendGroups)
-				_isSpread = &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _c = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_c)
-						return (mml.BinaryOp(15, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _c)}).Values), 3).(bool) && mml.BinaryOp(11, mml.RefRange(_c, mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _c)}).Values), 3), nil), "...").(bool))
-					},
-					FixedArgs: 1,
-				}
-				_selectSpread = &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _c = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_c)
-						return func() interface{} {
-							c = _isSpread.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _c)}).Values)
-							if c.(bool) {
-								return func() interface{} {
-									s := &mml.Struct{Values: make(map[string]interface{})}
-									s.Values["spread"] = _c
-									return s
-								}()
-							} else {
-								return _c
-							}
-						}()
-					},
-					FixedArgs: 1,
-				}
-				_groupSpread = _fold.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _item = a[0]
-						var _groups = a[1]
-						var _ interface{}
-						_ = &mml.List{a[2:]}
-						mml.Nop(_item, _groups)
-						var _i interface{}
-						var _isSpread interface{}
-						var _groupIsSpread interface{}
-						var _appendNewSimple interface{}
-						var _appendNewSpread interface{}
-						var _appendSimple interface{}
-						var _appendSpread interface{}
-						mml.Nop(_i, _isSpread, _groupIsSpread, _appendNewSimple, _appendNewSpread, _appendSimple, _appendSpread)
-						_i = mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _groups)}).Values), 1)
-						_isSpread = _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "spread", _item)}).Values)
-						_groupIsSpread = (mml.BinaryOp(16, _i, 0).(bool) && _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "spread", mml.Ref(_groups, _i))}).Values).(bool))
-						_appendNewSimple = &mml.Function{
-							F: func(a []interface{}) interface{} {
-								var c interface{}
-								mml.Nop(c)
-								var _ interface{}
-								_ = &mml.List{a[0:]}
-								mml.Nop()
-								return &mml.List{Values: append(append([]interface{}{}, _groups.(*mml.List).Values...), func() interface{} {
-									s := &mml.Struct{Values: make(map[string]interface{})}
-									s.Values["simple"] = &mml.List{Values: append([]interface{}{}, _item)}
-									return s
-								}())}
-							},
-							FixedArgs: 0,
-						}
-						_appendNewSpread = &mml.Function{
-							F: func(a []interface{}) interface{} {
-								var c interface{}
-								mml.Nop(c)
-								var _ interface{}
-								_ = &mml.List{a[0:]}
-								mml.Nop()
-								return &mml.List{Values: append(append([]interface{}{}, _groups.(*mml.List).Values...), func() interface{} {
-									s := &mml.Struct{Values: make(map[string]interface{})}
-									s.Values["spread"] = &mml.List{Values: append([]interface{}{}, mml.Ref(_item, "spread"))}
-									return s
-								}())}
-							},
-							FixedArgs: 0,
-						}
-						_appendSimple = &mml.Function{
-							F: func(a []interface{}) interface{} {
-								var c interface{}
-								mml.Nop(c)
-								var _ interface{}
-								_ = &mml.List{a[0:]}
-								mml.Nop()
-								return &mml.List{Values: append(append([]interface{}{}, mml.RefRange(_groups, nil, _i).(*mml.List).Values...), func() interface{} {
-									s := &mml.Struct{Values: make(map[string]interface{})}
-									s.Values["simple"] = &mml.List{Values: append(append([]interface{}{}, mml.Ref(mml.Ref(_groups, _i), "simple").(*mml.List).Values...), _item)}
-									return s
-								}())}
-							},
-							FixedArgs: 0,
-						}
-						_appendSpread = &mml.Function{
-							F: func(a []interface{}) interface{} {
-								var c interface{}
-								mml.Nop(c)
-								var _ interface{}
-								_ = &mml.List{a[0:]}
-								mml.Nop()
-								return &mml.List{Values: append(append([]interface{}{}, mml.RefRange(_groups, nil, _i).(*mml.List).Values...), func() interface{} {
-									s := &mml.Struct{Values: make(map[string]interface{})}
-									s.Values["spread"] = &mml.List{Values: append(append([]interface{}{}, mml.Ref(mml.Ref(_groups, _i), "spread").(*mml.List).Values...), mml.Ref(_item, "spread"))}
-									return s
-								}())}
-							},
-							FixedArgs: 0,
-						}
-						switch {
-						case ((mml.BinaryOp(13, _i, 0).(bool) || _groupIsSpread.(bool)) && !_isSpread.(bool)):
-
-							mml.Nop()
-							return _appendNewSimple.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-						case ((mml.BinaryOp(13, _i, 0).(bool) || !_groupIsSpread.(bool)) && _isSpread.(bool)):
-
-							mml.Nop()
-							return _appendNewSpread.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-						case (!_groupIsSpread.(bool) && !_isSpread.(bool)):
-
-							mml.Nop()
-							return _appendSimple.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-						case (_groupIsSpread.(bool) && _isSpread.(bool)):
+				return direct_headerValue(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_headerValues = func(_header, _lines interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _map.(*mml.Function).Call([]interface{}{_headerValue.(*mml.Function).Call([]interface{}{_header})}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_hasHeader.(*mml.Function).Call([]interface{}{_header})}).(*mml.Function).Call([]interface{}{_lines})})
+		}
+		_headerValues = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_headerValues(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_takeWhile = func(_p, _l interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _taken interface{}
+			mml.Nop(_taken)
+			_taken = (&mml.List{})
+			for _, _i := range _l.(*mml.List).Values() {
 
-							mml.Nop()
-							return _appendSpread.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-						}
-						return nil
-					},
-					FixedArgs: 2,
-				}, &mml.List{Values: []interface{}{}})}).Values)
-				_appendSimples = &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _code = a[0]
-						var _group = a[1]
-						var _ interface{}
-						_ = &mml.List{a[2:]}
-						mml.Nop(_code, _group)
-						return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "append(%s, %s)", _code, _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, ", ", _group)}).Values))}).Values)
-					},
-					FixedArgs: 2,
-				}
-				_appendSpread = &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _item = a[0]
-						var _code = a[1]
-						var _ interface{}
-						_ = &mml.List{a[2:]}
-						mml.Nop(_item, _code)
-						return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "append(%s, %s)", _code, _item)}).Values)
-					},
-					FixedArgs: 2,
-				}
-				_appendSpreads = &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _code = a[0]
-						var _group = a[1]
-						var _ interface{}
-						_ = &mml.List{a[2:]}
-						mml.Nop(_code, _group)
-						return _fold.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _appendSpread, _code, _group)}).Values)
-					},
-					FixedArgs: 2,
-				}
-				_appendGroups = &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _groups = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_groups)
-						return _fold.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _appendGroup, "[]interface{}{}", _groups)}).Values)
-					},
-					FixedArgs: 1,
+				mml.Nop()
+				if !_p.(*mml.Function).Call([]interface{}{_i}).(bool) {
+					mml.Nop()
+					break
 				}
-				_appendGroup = &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _group = a[0]
-						var _code = a[1]
-						var _ interface{}
-						_ = &mml.List{a[2:]}
-						mml.Nop(_group, _code)
-						return func() interface{} {
-							c = _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "spread", _group)}).Values)
-							if c.(bool) {
-								return _appendSpreads.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code, mml.Ref(_group, "spread"))}).Values)
-							} else {
-								return _appendSimples.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code, mml.Ref(_group, "simple"))}).Values)
-							}
-						}()
-					},
-					FixedArgs: 2,
+				_taken = (&mml.List{}).Concat(_taken.(*mml.List)).Append(_i)
+			}
+			return _taken
+		}
+		_takeWhile = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_takeWhile(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_cached = func(_dir, _path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _text interface{}
+			var _lines interface{}
+			var _header interface{}
+			mml.Nop(_text, _lines, _header)
+			_text = mml.Ref(_io, "readFile").(*mml.Function).Call([]interface{}{direct_fileName(_dir, _path)})
+			c = _isError.(*mml.Function).Call([]interface{}{_text})
+			if c.(bool) {
+				mml.Nop()
+				return func() interface{} { s := &mml.Struct{}; ; return s }()
+			}
+			_lines = mml.Ref(_strings, "split").(*mml.Function).Call([]interface{}{"\n", _text})
+			if (mml.BinaryOp(13, _len.(*mml.Function).Call([]interface{}{_lines}), 2).(bool) || !direct_hasHeader(_sourceHeader, mml.Ref(_lines, 0)).(bool)) || !direct_hasHeader(_keyHeader, mml.Ref(_lines, 1)).(bool) {
+				mml.Nop()
+				return func() interface{} { s := &mml.Struct{}; ; return s }()
+			}
+			_header = direct_takeWhile(_hasHeader.(*mml.Function).Call([]interface{}{"// "}), _lines)
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Set("source", direct_headerValue(_sourceHeader, mml.Ref(_lines, 0)))
+				s.Set("key", direct_headerValue(_keyHeader, mml.Ref(_lines, 1)))
+				s.Set("uses", direct_headerValues(_useHeader, _header))
+				s.Set("exports", direct_headerValues(_exportHeader, _header))
+				s.Set("reexports", _map.(*mml.Function).Call([]interface{}{_parseReexport}).(*mml.Function).Call([]interface{}{direct_headerValues(_reexportHeader, _header)}))
+				return s
+			}()
+		}
+		_cached = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_cached(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_formatReexport = func(_r interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				c = _has.(*mml.Function).Call([]interface{}{"selection", _r})
+				if c.(bool) {
+					return _join.(*mml.Function).Call([]interface{}{" ", (&mml.List{}).Append(mml.Ref(_r, "path")).Concat(mml.Ref(_r, "selection").(*mml.List))})
+				} else {
+					return mml.Ref(_r, "path")
 				}
-				return (&mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _c = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_c)
-						return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "&mml.List{Values: %s}", _c)}).Values)
-					},
-					FixedArgs: 1,
-				}).Call((&mml.List{Values: append([]interface{}{}, _appendGroups.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _groupSpread.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _selectSpread)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _do)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_l, "values"))}).Values))}).Values))}).Values))}).Values))}).Values)
-				return nil
+			}()
+		}
+		_formatReexport = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_formatReexport(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_expressionKey = &mml.Function{
+		direct_parseReexport = func(_value interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _parts interface{}
+			mml.Nop(_parts)
+			_parts = mml.Ref(_strings, "split").(*mml.Function).Call([]interface{}{" ", _value})
+			return func() interface{} {
+				c = mml.BinaryOp(15, _len.(*mml.Function).Call([]interface{}{_parts}), 1)
+				if c.(bool) {
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Set("path", mml.Ref(_parts, 0))
+						s.Set("selection", mml.RefRange(_parts, 1, nil))
+						return s
+					}()
+				} else {
+					return func() interface{} { s := &mml.Struct{}; s.Set("path", mml.Ref(_parts, 0)); ; return s }()
+				}
+			}()
+		}
+		_parseReexport = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _k = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_k)
-				return _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_k, "value"))}).Values)
+				return direct_parseReexport(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_struct = &mml.Function{
+		direct_exports = func(_moduleCode interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _sort.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _left = a[0]
+					var _right = a[1]
+					mml.Nop(_left, _right)
+					return mml.BinaryOp(13, _left, _right)
+				},
+				FixedArgs: 2,
+			}}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _d = a[0]
+					mml.Nop(_d)
+					return mml.Ref(_d, "symbol")
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("exported", true); ; return s }()})}).(*mml.Function).Call([]interface{}{mml.Ref(_code, "getDefinitions").(*mml.Function).Call([]interface{}{mml.Ref(_moduleCode, "body")})})})})
+		}
+		_exports = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_s)
-				var _entry interface{}
-				mml.Nop(_entry)
-				_entry = &mml.Function{
+				return direct_exports(a[0])
+			},
+			FixedArgs: 1,
+		}
+		direct_scan = func(_searchPath, _dir, _path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _text interface{}
+			var _changed bool
+			var _moduleCode interface{}
+			var _sourceUses interface{}
+			var _located interface{}
+			var _interfaces interface{}
+			var _source interface{}
+			var _previous interface{}
+			mml.Nop(_text, _changed, _moduleCode, _sourceUses, _located, _interfaces, _source, _previous)
+			_text = mml.Ref(_read, "readSource").(*mml.Function).Call([]interface{}{_path})
+			if v := _text; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_source = mml.Ref(_strings, "hash").(*mml.Function).Call([]interface{}{_text})
+			_previous = direct_cached(_dir, _path)
+			_changed = !_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("source", _source); ; return s }(), _previous}).(bool)
+			_moduleCode = func() interface{} {
+				if _changed {
+					return mml.Ref(_parse, "do").(*mml.Function).Call([]interface{}{mml.Ref(_read, "fileName").(*mml.Function).Call([]interface{}{_path}), _text})
+				} else {
+					return func() interface{} { s := &mml.Struct{}; ; return s }()
+				}
+			}()
+			if v := _moduleCode; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_sourceUses = func() interface{} {
+				if _changed {
+					return mml.Ref(_read, "uses").(*mml.Function).Call([]interface{}{_moduleCode})
+				} else {
+					return mml.Ref(_previous, "uses")
+				}
+			}()
+			_located = mml.Ref(_read, "locateAll").(*mml.Function).Call([]interface{}{_searchPath, _path, _sourceUses, func() interface{} {
+				if _changed {
+					return mml.Ref(_read, "usePositions").(*mml.Function).Call([]interface{}{_moduleCode})
+				} else {
+					return func() interface{} { s := &mml.Struct{}; ; return s }()
+				}
+			}()})
+			if v := _located; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_interfaces = mml.Ref(_read, "readInterfaces").(*mml.Function).Call([]interface{}{_searchPath, _path, _located})
+			if v := _interfaces; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Set("text", _text)
+				s.Set("source", _source)
+				s.Set("sourceUses", _sourceUses)
+				s.Set("located", _located)
+				s.Set("uses", _map.(*mml.Function).Call([]interface{}{&mml.Function{
 					F: func(a []interface{}) interface{} {
 						var c interface{}
 						mml.Nop(c)
-						var _e = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_e)
-						var _v interface{}
-						mml.Nop(_v)
-						_v = _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_e, "value"))}).Values)
-						switch mml.Ref(_e, "type") {
-						case "spread":
-							var _var interface{}
-							var _assign interface{}
-							mml.Nop(_var, _assign)
-							_var = _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "sp := %s.(*mml.Struct);", _v)}).Values)
-							_assign = "for k, v := range sp.Values { s.Values[k] = v };"
-							return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "func() { %s; %s }();\n", _var, _assign)}).Values)
-						default:
-
-							mml.Nop()
-							switch mml.Ref(mml.Ref(_e, "key"), "type") {
-							case "string":
-
-								mml.Nop()
-								return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "s.Values[%s] = %s;", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_e, "key"))}).Values), _v)}).Values)
-							case "symbol":
-
-								mml.Nop()
-								return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "s.Values[\"%s\"] = %s;", mml.Ref(mml.Ref(_e, "key"), "name"), _v)}).Values)
-							default:
-
-								mml.Nop()
-								return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "s.Values[%s.(string)] = %s;", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_e, "key"))}).Values), _v)}).Values)
-							}
-						}
-						return nil
+						var _u = a[0]
+						mml.Nop(_u)
+						return mml.Ref(_located, _u)
 					},
 					FixedArgs: 1,
-				}
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; %s; return s }()", _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _entry)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_s, "entries"))}).Values))}).Values))}).Values)
-				return nil
+				}, _sourceUses}))
+				s.Set("positions", func() interface{} {
+					if _changed {
+						return mml.Ref(_read, "usePositions").(*mml.Function).Call([]interface{}{mml.Ref(_read, "setPaths").(*mml.Function).Call([]interface{}{_located, _moduleCode})})
+					} else {
+						return func() interface{} { s := &mml.Struct{}; ; return s }()
+					}
+				}())
+				s.Set("interfaces", _interfaces)
+				s.Set("exports", func() interface{} {
+					if _changed {
+						return direct_exports(_moduleCode)
+					} else {
+						return mml.Ref(_previous, "exports")
+					}
+				}())
+				s.Set("reexports", func() interface{} {
+					if _changed {
+						return mml.Ref(_read, "reexports").(*mml.Function).Call([]interface{}{_moduleCode})
+					} else {
+						return mml.Ref(_previous, "reexports")
+					}
+				}())
+				s.Set("previous", _previous)
+				s.Set("code", _moduleCode)
+				return s
+			}()
+		}
+		_scan = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_scan(a[0], a[1], a[2])
 			},
-			FixedArgs: 1,
+			FixedArgs: 3,
 		}
-		_getDefinitions = &mml.Function{
+		direct_cacheKey = func(_version, _scanned, _path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return mml.Ref(_strings, "hash").(*mml.Function).Call([]interface{}{_join.(*mml.Function).Call([]interface{}{"\n", (&mml.List{}).Append(_version, mml.Ref(mml.Ref(_scanned, _path), "source")).Concat(_map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _u = a[0]
+					mml.Nop(_u)
+					return _join.(*mml.Function).Call([]interface{}{" ", (&mml.List{}).Append(_u).Concat(mml.Ref(mml.Ref(_scanned, _u), "interface").(*mml.List))})
+				},
+				FixedArgs: 1,
+			}, mml.Ref(mml.Ref(_scanned, _path), "uses")}).(*mml.List))})})
+		}
+		_cacheKey = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _statementList = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_statementList)
-				var _definitions interface{}
-				var _definitionsFromGroups interface{}
-				mml.Nop(_definitions, _definitionsFromGroups)
-				_definitions = _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["type"] = "definition"
-					return s
-				}())}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_statementList, "statements"))}).Values)
-				_definitionsFromGroups = _flat.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_structs, "get").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "definitions")}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["type"] = "definition-group"
+				return direct_cacheKey(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_interfaceModule = func(_path, _names interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Set("type", "module")
+				s.Set("path", _path)
+				s.Set("body", func() interface{} {
+					s := &mml.Struct{}
+					s.Set("type", "statement-list")
+					s.Set("statements", _map.(*mml.Function).Call([]interface{}{&mml.Function{
+						F: func(a []interface{}) interface{} {
+							var c interface{}
+							mml.Nop(c)
+							var _name = a[0]
+							mml.Nop(_name)
+							return func() interface{} {
+								s := &mml.Struct{}
+								s.Set("type", "definition")
+								s.Set("symbol", _name)
+								s.Set("exported", true)
+								s.Set("mutable", false)
+								return s
+							}()
+						},
+						FixedArgs: 1,
+					}, _names}))
 					return s
-				}())}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_statementList, "statements"))}).Values))}).Values))}).Values)
-				return &mml.List{Values: append(append([]interface{}{}, _definitions.(*mml.List).Values...), _definitionsFromGroups.(*mml.List).Values...)}
-				return nil
-			},
-			FixedArgs: 1,
+				}())
+				return s
+			}()
 		}
-		_getScope = &mml.Function{
+		_interfaceModule = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _statementList = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_statementList)
-				var _definitions interface{}
-				var _uses interface{}
-				var _inlineUses interface{}
-				var _unnamedUses interface{}
-				var _namedUses interface{}
-				mml.Nop(_definitions, _uses, _inlineUses, _unnamedUses, _namedUses)
-				_definitions = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_structs, "get").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "symbol")}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _getDefinitions.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _statementList)}).Values))}).Values)
-				_uses = _flat.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_structs, "get").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "uses")}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["type"] = "use-list"
-					return s
-				}())}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_statementList, "statements"))}).Values))}).Values))}).Values)
-				_unnamedUses = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_structs, "get").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "value")}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_structs, "get").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "path")}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _not.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["capture"] = _any
-					return s
-				}())}).Values))}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _uses)}).Values))}).Values))}).Values)
-				_namedUses = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_structs, "get").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "capture")}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["capture"] = _not.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, ".")}).Values)
-					return s
-				}())}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _uses)}).Values))}).Values)
-				_inlineUses = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_structs, "get").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "symbol")}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["exported"] = true
-					return s
-				}())}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _flat.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _getDefinitions)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_structs, "get").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "body")}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_structs, "get").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "module")}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["capture"] = "."
-					return s
-				}())}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _uses)}).Values))}).Values))}).Values))}).Values))}).Values))}).Values))}).Values)
-				return _flats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _definitions, _unnamedUses, _namedUses, _inlineUses)}).Values)
-				return nil
+				return direct_interfaceModule(a[0], a[1])
 			},
-			FixedArgs: 1,
+			FixedArgs: 2,
 		}
-		_paramList = &mml.Function{
+		direct_parsedModule = func(_scanned, _path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _m interface{}
+			mml.Nop(_m)
+			_m = mml.Ref(_scanned, _path)
+			return func() interface{} {
+				c = _has.(*mml.Function).Call([]interface{}{"type", mml.Ref(_m, "code")})
+				if c.(bool) {
+					return mml.Ref(_m, "code")
+				} else {
+					return mml.Ref(_parse, "do").(*mml.Function).Call([]interface{}{mml.Ref(_read, "fileName").(*mml.Function).Call([]interface{}{_path}), mml.Ref(_m, "text")})
+				}
+			}()
+		}
+		_parsedModule = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _params = a[0]
-				var _collectParam = a[1]
-				var _ interface{}
-				_ = &mml.List{a[2:]}
-				mml.Nop(_params, _collectParam)
-				var _paramFormat interface{}
-				var _collectParamFormat interface{}
-				var _paramsString interface{}
-				var _collectParamString interface{}
-				mml.Nop(_paramFormat, _collectParamFormat, _paramsString, _collectParamString)
-				_paramFormat = "var _%s = a[%d]"
-				_collectParamFormat = "var _%s interface{}; _%s = &mml.List{a[%d:]}"
-				_paramsString = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _i = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_i)
-						return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _paramFormat, mml.Ref(_params, _i), _i)}).Values)
-					},
-					FixedArgs: 1,
-				})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_lists, "indexes").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _params)}).Values))}).Values)
-				_collectParamString = _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _collectParamFormat, _collectParam, _collectParam, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _params)}).Values))}).Values)
-				return _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, ";\n", &mml.List{Values: append(append([]interface{}{}, _paramsString.(*mml.List).Values...), _collectParamString)})}).Values)
-				return nil
+				return direct_parsedModule(a[0], a[1])
 			},
 			FixedArgs: 2,
 		}
-		_functionLiteral = &mml.Function{
+		direct_implementation = func(_scanned, _path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _moduleCode interface{}
+			mml.Nop(_moduleCode)
+			_moduleCode = direct_parsedModule(_scanned, _path)
+			if v := _moduleCode; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Merge(mml.Ref(_contracts, "implementation").(*mml.Function).Call([]interface{}{_moduleCode}).(*mml.Struct))
+				s.Set("names", mml.Ref(mml.Ref(_scanned, _path), "interface"))
+				return s
+			}()
+		}
+		_implementation = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _f = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_f)
-				var _paramNames interface{}
-				var _statementListFormat interface{}
-				var _expressionFormat interface{}
-				mml.Nop(_paramNames, _statementListFormat, _expressionFormat)
-				_paramNames = func() interface{} {
-					c = mml.BinaryOp(11, mml.Ref(_f, "collectParam"), "")
-					if c.(bool) {
-						return mml.Ref(_f, "params")
-					} else {
-						return &mml.List{Values: append(append([]interface{}{}, mml.Ref(_f, "params").(*mml.List).Values...), mml.Ref(_f, "collectParam"))}
-					}
-				}()
-				_statementListFormat = "&mml.Function{\n\t\tF: func(a []interface{}) interface{} {\n\t\t\tvar c interface{}\n\t\t\tmml.Nop(c)\n\t\t\t%s;\n\t\t\tmml.Nop(%s);\n\t\t\t%s;\n\t\t\treturn nil\n\t\t},\n\t\tFixedArgs: %d,\n\t}"
-				_expressionFormat = "&mml.Function{\n\t\tF: func(a []interface{}) interface{} {\n\t\t\tvar c interface{}\n\t\t\tmml.Nop(c)\n\t\t\t%s;\n\t\t\tmml.Nop(%s);\n\t\t\treturn %s\n\t\t},\n\t\tFixedArgs: %d,\n\t}"
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					c = _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-						s := &mml.Struct{Values: make(map[string]interface{})}
-						s.Values["type"] = "statement-list"
-						return s
-					}(), mml.Ref(_f, "body"))}).Values)
-					if c.(bool) {
-						return _statementListFormat
-					} else {
-						return _expressionFormat
-					}
-				}(), _paramList.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_f, "params"), mml.Ref(_f, "collectParam"))}).Values), _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, ", ", _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_strings, "formatOne").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "_%s")}).Values), &mml.List{Values: append([]interface{}{}, _paramNames.(*mml.List).Values...)})}).Values))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_f, "body"))}).Values), _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_f, "params"))}).Values))}).Values)
-				return nil
+				return direct_implementation(a[0], a[1])
 			},
-			FixedArgs: 1,
+			FixedArgs: 2,
 		}
-		_indexer = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _i = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_i)
-
-				mml.Nop()
-				switch {
-				case _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["type"] = "range"
-					return s
-				}(), mml.Ref(_i, "index"))}).Values):
-
-					mml.Nop()
-					return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "mml.RefRange(%s, %s, %s)", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_i, "expression"))}).Values), func() interface{} {
-						c = _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-							s := &mml.Struct{Values: make(map[string]interface{})}
-							s.Values["from"] = _any
-							return s
-						}(), mml.Ref(_i, "index"))}).Values)
-						if c.(bool) {
-							return _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_i, "index"), "from"))}).Values)
-						} else {
-							return "nil"
-						}
-					}(), func() interface{} {
-						c = _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-							s := &mml.Struct{Values: make(map[string]interface{})}
-							s.Values["to"] = _any
-							return s
-						}(), mml.Ref(_i, "index"))}).Values)
+		direct_linkedModule = func(_scanned, _path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _m interface{}
+			var _moduleCode interface{}
+			var _setUsedModule interface{}
+			var _withUsedModules interface{}
+			var _linked interface{}
+			mml.Nop(_m, _moduleCode, _setUsedModule, _withUsedModules, _linked)
+			_m = mml.Ref(_scanned, _path)
+			_moduleCode = direct_parsedModule(_scanned, _path)
+			if v := _moduleCode; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_setUsedModule = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _c = a[0]
+					mml.Nop(_c)
+					return func() interface{} {
+						c = _is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "use"); ; return s }(), _c})
 						if c.(bool) {
-							return _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_i, "index"), "to"))}).Values)
+							return func() interface{} {
+								s := &mml.Struct{}
+								s.Merge(_c.(*mml.Struct))
+								s.Set("module", direct_interfaceModule(mml.Ref(mml.Ref(_c, "path"), "value"), mml.Ref(mml.Ref(_scanned, mml.Ref(mml.Ref(_c, "path"), "value")), "interface")))
+								return s
+							}()
 						} else {
-							return "nil"
+							return _c
 						}
-					}())}).Values)
-				case _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["type"] = "symbol-index"
-					return s
-				}(), mml.Ref(_i, "index"))}).Values):
-
-					mml.Nop()
-					return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "mml.Ref(%s, \"%s\")", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_i, "expression"))}).Values), mml.Ref(mml.Ref(mml.Ref(_i, "index"), "symbol"), "name"))}).Values)
-				default:
-
-					mml.Nop()
-					return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "mml.Ref(%s, %s)", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_i, "expression"))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_i, "index"))}).Values))}).Values)
-				}
-				return nil
-			},
-			FixedArgs: 1,
+					}()
+				},
+				FixedArgs: 1,
+			}
+			_withUsedModules = mml.Ref(_read, "checkReexports").(*mml.Function).Call([]interface{}{mml.Ref(_codetree, "edit").(*mml.Function).Call([]interface{}{_setUsedModule}).(*mml.Function).Call([]interface{}{mml.Ref(_read, "setPaths").(*mml.Function).Call([]interface{}{mml.Ref(_m, "located")}).(*mml.Function).Call([]interface{}{_moduleCode})})})
+			if v := _withUsedModules; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_linked = mml.Ref(_signatures, "replaceUse").(*mml.Function).Call([]interface{}{mml.Ref(_read, "expandReexports").(*mml.Function).Call([]interface{}{_withUsedModules})})
+			return func() interface{} {
+				s := &mml.Struct{}
+				s.Merge(_linked.(*mml.Struct))
+				s.Set("path", _path)
+				return s
+			}()
 		}
-		_application = &mml.Function{
+		_linkedModule = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _a = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_a)
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					c = _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-						s := &mml.Struct{Values: make(map[string]interface{})}
-						s.Values["function"] = func() interface{} {
-							s := &mml.Struct{Values: make(map[string]interface{})}
-							s.Values["type"] = "function"
-							return s
-						}()
-						return s
-					}(), _a)}).Values)
-					if c.(bool) {
-						return "(%s).Call((%s).Values)"
-					} else {
-						return "%s.(*mml.Function).Call((%s).Values)"
-					}
-				}(), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "function"))}).Values), _list.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["values"] = mml.Ref(_a, "args")
-					return s
-				}())}).Values))}).Values)
+				return direct_linkedModule(a[0], a[1])
 			},
-			FixedArgs: 1,
+			FixedArgs: 2,
 		}
-		_unary = &mml.Function{
+		direct_moduleFile = func(_scanned, _key, _m interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _join.(*mml.Function).Call([]interface{}{"\n", (&mml.List{}).Append(_formats.(*mml.Function).Call([]interface{}{"%s%s", _sourceHeader, mml.Ref(mml.Ref(_scanned, mml.Ref(_m, "path")), "source")}), _formats.(*mml.Function).Call([]interface{}{"%s%s", _keyHeader, _key})).Concat(_map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _u = a[0]
+					mml.Nop(_u)
+					return _formats.(*mml.Function).Call([]interface{}{"%s%s", _useHeader, _u})
+				},
+				FixedArgs: 1,
+			}, mml.Ref(mml.Ref(_scanned, mml.Ref(_m, "path")), "sourceUses")}).(*mml.List)).Concat(_map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _e = a[0]
+					mml.Nop(_e)
+					return _formats.(*mml.Function).Call([]interface{}{"%s%s", _exportHeader, _e})
+				},
+				FixedArgs: 1,
+			}, mml.Ref(mml.Ref(_scanned, mml.Ref(_m, "path")), "exports")}).(*mml.List)).Concat(_map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _r = a[0]
+					mml.Nop(_r)
+					return _formats.(*mml.Function).Call([]interface{}{"%s%s", _reexportHeader, direct_formatReexport(_r)})
+				},
+				FixedArgs: 1,
+			}, mml.Ref(mml.Ref(_scanned, mml.Ref(_m, "path")), "reexports")}).(*mml.List)).Append(mml.Ref(_compile, "moduleToGo").(*mml.Function).Call([]interface{}{_m}))})
+		}
+		_moduleFile = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _u = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_u)
-				return func() interface{} {
-					c = mml.BinaryOp(11, mml.Ref(_u, "op"), mml.Ref(_code, "logicalNot"))
+				return direct_moduleFile(a[0], a[1], a[2])
+			},
+			FixedArgs: 3,
+		}
+		direct_setInterfaces = func(_scanned interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _interfaces interface{}
+			var _interface interface{}
+			var _withInterfaces interface{}
+			mml.Nop(_interfaces, _interface, _withInterfaces)
+			_interfaces = func() interface{} { s := &mml.Struct{}; ; return s }()
+			_interface = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _path = a[0]
+					mml.Nop(_path)
+					var _m interface{}
+					var _names interface{}
+					mml.Nop(_m, _names)
+					c = _has.(*mml.Function).Call([]interface{}{_path, _interfaces})
 					if c.(bool) {
-						return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-							c = _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-								s := &mml.Struct{Values: make(map[string]interface{})}
-								s.Values["type"] = "bool"
-								return s
-							}(), mml.Ref(_u, "arg"))}).Values)
+						mml.Nop()
+						return mml.Ref(_interfaces, _path)
+					}
+					_m = mml.Ref(_scanned, _path)
+					_names = mml.Ref(_m, "exports")
+					for _, _r := range mml.Ref(_m, "reexports").(*mml.List).Values() {
+						var _reexported interface{}
+						mml.Nop(_reexported)
+						_reexported = func() interface{} {
+							c = _has.(*mml.Function).Call([]interface{}{"selection", _r})
 							if c.(bool) {
-								return "!%s"
+								return mml.Ref(_r, "selection")
 							} else {
-								return "!%s.(bool)"
+								return _interface.(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_m, "located"), mml.Ref(_r, "path"))})
 							}
-						}(), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_u, "arg"))}).Values))}).Values)
-					} else {
-						return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "mml.UnaryOp(%d, %s)", mml.Ref(_u, "op"), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_u, "arg"))}).Values))}).Values)
+						}()
+						_names = (&mml.List{}).Concat(_names.(*mml.List)).Concat(_reexported.(*mml.List))
 					}
+					mml.SetRef(_interfaces, _path, _sort.(*mml.Function).Call([]interface{}{&mml.Function{
+						F: func(a []interface{}) interface{} {
+							var c interface{}
+							mml.Nop(c)
+							var _left = a[0]
+							var _right = a[1]
+							mml.Nop(_left, _right)
+							return mml.BinaryOp(13, _left, _right)
+						},
+						FixedArgs: 2,
+					}}).(*mml.Function).Call([]interface{}{_uniq.(*mml.Function).Call([]interface{}{&mml.Function{
+						F: func(a []interface{}) interface{} {
+							var c interface{}
+							mml.Nop(c)
+							var _left = a[0]
+							var _right = a[1]
+							mml.Nop(_left, _right)
+							return mml.BinaryOp(11, _left, _right)
+						},
+						FixedArgs: 2,
+					}}).(*mml.Function).Call([]interface{}{_names})}))
+					return mml.Ref(_interfaces, _path)
+				},
+				FixedArgs: 1,
+			}
+			_withInterfaces = func() interface{} { s := &mml.Struct{}; ; return s }()
+			for _, _path := range _keys.(*mml.Function).Call([]interface{}{_scanned}).(*mml.List).Values() {
+
+				mml.Nop()
+				_withInterfaces = func() interface{} {
+					s := &mml.Struct{}
+					s.Merge(_withInterfaces.(*mml.Struct))
+					s.Set(_path.(string), func() interface{} {
+						s := &mml.Struct{}
+						s.Merge(mml.Ref(_scanned, _path).(*mml.Struct))
+						s.Set("interface", _interface.(*mml.Function).Call([]interface{}{_path}))
+						return s
+					}())
+					return s
 				}()
+			}
+			return _withInterfaces
+		}
+		_setInterfaces = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_setInterfaces(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_binary = &mml.Function{
+		direct_compileModule = func(_dir, _version, _scanned, _m interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _prepared interface{}
+			var _key interface{}
+			var _written interface{}
+			mml.Nop(_prepared, _key, _written)
+			_prepared = mml.Ref(_deadcode, "keepExported").(*mml.Function).Call([]interface{}{mml.Ref(_constants, "keepExported").(*mml.Function).Call([]interface{}{(&mml.List{}).Append(_m)})})
+			_key = direct_cacheKey(_version, _scanned, mml.Ref(_m, "path"))
+			_written = _writeFile.(*mml.Function).Call([]interface{}{direct_fileName(_dir, mml.Ref(_m, "path")), direct_moduleFile(_scanned, _key, mml.Ref(_prepared, 0))})
+			if v := _written; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			return _m
+		}
+		_compileModule = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _b = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_b)
-				var _isBoolOp interface{}
-				var _isBoolValue interface{}
-				var _convertIfNotBool interface{}
-				var _left interface{}
-				var _right interface{}
-				var _op interface{}
-				mml.Nop(_isBoolOp, _isBoolValue, _convertIfNotBool, _left, _right, _op)
-				c = !_is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _or.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_code, "logicalAnd"), mml.Ref(_code, "logicalOr"))}).Values), mml.Ref(_b, "op"))}).Values).(bool)
-				if c.(bool) {
-					mml.Nop()
-					return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "mml.BinaryOp(%d, %s, %s)", mml.Ref(_b, "op"), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_b, "left"))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_b, "right"))}).Values))}).Values)
-				}
-				_isBoolOp = &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _c = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_c)
-						return _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-							s := &mml.Struct{Values: make(map[string]interface{})}
-							s.Values["type"] = _or.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "unary", "binary")}).Values)
-							s.Values["op"] = _or.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_code, "logicalNot"), mml.Ref(_code, "logicalAnd"), mml.Ref(_code, "logicalOr"))}).Values)
-							return s
-						}(), _c)}).Values)
-					},
-					FixedArgs: 1,
-				}
-				_isBoolValue = &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _c = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_c)
-						return _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-							s := &mml.Struct{Values: make(map[string]interface{})}
-							s.Values["type"] = "bool"
-							return s
-						}(), _c)}).Values)
-					},
-					FixedArgs: 1,
-				}
-				_convertIfNotBool = &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _c = a[0]
-						var _s = a[1]
-						var _ interface{}
-						_ = &mml.List{a[2:]}
-						mml.Nop(_c, _s)
-						return func() interface{} {
-							c = (_isBoolValue.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _c)}).Values).(bool) || _isBoolOp.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _c)}).Values).(bool))
-							if c.(bool) {
-								return _s
-							} else {
-								return mml.BinaryOp(9, _s, ".(bool)")
-							}
-						}()
-					},
-					FixedArgs: 2,
-				}
-				_left = _convertIfNotBool.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_b, "left"))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_b, "left"))}).Values))}).Values)
-				_right = _convertIfNotBool.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_b, "right"))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_b, "right"))}).Values))}).Values)
-				_op = func() interface{} {
-					c = mml.BinaryOp(11, mml.Ref(_b, "op"), mml.Ref(_code, "logicalAnd"))
-					if c.(bool) {
-						return "&&"
-					} else {
-						return "||"
-					}
-				}()
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "(%s %s %s)", _left, _op, _right)}).Values)
-				return nil
+				return direct_compileModule(a[0], a[1], a[2], a[3])
 			},
-			FixedArgs: 1,
+			FixedArgs: 4,
 		}
-		_ternary = &mml.Function{
+		direct_compilerVersion = func() interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _path interface{}
+			var _binary interface{}
+			mml.Nop(_path, _binary)
+			_path = _executable.(*mml.Function).Call([]interface{}{})
+			if v := _path; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_binary = mml.Ref(_io, "readFile").(*mml.Function).Call([]interface{}{_path})
+			if v := _binary; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			return mml.Ref(_strings, "hash").(*mml.Function).Call([]interface{}{_binary})
+		}
+		_compilerVersion = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _c = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_c)
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "func () interface{} { c = %s; if c.(bool) { return %s } else { return %s } }()", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_c, "condition"))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_c, "consequent"))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_c, "alternative"))}).Values))}).Values)
+				return direct_compilerVersion()
 			},
-			FixedArgs: 1,
+			FixedArgs: 0,
 		}
-		_ifStatement = &mml.Function{
+		direct_removeStale = func(_dir, _paths interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _entries interface{}
+			var _current interface{}
+			mml.Nop(_entries, _current)
+			_entries = _readDir.(*mml.Function).Call([]interface{}{_dir})
+			if v := _entries; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_current = _map.(*mml.Function).Call([]interface{}{_baseName, _paths})
+			for _, _name := range _entries.(*mml.List).Values() {
+
+				mml.Nop()
+				if direct_isModuleFile(_name).(bool) && !_contains.(*mml.Function).Call([]interface{}{_name, _current}).(bool) {
+					var _removed interface{}
+					mml.Nop(_removed)
+					_removed = _removeAll.(*mml.Function).Call([]interface{}{_formats.(*mml.Function).Call([]interface{}{"%s/%s", _dir, _name})})
+					if v := _removed; mml.IsError.F([]interface{}{v}).(bool) {
+						return v
+					}
+				}
+			}
+			return nil
+		}
+		_removeStale = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _c = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_c)
-				return func() interface{} {
-					c = _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-						s := &mml.Struct{Values: make(map[string]interface{})}
-						s.Values["alternative"] = _any
+				return direct_removeStale(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_build = func(_dir, _path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _created interface{}
+			var _version interface{}
+			var _mainPath interface{}
+			var _loaded interface{}
+			var _noCycles interface{}
+			var _scanned interface{}
+			var _verified interface{}
+			var _changed interface{}
+			var _linked interface{}
+			var _interopChecked interface{}
+			var _compiled interface{}
+			var _removed interface{}
+			var _mainWritten interface{}
+			mml.Nop(_created, _version, _mainPath, _loaded, _noCycles, _scanned, _verified, _changed, _linked, _interopChecked, _compiled, _removed, _mainWritten)
+			_created = _makeDir.(*mml.Function).Call([]interface{}{_dir})
+			if v := _created; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_version = direct_compilerVersion()
+			if v := _version; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_mainPath = mml.Ref(_errors, "pass").(*mml.Function).Call([]interface{}{mml.Ref(_paths, "normalize"), mml.Ref(_paths, "trimExtension")}).(*mml.Function).Call([]interface{}{_path})
+			if v := _mainPath; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_loaded = mml.Ref(_read, "loadAll").(*mml.Function).Call([]interface{}{_scan.(*mml.Function).Call([]interface{}{mml.Ref(_read, "searchPath").(*mml.Function).Call([]interface{}{_mainPath}), _dir}), (&mml.List{}).Append(_mainPath)})
+			if v := _loaded; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_noCycles = mml.Ref(_read, "checkCycles").(*mml.Function).Call([]interface{}{_loaded, _mainPath})
+			if v := _noCycles; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_scanned = direct_setInterfaces(_loaded)
+			_verified = mml.Ref(_read, "verifyInterfaces").(*mml.Function).Call([]interface{}{_loaded, _implementation.(*mml.Function).Call([]interface{}{_scanned})})
+			if v := _verified; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_changed = _filter.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _p = a[0]
+					mml.Nop(_p)
+					return !_is.(*mml.Function).Call([]interface{}{func() interface{} {
+						s := &mml.Struct{}
+						s.Set("key", direct_cacheKey(_version, _scanned, _p))
 						return s
-					}(), _c)}).Values)
-					if c.(bool) {
-						return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "c = %s; if c.(bool) { %s } else { %s }", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_c, "condition"))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_c, "consequent"))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_c, "alternative"))}).Values))}).Values)
-					} else {
-						return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "c = %s; if c.(bool) { %s }", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_c, "condition"))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_c, "consequent"))}).Values))}).Values)
-					}
-				}()
+					}(), mml.Ref(mml.Ref(_scanned, _p), "previous")}).(bool)
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_sort.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _left = a[0]
+					var _right = a[1]
+					mml.Nop(_left, _right)
+					return mml.BinaryOp(13, _left, _right)
+				},
+				FixedArgs: 2,
+			}}).(*mml.Function).Call([]interface{}{_keys.(*mml.Function).Call([]interface{}{_scanned})})})
+			_linked = mml.Ref(_tasks, "map").(*mml.Function).Call([]interface{}{_linkedModule.(*mml.Function).Call([]interface{}{_scanned}), _changed})
+			if v := _linked; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_interopChecked = mml.Ref(_toolchain, "checkInterop").(*mml.Function).Call([]interface{}{_linked})
+			if v := _interopChecked; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_compiled = mml.Ref(_tasks, "map").(*mml.Function).Call([]interface{}{_compileModule.(*mml.Function).Call([]interface{}{_dir, _version, _scanned}), _linked})
+			if v := _compiled; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_removed = direct_removeStale(_dir, _keys.(*mml.Function).Call([]interface{}{_scanned}))
+			if v := _removed; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_mainWritten = _writeFile.(*mml.Function).Call([]interface{}{_formats.(*mml.Function).Call([]interface{}{"%s/main.go", _dir}), mml.Ref(_compile, "mainToGo").(*mml.Function).Call([]interface{}{_mainPath})})
+			if v := _mainWritten; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			return _compiled
+		}
+		_build = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_build(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		exports["build"] = _build
+
+		return exports
+	})
+
+	modulePath = "toolchain"
+
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
+		exports := make(map[string]interface{})
+
+		var c interface{}
+		mml.Nop(c)
+
+		var _runtimePackage string
+		var _sourceDir interface{}
+		var _runtimeDir interface{}
+		var _goMod interface{}
+		var _vendoredModule interface{}
+		var _vendoredModules interface{}
+		var _vendor interface{}
+		var _writeModule interface{}
+		var _goBuild interface{}
+		var _absolute interface{}
+		var _describeCode interface{}
+		var _build interface{}
+		var _run interface{}
+		var _describe interface{}
+		var _checkInterop interface{}
+		var _strings interface{}
+		var _io interface{}
+		var _paths interface{}
+		var _signatures interface{}
+		var _fold interface{}
+		var _foldr interface{}
+		var _map interface{}
+		var _filter interface{}
+		var _contains interface{}
+		var _sort interface{}
+		var _flat interface{}
+		var _flats interface{}
+		var _uniq interface{}
+		var _every interface{}
+		var _some interface{}
+		var _join interface{}
+		var _joins interface{}
+		var _formats interface{}
+		var _enum interface{}
+		var _log interface{}
+		var _fatal interface{}
+		var _bind interface{}
+		var _identity interface{}
+		var _eq interface{}
+		var _any interface{}
+		var _function interface{}
+		var _channel interface{}
+		var _natural interface{}
+		var _type interface{}
+		var _listOf interface{}
+		var _structOf interface{}
+		var _range interface{}
+		var _rangeMin interface{}
+		var _listLength interface{}
+		var _or interface{}
+		var _and interface{}
+		var _not interface{}
+		var _predicate interface{}
+		var _is interface{}
+		var direct_sourceDir func(interface{}) interface{}
+		mml.Nop(direct_sourceDir)
+		var direct_runtimeDir func() interface{}
+		mml.Nop(direct_runtimeDir)
+		var direct_goMod func(interface{}) interface{}
+		mml.Nop(direct_goMod)
+		var direct_vendoredModule func(interface{}) interface{}
+		mml.Nop(direct_vendoredModule)
+		var direct_vendoredModules func(interface{}) interface{}
+		mml.Nop(direct_vendoredModules)
+		var direct_vendor func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_vendor)
+		var direct_writeModule func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_writeModule)
+		var direct_goBuild func(interface{}, interface{}) interface{}
+		mml.Nop(direct_goBuild)
+		var direct_absolute func(interface{}) interface{}
+		mml.Nop(direct_absolute)
+		var direct_describeCode func(interface{}) interface{}
+		mml.Nop(direct_describeCode)
+		var direct_build func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_build)
+		var direct_run func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_run)
+		var direct_describe func(interface{}) interface{}
+		mml.Nop(direct_describe)
+		var direct_checkInterop func(interface{}) interface{}
+		mml.Nop(direct_checkInterop)
+		mml.Nop(_runtimePackage, _sourceDir, _runtimeDir, _goMod, _vendoredModule, _vendoredModules, _vendor, _writeModule, _goBuild, _absolute, _describeCode, _build, _run, _describe, _checkInterop, _strings, _io, _paths, _signatures, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _flats, _uniq, _every, _some, _join, _joins, _formats, _enum, _log, _fatal, _bind, _identity, _eq, _any, _function, _channel, _natural, _type, _listOf, _structOf, _range, _rangeMin, _listLength, _or, _and, _not, _predicate, _is)
+		var __lang = use("lang")
+		_fold = __lang.Get("fold")
+		_foldr = __lang.Get("foldr")
+		_map = __lang.Get("map")
+		_filter = __lang.Get("filter")
+		_contains = __lang.Get("contains")
+		_sort = __lang.Get("sort")
+		_flat = __lang.Get("flat")
+		_flats = __lang.Get("flats")
+		_uniq = __lang.Get("uniq")
+		_every = __lang.Get("every")
+		_some = __lang.Get("some")
+		_join = __lang.Get("join")
+		_joins = __lang.Get("joins")
+		_formats = __lang.Get("formats")
+		_enum = __lang.Get("enum")
+		_log = __lang.Get("log")
+		_fatal = __lang.Get("fatal")
+		_bind = __lang.Get("bind")
+		_identity = __lang.Get("identity")
+		_eq = __lang.Get("eq")
+		_any = __lang.Get("any")
+		_function = __lang.Get("function")
+		_channel = __lang.Get("channel")
+		_natural = __lang.Get("natural")
+		_type = __lang.Get("type")
+		_listOf = __lang.Get("listOf")
+		_structOf = __lang.Get("structOf")
+		_range = __lang.Get("range")
+		_rangeMin = __lang.Get("rangeMin")
+		_listLength = __lang.Get("listLength")
+		_or = __lang.Get("or")
+		_and = __lang.Get("and")
+		_not = __lang.Get("not")
+		_predicate = __lang.Get("predicate")
+		_is = __lang.Get("is")
+		_strings = use("strings")
+		_io = use("io")
+		_paths = use("paths")
+		_signatures = use("signatures")
+		_runtimePackage = "github.com/aryszka/mml"
+		direct_sourceDir = func(_pkg interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _gopath interface{}
+			var _home interface{}
+			mml.Nop(_gopath, _home)
+			_gopath = _getEnv.(*mml.Function).Call([]interface{}{"GOPATH"})
+			if !_isError.(*mml.Function).Call([]interface{}{_gopath}).(bool) {
+				mml.Nop()
+				return _formats.(*mml.Function).Call([]interface{}{"%s/src/%s", mml.Ref(mml.Ref(_strings, "split").(*mml.Function).Call([]interface{}{":", _gopath}), 0), _pkg})
+			}
+			_home = _getEnv.(*mml.Function).Call([]interface{}{"HOME"})
+			if v := _home; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			return _formats.(*mml.Function).Call([]interface{}{"%s/go/src/%s", _home, _pkg})
+		}
+		_sourceDir = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_sourceDir(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_cond = &mml.Function{
+		direct_runtimeDir = func() interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _root interface{}
+			mml.Nop(_root)
+			_root = _getEnv.(*mml.Function).Call([]interface{}{"MMLROOT"})
+			return func() interface{} {
+				c = _isError.(*mml.Function).Call([]interface{}{_root})
+				if c.(bool) {
+					return direct_sourceDir(_runtimePackage)
+				} else {
+					return _root
+				}
+			}()
+		}
+		_runtimeDir = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _c = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_c)
-				return func() interface{} {
-					c = mml.Ref(_c, "ternary")
-					if c.(bool) {
-						return _ternary.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _c)}).Values)
-					} else {
-						return _ifStatement.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _c)}).Values)
-					}
-				}()
+				return direct_runtimeDir()
 			},
-			FixedArgs: 1,
+			FixedArgs: 0,
 		}
-		_caseBlock = &mml.Function{
+		direct_goMod = func(_packages interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _formats.(*mml.Function).Call([]interface{}{"module mmlprogram\n\ngo 1.16\n\n%s", _join.(*mml.Function).Call([]interface{}{""}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _p = a[0]
+					mml.Nop(_p)
+					return _formats.(*mml.Function).Call([]interface{}{"require %s v0.0.0\n", _p})
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_packages})})})
+		}
+		_goMod = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _c = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_c)
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "case %s:\n%s", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_c, "expression"))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_c, "body"))}).Values))}).Values)
+				return direct_goMod(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_switchStatement = &mml.Function{
+		direct_vendoredModule = func(_pkg interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _formats.(*mml.Function).Call([]interface{}{"# %s v0.0.0\n## explicit\n%s\n", _pkg, _pkg})
+		}
+		_vendoredModule = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_s)
-				var _hasDefault interface{}
-				var _cases interface{}
-				var _def interface{}
-				var _defaultCode interface{}
-				mml.Nop(_hasDefault, _cases, _def, _defaultCode)
-				_hasDefault = mml.BinaryOp(15, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_s, "defaultStatements"), "statements"))}).Values), 0)
-				_cases = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _do)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_s, "cases"))}).Values)
-				_def = func() interface{} {
-					c = _hasDefault
-					if c.(bool) {
-						return _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_s, "defaultStatements"))}).Values)
-					} else {
-						return ""
-					}
-				}()
-				_defaultCode = func() interface{} {
-					c = _hasDefault
-					if c.(bool) {
-						return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "default:\n%s", _def)}).Values)
-					} else {
-						return ""
-					}
-				}()
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "switch %s {\n%s\n}", func() interface{} {
-					c = _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-						s := &mml.Struct{Values: make(map[string]interface{})}
-						s.Values["expression"] = _any
-						return s
-					}(), _s)}).Values)
-					if c.(bool) {
-						return _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_s, "expression"))}).Values)
-					} else {
-						return ""
-					}
-				}(), _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "\n")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					c = _hasDefault
-					if c.(bool) {
-						return &mml.List{Values: append(append([]interface{}{}, _cases.(*mml.List).Values...), _defaultCode)}
-					} else {
-						return _cases
-					}
-				}())}).Values))}).Values)
-				return nil
+				return direct_vendoredModule(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_sendStatement = &mml.Function{
+		direct_vendoredModules = func(_packages interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _joins.(*mml.Function).Call(append([]interface{}{"", direct_vendoredModule(_runtimePackage), _formats.(*mml.Function).Call([]interface{}{"%s/parser\n", _runtimePackage})}, _map.(*mml.Function).Call([]interface{}{_vendoredModule, _packages}).(*mml.List).Values()...))
+		}
+		_vendoredModules = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_s)
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "%s <- %s", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_s, "channel"))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_s, "value"))}).Values))}).Values)
+				return direct_vendoredModules(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_receiveExpression = &mml.Function{
+		direct_vendor = func(_dir, _pkg, _source interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _target interface{}
+			var _created interface{}
+			mml.Nop(_target, _created)
+			_target = _formats.(*mml.Function).Call([]interface{}{"%s/vendor/%s", _dir, _pkg})
+			_created = _makeDir.(*mml.Function).Call([]interface{}{mml.Ref(_paths, "dir").(*mml.Function).Call([]interface{}{_target})})
+			if v := _created; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			return _symlink.(*mml.Function).Call([]interface{}{_source, _target})
+		}
+		_vendor = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _r = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_r)
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "<-%s", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_r, "channel"))}).Values))}).Values)
+				return direct_vendor(a[0], a[1], a[2])
 			},
-			FixedArgs: 1,
+			FixedArgs: 3,
 		}
-		_goStatement = &mml.Function{
+		direct_writeModule = func(_dir, _packages, _goCode interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _runtime interface{}
+			var _sources interface{}
+			mml.Nop(_runtime, _sources)
+			_runtime = direct_runtimeDir()
+			if v := _runtime; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_sources = _map.(*mml.Function).Call([]interface{}{_sourceDir}).(*mml.Function).Call([]interface{}{_packages})
+			for _, _s := range _sources.(*mml.List).Values() {
+
+				mml.Nop()
+				if v := _s; mml.IsError.F([]interface{}{v}).(bool) {
+					return v
+				}
+			}
+			for _, _result := range (&mml.List{}).Append(_writeFile.(*mml.Function).Call([]interface{}{_formats.(*mml.Function).Call([]interface{}{"%s/go.mod", _dir}), direct_goMod((&mml.List{}).Append(_runtimePackage).Concat(_packages.(*mml.List)))}), _writeFile.(*mml.Function).Call([]interface{}{_formats.(*mml.Function).Call([]interface{}{"%s/main.go", _dir}), _goCode}), direct_vendor(_dir, _runtimePackage, _runtime), _writeFile.(*mml.Function).Call([]interface{}{_formats.(*mml.Function).Call([]interface{}{"%s/vendor/modules.txt", _dir}), direct_vendoredModules(_packages)})).Values() {
+
+				mml.Nop()
+				if v := _result; mml.IsError.F([]interface{}{v}).(bool) {
+					return v
+				}
+			}
+			for _i := 0; _i < _len.(*mml.Function).Call([]interface{}{_packages}).(int); _i++ {
+				var _vendored interface{}
+				mml.Nop(_vendored)
+				_vendored = direct_vendor(_dir, mml.Ref(_packages, _i), mml.Ref(_sources, _i))
+				if v := _vendored; mml.IsError.F([]interface{}{v}).(bool) {
+					return v
+				}
+			}
+			return _dir
+		}
+		_writeModule = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _g = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_g)
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "go %s", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_g, "application"))}).Values))}).Values)
+				return direct_writeModule(a[0], a[1], a[2])
 			},
-			FixedArgs: 1,
+			FixedArgs: 3,
 		}
-		_deferStatement = &mml.Function{
+		direct_goBuild = func(_dir, _output interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _exitCode interface{}
+			mml.Nop(_exitCode)
+			_exitCode = _execute.(*mml.Function).Call([]interface{}{_dir, "env", (&mml.List{}).Append("GO111MODULE=on", "GOFLAGS=", "go", "build", "-mod=vendor", "-o", _output, ".")})
+			if v := _exitCode; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			return func() interface{} {
+				c = mml.BinaryOp(11, _exitCode, 0)
+				if c.(bool) {
+					return _output
+				} else {
+					return _error.(*mml.Function).Call([]interface{}{"go build failed"})
+				}
+			}()
+		}
+		_goBuild = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _d = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_d)
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					c = _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-						s := &mml.Struct{Values: make(map[string]interface{})}
-						s.Values["application"] = func() interface{} {
-							s := &mml.Struct{Values: make(map[string]interface{})}
-							s.Values["function"] = func() interface{} {
-								s := &mml.Struct{Values: make(map[string]interface{})}
-								s.Values["type"] = "function"
-								return s
-							}()
-							return s
-						}()
-						return s
-					}(), _d)}).Values)
-					if c.(bool) {
-						return "c = (%s); defer c.Call((%s).Values)"
-					} else {
-						return "defer %s.(*mml.Function).Call((%s).Values)"
-					}
-				}(), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_d, "application"), "function"))}).Values), _list.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["values"] = mml.Ref(mml.Ref(_d, "application"), "args")
-					return s
-				}())}).Values))}).Values)
+				return direct_goBuild(a[0], a[1])
 			},
-			FixedArgs: 1,
+			FixedArgs: 2,
 		}
-		_selectStatement = &mml.Function{
+		direct_absolute = func(_path interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _wd interface{}
+			mml.Nop(_wd)
+			if mml.BinaryOp(15, _len.(*mml.Function).Call([]interface{}{_path}), 0).(bool) && mml.BinaryOp(11, mml.Ref(_path, 0), "/").(bool) {
+				mml.Nop()
+				return _path
+			}
+			_wd = _workDir.(*mml.Function).Call([]interface{}{})
+			if v := _wd; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			return _formats.(*mml.Function).Call([]interface{}{"%s/%s", _wd, _path})
+		}
+		_absolute = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_s)
-				return (&mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _c = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_c)
-						return mml.Ref(_strings, "formatOne").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "func() interface{} {\nselect {\n%s\n} }()")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "\n")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-							c = mml.Ref(_s, "hasDefault")
-							if c.(bool) {
-								return &mml.List{Values: append(append([]interface{}{}, _c.(*mml.List).Values...), mml.Ref(_strings, "formatOne").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "default:\n%s")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_s, "defaultStatements"))}).Values))}).Values))}
-							} else {
-								return _c
-							}
-						}())}).Values))}).Values)
-					},
-					FixedArgs: 1,
-				}).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _do)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_s, "cases"))}).Values))}).Values)
+				return direct_absolute(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_rangeOver = &mml.Function{
+		direct_build = func(_output, _packages, _goCode interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _dir interface{}
+			var _absOutput interface{}
+			var _written interface{}
+			mml.Nop(_dir, _absOutput, _written)
+			_dir = _makeTempDir.(*mml.Function).Call([]interface{}{})
+			if v := _dir; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			defer _removeAll.(*mml.Function).Call([]interface{}{_dir})
+			_absOutput = direct_absolute(_output)
+			if v := _absOutput; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_written = direct_writeModule(_dir, _packages, _goCode)
+			if v := _written; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			return direct_goBuild(_dir, _absOutput)
+		}
+		_build = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _r = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_r)
-				var _infiniteCounter interface{}
-				var _withRangeExpression interface{}
-				var _listStyleRange interface{}
-				mml.Nop(_infiniteCounter, _withRangeExpression, _listStyleRange)
-				_infiniteCounter = &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _ interface{}
-						_ = &mml.List{a[0:]}
-						mml.Nop()
-						return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "_%s := 0; true; _%s++", mml.Ref(_r, "symbol"), mml.Ref(_r, "symbol"))}).Values)
-					},
-					FixedArgs: 0,
-				}
-				_withRangeExpression = &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _ interface{}
-						_ = &mml.List{a[0:]}
-						mml.Nop()
-						return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "_%s := %s; %s; _%s++", mml.Ref(_r, "symbol"), func() interface{} {
-							c = _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "from", mml.Ref(_r, "expression"))}).Values)
-							if c.(bool) {
-								return _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_r, "expression"), "from"))}).Values)
-							} else {
-								return "0"
-							}
-						}(), func() interface{} {
-							c = _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "to", mml.Ref(_r, "expression"))}).Values)
-							if c.(bool) {
-								return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "_%s < %s.(int)", mml.Ref(_r, "symbol"), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_r, "expression"), "to"))}).Values))}).Values)
-							} else {
-								return "true"
-							}
-						}(), mml.Ref(_r, "symbol"))}).Values)
-					},
-					FixedArgs: 0,
-				}
-				_listStyleRange = &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _ interface{}
-						_ = &mml.List{a[0:]}
-						mml.Nop()
-						return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "_, _%s := range %s.(*mml.List).Values", mml.Ref(_r, "symbol"), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_r, "expression"))}).Values))}).Values)
-					},
-					FixedArgs: 0,
-				}
-				switch {
-				case !_has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "expression", _r)}).Values).(bool):
-
-					mml.Nop()
-					return _infiniteCounter.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-				case _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["type"] = "range"
-					return s
-				}(), mml.Ref(_r, "expression"))}).Values):
-
-					mml.Nop()
-					return _withRangeExpression.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-				default:
-
-					mml.Nop()
-					return _listStyleRange.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-				}
-				return nil
+				return direct_build(a[0], a[1], a[2])
 			},
-			FixedArgs: 1,
+			FixedArgs: 3,
 		}
-		_breakStatement = &mml.Function{
+		exports["build"] = _build
+		direct_run = func(_args, _packages, _goCode interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _dir interface{}
+			var _written interface{}
+			var _binary interface{}
+			mml.Nop(_dir, _written, _binary)
+			_dir = _makeTempDir.(*mml.Function).Call([]interface{}{})
+			if v := _dir; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			defer _removeAll.(*mml.Function).Call([]interface{}{_dir})
+			_written = direct_writeModule(_dir, _packages, _goCode)
+			if v := _written; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_binary = direct_goBuild(_dir, _formats.(*mml.Function).Call([]interface{}{"%s/program", _dir}))
+			if v := _binary; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			return _execute.(*mml.Function).Call([]interface{}{"", _binary, _args})
+		}
+		_run = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var __ = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(__)
-				return "break"
+				return direct_run(a[0], a[1], a[2])
 			},
-			FixedArgs: 1,
+			FixedArgs: 3,
 		}
-		_continueStatement = &mml.Function{
+		exports["run"] = _run
+		direct_describeCode = func(_packages interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _formats.(*mml.Function).Call([]interface{}{"package main\n\nimport (\n\t\"os\"\n\n\t\"%s\"\n%s)\n\nfunc main() {\n\tf, err := os.Create(os.Args[1])\n\tif err != nil {\n\t\tpanic(err)\n\t}\n\n\tdefer f.Close()\n\tif err := mml.Interop.Describe(f); err != nil {\n\t\tpanic(err)\n\t}\n}\n", _runtimePackage, _join.(*mml.Function).Call([]interface{}{""}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _p = a[0]
+					mml.Nop(_p)
+					return _formats.(*mml.Function).Call([]interface{}{"\t_ \"%s\"\n", _p})
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_packages})})})
+		}
+		_describeCode = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var __ = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(__)
-				return "continue"
+				return direct_describeCode(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_loop = &mml.Function{
+		direct_describe = func(_packages interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _dir interface{}
+			var _written interface{}
+			var _binary interface{}
+			var _output interface{}
+			var _exitCode interface{}
+			mml.Nop(_dir, _written, _binary, _output, _exitCode)
+			_dir = _makeTempDir.(*mml.Function).Call([]interface{}{})
+			if v := _dir; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			defer _removeAll.(*mml.Function).Call([]interface{}{_dir})
+			_written = direct_writeModule(_dir, _packages, direct_describeCode(_packages))
+			if v := _written; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_binary = direct_goBuild(_dir, _formats.(*mml.Function).Call([]interface{}{"%s/describe", _dir}))
+			if v := _binary; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			_output = _formats.(*mml.Function).Call([]interface{}{"%s/signatures", _dir})
+			_exitCode = _execute.(*mml.Function).Call([]interface{}{"", _binary, (&mml.List{}).Append(_output)})
+			if v := _exitCode; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			return func() interface{} {
+				c = mml.BinaryOp(11, _exitCode, 0)
+				if c.(bool) {
+					return mml.Ref(_io, "readFile").(*mml.Function).Call([]interface{}{_output})
+				} else {
+					return _error.(*mml.Function).Call([]interface{}{"failed to read the signatures of the Go functions"})
+				}
+			}()
+		}
+		_describe = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _l = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_l)
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "for %s {\n%s\n}", func() interface{} {
-					c = _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "expression", _l)}).Values)
-					if c.(bool) {
-						return _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_l, "expression"))}).Values)
-					} else {
-						return ""
-					}
-				}(), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_l, "body"))}).Values))}).Values)
+				return direct_describe(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_definition = &mml.Function{
+		exports["describe"] = _describe
+		direct_checkInterop = func(_modules interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _used interface{}
+			var _described interface{}
+			mml.Nop(_used, _described)
+			_used = mml.Ref(_signatures, "uses").(*mml.Function).Call([]interface{}{_modules})
+			if v := _used; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			c = mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_used}), 0)
+			if c.(bool) {
+				mml.Nop()
+				return true
+			}
+			_described = direct_describe(mml.Ref(_signatures, "packages").(*mml.Function).Call([]interface{}{_modules}))
+			if v := _described; mml.IsError.F([]interface{}{v}).(bool) {
+				return v
+			}
+			return mml.Ref(_signatures, "verify").(*mml.Function).Call([]interface{}{_modules, mml.Ref(_signatures, "parse").(*mml.Function).Call([]interface{}{_described})})
+		}
+		_checkInterop = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _d = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_d)
-				return func() interface{} {
-					c = mml.Ref(_d, "exported")
-					if c.(bool) {
-						return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "_%s = %s; exports[\"%s\"] = _%s", mml.Ref(_d, "symbol"), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_d, "expression"))}).Values), mml.Ref(_d, "symbol"), mml.Ref(_d, "symbol"))}).Values)
-					} else {
-						return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "_%s = %s", mml.Ref(_d, "symbol"), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_d, "expression"))}).Values))}).Values)
-					}
-				}()
+				return direct_checkInterop(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_definitionGroup = &mml.Function{
+		exports["checkInterop"] = _checkInterop
+
+		return exports
+	})
+
+	modulePath = "races"
+
+	mml.Modules.Set(modulePath, func(use func(string) *mml.Struct) map[string]interface{} {
+		exports := make(map[string]interface{})
+
+		var c interface{}
+		mml.Nop(c)
+
+		var _spawnsOf interface{}
+		var _startsIn interface{}
+		var _captureSymbol interface{}
+		var _assignedSymbols interface{}
+		var _isMutableDefinition interface{}
+		var _goroutineFunctions interface{}
+		var _goroutineWrites interface{}
+		var _count interface{}
+		var _moduleWarnings interface{}
+		var _startWarnings interface{}
+		var _definitionsIn interface{}
+		var _assignsIn interface{}
+		var _find interface{}
+		var _lists interface{}
+		var _structs interface{}
+		var _codetree interface{}
+		var _fold interface{}
+		var _foldr interface{}
+		var _map interface{}
+		var _filter interface{}
+		var _contains interface{}
+		var _sort interface{}
+		var _flat interface{}
+		var _flats interface{}
+		var _uniq interface{}
+		var _every interface{}
+		var _some interface{}
+		var _join interface{}
+		var _joins interface{}
+		var _formats interface{}
+		var _enum interface{}
+		var _log interface{}
+		var _fatal interface{}
+		var _bind interface{}
+		var _identity interface{}
+		var _eq interface{}
+		var _any interface{}
+		var _function interface{}
+		var _channel interface{}
+		var _natural interface{}
+		var _type interface{}
+		var _listOf interface{}
+		var _structOf interface{}
+		var _range interface{}
+		var _rangeMin interface{}
+		var _listLength interface{}
+		var _or interface{}
+		var _and interface{}
+		var _not interface{}
+		var _predicate interface{}
+		var _is interface{}
+		var direct_spawnsOf func(interface{}, interface{}, interface{}) interface{}
+		mml.Nop(direct_spawnsOf)
+		var direct_startsIn func(interface{}) interface{}
+		mml.Nop(direct_startsIn)
+		var direct_captureSymbol func(interface{}) interface{}
+		mml.Nop(direct_captureSymbol)
+		var direct_assignedSymbols func(interface{}) interface{}
+		mml.Nop(direct_assignedSymbols)
+		var direct_isMutableDefinition func(interface{}) interface{}
+		mml.Nop(direct_isMutableDefinition)
+		var direct_goroutineFunctions func(interface{}, interface{}) interface{}
+		mml.Nop(direct_goroutineFunctions)
+		var direct_goroutineWrites func(interface{}, interface{}) interface{}
+		mml.Nop(direct_goroutineWrites)
+		var direct_count func(interface{}, interface{}) interface{}
+		mml.Nop(direct_count)
+		var direct_moduleWarnings func(interface{}) interface{}
+		mml.Nop(direct_moduleWarnings)
+		var direct_startWarnings func(interface{}, interface{}) interface{}
+		mml.Nop(direct_startWarnings)
+		var direct_definitionsIn func(interface{}) interface{}
+		mml.Nop(direct_definitionsIn)
+		var direct_assignsIn func(interface{}) interface{}
+		mml.Nop(direct_assignsIn)
+		var direct_find func(interface{}) interface{}
+		mml.Nop(direct_find)
+		mml.Nop(_spawnsOf, _startsIn, _captureSymbol, _assignedSymbols, _isMutableDefinition, _goroutineFunctions, _goroutineWrites, _count, _moduleWarnings, _startWarnings, _definitionsIn, _assignsIn, _find, _lists, _structs, _codetree, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _flats, _uniq, _every, _some, _join, _joins, _formats, _enum, _log, _fatal, _bind, _identity, _eq, _any, _function, _channel, _natural, _type, _listOf, _structOf, _range, _rangeMin, _listLength, _or, _and, _not, _predicate, _is)
+		var __lang = use("lang")
+		_fold = __lang.Get("fold")
+		_foldr = __lang.Get("foldr")
+		_map = __lang.Get("map")
+		_filter = __lang.Get("filter")
+		_contains = __lang.Get("contains")
+		_sort = __lang.Get("sort")
+		_flat = __lang.Get("flat")
+		_flats = __lang.Get("flats")
+		_uniq = __lang.Get("uniq")
+		_every = __lang.Get("every")
+		_some = __lang.Get("some")
+		_join = __lang.Get("join")
+		_joins = __lang.Get("joins")
+		_formats = __lang.Get("formats")
+		_enum = __lang.Get("enum")
+		_log = __lang.Get("log")
+		_fatal = __lang.Get("fatal")
+		_bind = __lang.Get("bind")
+		_identity = __lang.Get("identity")
+		_eq = __lang.Get("eq")
+		_any = __lang.Get("any")
+		_function = __lang.Get("function")
+		_channel = __lang.Get("channel")
+		_natural = __lang.Get("natural")
+		_type = __lang.Get("type")
+		_listOf = __lang.Get("listOf")
+		_structOf = __lang.Get("structOf")
+		_range = __lang.Get("range")
+		_rangeMin = __lang.Get("rangeMin")
+		_listLength = __lang.Get("listLength")
+		_or = __lang.Get("or")
+		_and = __lang.Get("and")
+		_not = __lang.Get("not")
+		_predicate = __lang.Get("predicate")
+		_is = __lang.Get("is")
+		_lists = use("lists")
+		_structs = use("structs")
+		_codetree = use("codetree")
+		direct_definitionsIn = func(_code interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return mml.Ref(_codetree, "filter").(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "definition"); ; return s }()}), _code})
+		}
+		_definitionsIn = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _g = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_g)
-				return _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, ";\n")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _do)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_g, "definitions"))}).Values))}).Values)
+				return direct_definitionsIn(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_assign = &mml.Function{
+		direct_assignsIn = func(_code interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return mml.Ref(_codetree, "filter").(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "assign"); ; return s }()}), _code})
+		}
+		_assignsIn = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _a = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_a)
-				return func() interface{} {
-					c = mml.BinaryOp(11, mml.Ref(mml.Ref(_a, "capture"), "type"), "symbol")
-					if c.(bool) {
-						return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "%s = %s", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "capture"))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "value"))}).Values))}).Values)
-					} else {
-						return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "mml.SetRef(%s, %s, %s)", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_a, "capture"), "expression"))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_a, "capture"), "index"))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "value"))}).Values))}).Values)
-					}
-				}()
+				return direct_assignsIn(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_ret = &mml.Function{
+		direct_spawnsOf = func(_name, _functionIndex, _nodes interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _filter.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _a = a[0]
+					mml.Nop(_a)
+					return (mml.BinaryOp(15, _len.(*mml.Function).Call([]interface{}{mml.Ref(_a, "args")}), _functionIndex).(bool) && !_some.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "spread"); ; return s }()}), mml.RefRange(mml.Ref(_a, "args"), nil, mml.BinaryOp(9, _functionIndex, 1))}).(bool))
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("type", "application")
+				s.Set("function", func() interface{} { s := &mml.Struct{}; s.Set("type", "symbol"); s.Set("name", _name); ; return s }())
+				return s
+			}()})}).(*mml.Function).Call([]interface{}{_nodes})})
+		}
+		_spawnsOf = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _r = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_r)
-				return func() interface{} {
-					c = _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "value", _r)}).Values)
-					if c.(bool) {
-						return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "return %s", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_r, "value"))}).Values))}).Values)
-					} else {
-						return "return"
-					}
-				}()
+				return direct_spawnsOf(a[0], a[1], a[2])
 			},
-			FixedArgs: 1,
+			FixedArgs: 3,
+		}
+		direct_startsIn = func(_code interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _nodes interface{}
+			mml.Nop(_nodes)
+			_nodes = mml.Ref(_codetree, "filter").(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _c = a[0]
+					mml.Nop(_c)
+					return (mml.BinaryOp(11, mml.Ref(_c, "type"), "go-statement").(bool) || mml.BinaryOp(11, mml.Ref(_c, "type"), "application").(bool))
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_code})
+			return (&mml.List{}).Concat(_map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _g = a[0]
+					mml.Nop(_g)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Set("node", _g)
+						s.Set("function", mml.Ref(mml.Ref(_g, "application"), "function"))
+						s.Set("args", mml.Ref(mml.Ref(_g, "application"), "args"))
+						return s
+					}()
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "go-statement"); ; return s }()})}).(*mml.Function).Call([]interface{}{_nodes})}).(*mml.List)).Concat(_map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _a = a[0]
+					mml.Nop(_a)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Set("node", _a)
+						s.Set("function", mml.Ref(mml.Ref(_a, "args"), 0))
+						s.Set("args", mml.RefRange(mml.Ref(_a, "args"), 1, nil))
+						return s
+					}()
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_spawnsOf.(*mml.Function).Call([]interface{}{"spawn", 0}).(*mml.Function).Call([]interface{}{_nodes})}).(*mml.List)).Concat(_map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _a = a[0]
+					mml.Nop(_a)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Set("node", _a)
+						s.Set("function", mml.Ref(mml.Ref(_a, "args"), 1))
+						s.Set("args", mml.RefRange(mml.Ref(_a, "args"), 2, nil))
+						return s
+					}()
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_spawnsOf.(*mml.Function).Call([]interface{}{"spawnIn", 1}).(*mml.Function).Call([]interface{}{_nodes})}).(*mml.List))
 		}
-		_checkRet = &mml.Function{
+		_startsIn = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _r = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_r)
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "if v := %s; mml.IsError.F([]interface{}{v}).(bool) { return v }", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_r, "value"))}).Values))}).Values)
+				return direct_startsIn(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_useStatement = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _u = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_u)
+		direct_captureSymbol = func(_capture interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			a := []interface{}{_capture}
+			_ = a
+		tailcall:
+			for {
+				var _capture = a[0]
+				mml.Nop(_capture)
 
 				mml.Nop()
-				switch {
-				case _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["capture"] = "."
-					return s
-				}(), _u)}).Values):
-					var _statement interface{}
-					var _assigns interface{}
-					mml.Nop(_statement, _assigns)
-					_statement = _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "var __%s = mml.Modules.Use(\"%s\");", mml.Ref(_code, "getModuleName").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_u, "path"), "value"))}).Values), mml.Ref(mml.Ref(_u, "path"), "value"))}).Values)
-					_assigns = _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, ";\n")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-						F: func(a []interface{}) interface{} {
-							var c interface{}
-							mml.Nop(c)
-							var _name = a[0]
-							var _ interface{}
-							_ = &mml.List{a[1:]}
-							mml.Nop(_name)
-							return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "_%s = __%s.Values[\"%s\"]", _name, mml.Ref(_code, "getModuleName").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_u, "path"), "value"))}).Values), _name)}).Values)
-						},
-						FixedArgs: 1,
-					}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_structs, "get").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "symbol")}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-						s := &mml.Struct{Values: make(map[string]interface{})}
-						s.Values["exported"] = true
-						return s
-					}())}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _getDefinitions.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_u, "module"), "body"))}).Values))}).Values))}).Values))}).Values))}).Values)
-					return _joins.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, ";", _statement, _assigns)}).Values)
-				case _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["capture"] = _any
-					return s
-				}(), _u)}).Values):
+				switch mml.Ref(_capture, "type") {
+				case "symbol":
+
+					mml.Nop()
+					return mml.Ref(_capture, "name")
+				case "indexer":
 
 					mml.Nop()
-					return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "_%s = mml.Modules.Use(\"%s\")", mml.Ref(_u, "capture"), mml.Ref(mml.Ref(_u, "path"), "value"))}).Values)
+					a = []interface{}{mml.Ref(_capture, "expression")}
+					continue tailcall
 				default:
 
 					mml.Nop()
-					return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "_%s = mml.Modules.Use(\"%s\")", mml.Ref(_code, "getModuleName").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_u, "path"), "value"))}).Values), mml.Ref(mml.Ref(_u, "path"), "value"))}).Values)
+					return ""
 				}
-				return nil
-			},
-			FixedArgs: 1,
+			}
 		}
-		_useList = &mml.Function{
+		_captureSymbol = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _u = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_u)
-				return _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, ";\n")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _do)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_u, "uses"))}).Values))}).Values)
+				return direct_captureSymbol(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_module = &mml.Function{
+		direct_assignedSymbols = func(_code interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _filter.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _s = a[0]
+					mml.Nop(_s)
+					return mml.BinaryOp(12, _s, "")
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _a = a[0]
+					mml.Nop(_a)
+					return direct_captureSymbol(mml.Ref(_a, "capture"))
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{direct_assignsIn(_code)})})
+		}
+		_assignedSymbols = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _m = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_m)
-				return _joins.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "\n", _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "modulePath = \"%s\"", mml.Ref(_m, "path"))}).Values), mml.Ref(_snippets, "moduleHead"), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_m, "body"))}).Values), mml.Ref(_snippets, "moduleFooter"))}).Values)
+				return direct_assignedSymbols(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_statementList = &mml.Function{
+		direct_isMutableDefinition = func(_d interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return (mml.Ref(_d, "mutable").(bool) || _is.(*mml.Function).Call([]interface{}{func() interface{} {
+				s := &mml.Struct{}
+				s.Set("type", _or.(*mml.Function).Call([]interface{}{"list", "struct"}))
+				s.Set("mutable", true)
+				return s
+			}(), mml.Ref(_d, "expression")}).(bool))
+		}
+		_isMutableDefinition = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _l = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_l)
-				var _scopeDefs interface{}
-				var _scope interface{}
-				var _scopeNames interface{}
-				var _statements interface{}
-				mml.Nop(_scopeDefs, _scope, _scopeNames, _statements)
-				_scope = _getScope.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _l)}).Values)
-				_scopeNames = _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, ", ", _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _bind.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_strings, "formats"), "_%s")}).Values), _scope)}).Values))}).Values)
-				_statements = _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, ";\n")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _do, mml.Ref(_l, "statements"))}).Values))}).Values)
-				_scopeDefs = _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, ";\n")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _s = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_s)
-						return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "var _%s interface{}", _s)}).Values)
-					},
-					FixedArgs: 1,
-				})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _scope)}).Values))}).Values)
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "%s;\nmml.Nop(%s);\n%s", _scopeDefs, _scopeNames, _statements)}).Values)
-				return nil
+				return direct_isMutableDefinition(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_do = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _code = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_code)
+		direct_goroutineFunctions = func(_definitions, _g interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _f interface{}
+			mml.Nop(_f)
+			_f = mml.Ref(_g, "function")
+			switch mml.Ref(_f, "type") {
+			case "function":
 
 				mml.Nop()
-				switch {
-				case mml.BinaryOp(11, mml.Ref(_code, "type"), "int"):
-
-					mml.Nop()
-					return _intLiteral.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case mml.BinaryOp(11, mml.Ref(_code, "type"), "float"):
-
-					mml.Nop()
-					return _floatLiteral.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case mml.BinaryOp(11, mml.Ref(_code, "type"), "string"):
-
-					mml.Nop()
-					return _stringLiteral.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case mml.BinaryOp(11, mml.Ref(_code, "type"), "bool"):
-
-					mml.Nop()
-					return _boolLiteral.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				}
-				switch mml.Ref(_code, "type") {
-				case "comment":
-
-					mml.Nop()
-					return ""
-				case "symbol":
-
-					mml.Nop()
-					return _symbol.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "list":
-
-					mml.Nop()
-					return _list.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "expression-key":
-
-					mml.Nop()
-					return _expressionKey.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "struct":
-
-					mml.Nop()
-					return _struct.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "function":
-
-					mml.Nop()
-					return _functionLiteral.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "indexer":
-
-					mml.Nop()
-					return _indexer.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "spread":
-
-					mml.Nop()
-					return _spread.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "application":
-
-					mml.Nop()
-					return _application.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "unary":
-
-					mml.Nop()
-					return _unary.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "binary":
-
-					mml.Nop()
-					return _binary.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "cond":
-
-					mml.Nop()
-					return _cond.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "switch-case":
-
-					mml.Nop()
-					return _caseBlock.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "switch-statement":
-
-					mml.Nop()
-					return _switchStatement.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "send-statement":
-
-					mml.Nop()
-					return _sendStatement.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "receive-expression":
-
-					mml.Nop()
-					return _receiveExpression.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "go-statement":
-
-					mml.Nop()
-					return _goStatement.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "defer-statement":
-
-					mml.Nop()
-					return _deferStatement.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "select-case":
-
-					mml.Nop()
-					return _caseBlock.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "select-statement":
-
-					mml.Nop()
-					return _selectStatement.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "range-over":
-
-					mml.Nop()
-					return _rangeOver.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "break":
-
-					mml.Nop()
-					return _breakStatement.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "continue":
-
-					mml.Nop()
-					return _continueStatement.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "loop":
+				return (&mml.List{}).Append(_f)
+			case "symbol":
 
-					mml.Nop()
-					return _loop.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "definition":
-
-					mml.Nop()
-					return _definition.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "definition-group":
-
-					mml.Nop()
-					return _definitionGroup.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "assign":
-
-					mml.Nop()
-					return _assign.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "ret":
-
-					mml.Nop()
-					return _ret.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "check-ret":
-
-					mml.Nop()
-					return _checkRet.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "use":
-
-					mml.Nop()
-					return _useStatement.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "use-list":
-
-					mml.Nop()
-					return _useList.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				case "module":
-
-					mml.Nop()
-					return _module.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-				default:
+				mml.Nop()
+				return _map.(*mml.Function).Call([]interface{}{mml.Ref(_structs, "get").(*mml.Function).Call([]interface{}{"expression"})}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} {
+					s := &mml.Struct{}
+					s.Set("symbol", mml.Ref(_f, "name"))
+					s.Set("expression", func() interface{} { s := &mml.Struct{}; s.Set("type", "function"); ; return s }())
+					return s
+				}()})}).(*mml.Function).Call([]interface{}{_definitions})})
+			default:
 
-					mml.Nop()
-					return _statementList.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
+				mml.Nop()
+				return (&mml.List{})
+			}
+		}
+		_goroutineFunctions = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_goroutineFunctions(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_goroutineWrites = func(_definitions, _g interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _functionWrites interface{}
+			mml.Nop(_functionWrites)
+			_functionWrites = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _f = a[0]
+					mml.Nop(_f)
+					var _captured interface{}
+					var _throughParams interface{}
+					var _local interface{}
+					var _assigned interface{}
+					var _args interface{}
+					mml.Nop(_captured, _throughParams, _local, _assigned, _args)
+					_local = (&mml.List{}).Concat(mml.Ref(_f, "params").(*mml.List)).Append(mml.Ref(_f, "collectParam")).Concat(_map.(*mml.Function).Call([]interface{}{mml.Ref(_structs, "get").(*mml.Function).Call([]interface{}{"symbol"})}).(*mml.Function).Call([]interface{}{direct_definitionsIn(mml.Ref(_f, "body"))}).(*mml.List))
+					_assigned = direct_assignedSymbols(mml.Ref(_f, "body"))
+					_args = mml.Ref(_g, "args")
+					_captured = _filter.(*mml.Function).Call([]interface{}{&mml.Function{
+						F: func(a []interface{}) interface{} {
+							var c interface{}
+							mml.Nop(c)
+							var _s = a[0]
+							mml.Nop(_s)
+							return !_contains.(*mml.Function).Call([]interface{}{_s, _local}).(bool)
+						},
+						FixedArgs: 1,
+					}}).(*mml.Function).Call([]interface{}{_assigned})
+					_throughParams = _map.(*mml.Function).Call([]interface{}{mml.Ref(_structs, "get").(*mml.Function).Call([]interface{}{"name"})}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "symbol"); ; return s }()})}).(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{&mml.Function{
+						F: func(a []interface{}) interface{} {
+							var c interface{}
+							mml.Nop(c)
+							var _i = a[0]
+							mml.Nop(_i)
+							return mml.Ref(_args, _i)
+						},
+						FixedArgs: 1,
+					}}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{&mml.Function{
+						F: func(a []interface{}) interface{} {
+							var c interface{}
+							mml.Nop(c)
+							var _i = a[0]
+							mml.Nop(_i)
+							return (_contains.(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_f, "params"), _i), _assigned}).(bool) && mml.BinaryOp(13, _i, _len.(*mml.Function).Call([]interface{}{_args})).(bool))
+						},
+						FixedArgs: 1,
+					}}).(*mml.Function).Call([]interface{}{mml.Ref(_lists, "indexes").(*mml.Function).Call([]interface{}{mml.Ref(_f, "params")})})})})})
+					return (&mml.List{}).Concat(_captured.(*mml.List)).Concat(_throughParams.(*mml.List))
+				},
+				FixedArgs: 1,
+			}
+			return _uniq.(*mml.Function).Call([]interface{}{_eq}).(*mml.Function).Call([]interface{}{_flat.(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_functionWrites}).(*mml.Function).Call([]interface{}{direct_goroutineFunctions(_definitions, _g)})})})
+		}
+		_goroutineWrites = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_goroutineWrites(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_count = func(_v, _l interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _len.(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _i = a[0]
+					mml.Nop(_i)
+					return mml.BinaryOp(11, _i, _v)
+				},
+				FixedArgs: 1,
+			}, _l})})
+		}
+		_count = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_count(a[0], a[1])
+			},
+			FixedArgs: 2,
+		}
+		direct_moduleWarnings = func(_module interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _gos interface{}
+			mml.Nop(_gos)
+			_gos = direct_startsIn(mml.Ref(_module, "body"))
+			return func() interface{} {
+				c = mml.BinaryOp(11, _len.(*mml.Function).Call([]interface{}{_gos}), 0)
+				if c.(bool) {
+					return (&mml.List{})
+				} else {
+					return direct_startWarnings(_module, _gos)
 				}
-				return nil
+			}()
+		}
+		_moduleWarnings = &mml.Function{
+			F: func(a []interface{}) interface{} {
+				return direct_moduleWarnings(a[0])
 			},
 			FixedArgs: 1,
 		}
-		_allModules = &mml.Function{
+		direct_startWarnings = func(_module, _gos interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			var _writes interface{}
+			var _definitions interface{}
+			var _mutable interface{}
+			var _inLoops interface{}
+			var _allAssigned interface{}
+			var _goroutineFuncs interface{}
+			var _assignedInGos interface{}
+			var _fromGos interface{}
+			var _inLoop interface{}
+			var _fromMultiple interface{}
+			var _writtenOutside interface{}
+			var _racing interface{}
+			mml.Nop(_writes, _definitions, _mutable, _inLoops, _allAssigned, _goroutineFuncs, _assignedInGos, _fromGos, _inLoop, _fromMultiple, _writtenOutside, _racing)
+			_definitions = direct_definitionsIn(mml.Ref(_module, "body"))
+			_mutable = _map.(*mml.Function).Call([]interface{}{mml.Ref(_structs, "get").(*mml.Function).Call([]interface{}{"symbol"})}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_isMutableDefinition}).(*mml.Function).Call([]interface{}{_definitions})})
+			_inLoops = _map.(*mml.Function).Call([]interface{}{mml.Ref(_structs, "get").(*mml.Function).Call([]interface{}{"node"})}).(*mml.Function).Call([]interface{}{_flat.(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_startsIn}).(*mml.Function).Call([]interface{}{mml.Ref(_codetree, "filter").(*mml.Function).Call([]interface{}{_is.(*mml.Function).Call([]interface{}{func() interface{} { s := &mml.Struct{}; s.Set("type", "loop"); ; return s }()})}).(*mml.Function).Call([]interface{}{mml.Ref(_module, "body")})})})})
+			_writes = _map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _g = a[0]
+					mml.Nop(_g)
+					return func() interface{} {
+						s := &mml.Struct{}
+						s.Set("start", _g)
+						s.Set("symbols", _filter.(*mml.Function).Call([]interface{}{&mml.Function{
+							F: func(a []interface{}) interface{} {
+								var c interface{}
+								mml.Nop(c)
+								var _s = a[0]
+								mml.Nop(_s)
+								return _contains.(*mml.Function).Call([]interface{}{_s, _mutable})
+							},
+							FixedArgs: 1,
+						}}).(*mml.Function).Call([]interface{}{direct_goroutineWrites(_definitions, _g)}))
+						return s
+					}()
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_gos})
+			_allAssigned = direct_assignedSymbols(mml.Ref(_module, "body"))
+			_goroutineFuncs = _uniq.(*mml.Function).Call([]interface{}{_eq}).(*mml.Function).Call([]interface{}{_flat.(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_goroutineFunctions.(*mml.Function).Call([]interface{}{_definitions})}).(*mml.Function).Call([]interface{}{_gos})})})
+			_assignedInGos = _flat.(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _f = a[0]
+					mml.Nop(_f)
+					return direct_assignedSymbols(mml.Ref(_f, "body"))
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_goroutineFuncs})})
+			_fromGos = _flat.(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{mml.Ref(_structs, "get").(*mml.Function).Call([]interface{}{"symbols"})}).(*mml.Function).Call([]interface{}{_writes})})
+			_inLoop = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _s = a[0]
+					mml.Nop(_s)
+					return _some.(*mml.Function).Call([]interface{}{&mml.Function{
+						F: func(a []interface{}) interface{} {
+							var c interface{}
+							mml.Nop(c)
+							var _w = a[0]
+							mml.Nop(_w)
+							return (_contains.(*mml.Function).Call([]interface{}{mml.Ref(mml.Ref(_w, "start"), "node"), _inLoops}).(bool) && _contains.(*mml.Function).Call([]interface{}{_s, mml.Ref(_w, "symbols")}).(bool))
+						},
+						FixedArgs: 1,
+					}, _writes})
+				},
+				FixedArgs: 1,
+			}
+			_fromMultiple = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _s = a[0]
+					mml.Nop(_s)
+					return mml.BinaryOp(15, direct_count(_s, _fromGos), 1)
+				},
+				FixedArgs: 1,
+			}
+			_writtenOutside = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _s = a[0]
+					mml.Nop(_s)
+					return mml.BinaryOp(15, direct_count(_s, _allAssigned), direct_count(_s, _assignedInGos))
+				},
+				FixedArgs: 1,
+			}
+			_racing = &mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _s = a[0]
+					mml.Nop(_s)
+					return ((_inLoop.(*mml.Function).Call([]interface{}{_s}).(bool) || _fromMultiple.(*mml.Function).Call([]interface{}{_s}).(bool)) || _writtenOutside.(*mml.Function).Call([]interface{}{_s}).(bool))
+				},
+				FixedArgs: 1,
+			}
+			return _map.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _s = a[0]
+					mml.Nop(_s)
+					return _formats.(*mml.Function).Call([]interface{}{"%s: mutable value may be changed from multiple goroutines: %s", mml.Ref(_module, "path"), _s})
+				},
+				FixedArgs: 1,
+			}}).(*mml.Function).Call([]interface{}{_sort.(*mml.Function).Call([]interface{}{&mml.Function{
+				F: func(a []interface{}) interface{} {
+					var c interface{}
+					mml.Nop(c)
+					var _left = a[0]
+					var _right = a[1]
+					mml.Nop(_left, _right)
+					return mml.BinaryOp(13, _left, _right)
+				},
+				FixedArgs: 2,
+			}}).(*mml.Function).Call([]interface{}{_filter.(*mml.Function).Call([]interface{}{_racing}).(*mml.Function).Call([]interface{}{_uniq.(*mml.Function).Call([]interface{}{_eq}).(*mml.Function).Call([]interface{}{_fromGos})})})})
+		}
+		_startWarnings = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _module = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_module)
-				return _uniq.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _eq)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _bind.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _concats, &mml.List{Values: append([]interface{}{}, _module)})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _flat.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _allModules)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_structs, "get").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "module")}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_codetree, "filter").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _is.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} {
-					s := &mml.Struct{Values: make(map[string]interface{})}
-					s.Values["type"] = "use"
-					return s
-				}())}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _module)}).Values))}).Values))}).Values))}).Values))}).Values))}).Values)
+				return direct_startWarnings(a[0], a[1])
 			},
-			FixedArgs: 1,
+			FixedArgs: 2,
 		}
-		_toGo = &mml.Function{
+		direct_find = func(_modules interface{}) interface{} {
+			var c interface{}
+			mml.Nop(c)
+			return _flat.(*mml.Function).Call([]interface{}{_map.(*mml.Function).Call([]interface{}{_moduleWarnings}).(*mml.Function).Call([]interface{}{_modules})})
+		}
+		_find = &mml.Function{
 			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _module = a[0]
-				var _ interface{}
-				_ = &mml.List{a[1:]}
-				mml.Nop(_module)
-				return _joins.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "", mml.Ref(_snippets, "head"), _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, ";\n")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _k = a[0]
-						var _ interface{}
-						_ = &mml.List{a[1:]}
-						mml.Nop(_k)
-						return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "var _%s interface{} = mml.%s", _k, mml.Ref(mml.Ref(_code, "builtin"), _k))}).Values)
-					},
-					FixedArgs: 1,
-				})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _sort.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-					F: func(a []interface{}) interface{} {
-						var c interface{}
-						mml.Nop(c)
-						var _left = a[0]
-						var _right = a[1]
-						var _ interface{}
-						_ = &mml.List{a[2:]}
-						mml.Nop(_left, _right)
-						return mml.BinaryOp(13, _left, _right)
-					},
-					FixedArgs: 2,
-				})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _keys.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_code, "builtin"))}).Values))}).Values))}).Values))}).Values), mml.Ref(_snippets, "initHead"), _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "\n")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _do)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _allModules.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _module)}).Values))}).Values))}).Values), mml.Ref(_snippets, "initFooter"), mml.Ref(_snippets, "mainHead"), mml.Ref(_module, "path"), mml.Ref(_snippets, "mainFooter"))}).Values)
+				return direct_find(a[0])
 			},
 			FixedArgs: 1,
 		}
-		exports["toGo"] = _toGo
-
-		return exports
-	})
-
-	modulePath = "snippets"
-
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
-		exports := make(map[string]interface{})
-
-		var c interface{}
-		mml.Nop(c)
-
-		var _head interface{}
-		var _initHead interface{}
-		var _initFooter interface{}
-		var _moduleHead interface{}
-		var _moduleFooter interface{}
-		var _mainHead interface{}
-		var _mainFooter interface{}
-		mml.Nop(_head, _initHead, _initFooter, _moduleHead, _moduleFooter, _mainHead, _mainFooter)
-		_head = "// Generated code\npackage main\n\nimport \"github.com/aryszka/mml\"\n"
-		exports["head"] = _head
-		_initHead = "\nfunc init() {\n\tvar modulePath string\n"
-		exports["initHead"] = _initHead
-		_initFooter = "\n}\n"
-		exports["initFooter"] = _initFooter
-		_moduleHead = "\n\tmml.Modules.Set(modulePath, func() map[string]interface{} {\n\t\texports := make(map[string]interface{})\n\n\t\tvar c interface{}\n\t\tmml.Nop(c)\n"
-		exports["moduleHead"] = _moduleHead
-		_moduleFooter = "\n\t\treturn exports\n\t})\n"
-		exports["moduleFooter"] = _moduleFooter
-		_mainHead = "\nfunc main() {\n\tmml.Modules.Use(\""
-		exports["mainHead"] = _mainHead
-		_mainFooter = "\")\n}\n"
-		exports["mainFooter"] = _mainFooter
+		exports["find"] = _find
 
 		return exports
 	})
@@ -8053,5 +18713,5 @@ func init() {
 }
 
 func main() {
-	mml.Modules.Use("main")
+	mml.Modules.MustUse("main")
 }
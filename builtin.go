@@ -2,11 +2,17 @@ package mml
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"go/format"
 	"io"
 	"io/ioutil"
 	"os"
+	"os/exec"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -52,31 +58,56 @@ const (
 	logicalOr
 )
 
-type List struct {
-	Values []interface{}
-}
-
-type Struct struct {
-	Values map[string]interface{}
-}
-
 type Function struct {
 	F         func([]interface{}) interface{}
 	FixedArgs int
 	args      []interface{}
+
+	// returns a version of the function that reports the goroutines that it starts or blocks with the position
+	// of the call, see At
+	at func(position string) *Function
 }
 
+// ModuleInitializer initializes a module, and returns its exported definitions. The modules that the module
+// depends on are used with the use function, which panics with a *ModuleError when a module cannot be used.
+type ModuleInitializer func(use func(path string) *Struct) map[string]interface{}
+
 type ModuleContext struct {
 	lock         sync.Mutex
-	moduleLocks  map[string]*sync.Mutex
-	initializers map[string]func() map[string]interface{}
+	initializers map[string]ModuleInitializer
 	cache        map[string]map[string]interface{}
+	failed       map[string]*ModuleError
+
+	// closed when the initialization of the module has finished
+	initializing map[string]chan struct{}
+}
+
+// ModuleError is returned when a module cannot be used, because it is not registered, because it uses itself
+// directly or indirectly, or because its initialization failed. When the initialization failed with a panic,
+// Err contains the panic value as an error.
+type ModuleError struct {
+	Path string
+	Err  error
 }
 
+var (
+	ErrModuleNotFound    = errors.New("module not found")
+	ErrCircularReference = errors.New("circular module reference")
+)
+
 var Modules = &ModuleContext{
-	moduleLocks:  make(map[string]*sync.Mutex),
-	initializers: make(map[string]func() map[string]interface{}),
+	initializers: make(map[string]ModuleInitializer),
 	cache:        make(map[string]map[string]interface{}),
+	failed:       make(map[string]*ModuleError),
+	initializing: make(map[string]chan struct{}),
+}
+
+func (e *ModuleError) Error() string {
+	return fmt.Sprintf("module %s: %v", e.Path, e.Err)
+}
+
+func (e *ModuleError) Unwrap() error {
+	return e.Err
 }
 
 func (f *Function) Bind(a []interface{}) *Function {
@@ -86,7 +117,10 @@ func (f *Function) Bind(a []interface{}) *Function {
 }
 
 func (f *Function) Call(a []interface{}) interface{} {
-	a = append(f.args, a...)
+	if len(f.args) > 0 {
+		a = append(f.args[:len(f.args):len(f.args)], a...)
+	}
+
 	if len(a) < f.FixedArgs {
 		return f.Bind(a)
 	}
@@ -94,36 +128,150 @@ func (f *Function) Call(a []interface{}) interface{} {
 	return f.F(a)
 }
 
-func (c *ModuleContext) Set(path string, i func() map[string]interface{}) {
+func (c *ModuleContext) Set(path string, i ModuleInitializer) {
 	c.initializers[path] = i
 }
 
-func (c *ModuleContext) Use(path string) *Struct {
+// Paths returns the paths of the registered modules, in alphabetical order. It can be used to find the modules
+// that can be loaded dynamically with Use.
+func (c *ModuleContext) Paths() []string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	var p []string
+	for path := range c.initializers {
+		p = append(p, path)
+	}
+
+	sort.Strings(p)
+	return p
+}
+
+func initModule(path string, init func() map[string]interface{}) (m map[string]interface{}, err *ModuleError) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+
+		if rerr, ok := r.(error); ok {
+			err = &ModuleError{Path: path, Err: rerr}
+			return
+		}
+
+		err = &ModuleError{Path: path, Err: fmt.Errorf("%v", r)}
+	}()
+
+	return init(), nil
+}
+
+// Use returns the exported definitions of a module as a struct, and initializes the module when it is used for
+// the first time. When the module cannot be used, it returns a *ModuleError as the error.
+//
+// The modules used by a module are initialized during its initialization, on the same goroutine, and when the
+// same chain of initializations uses a module again, the modules use each other in a circle. In this case,
+// instead of waiting for itself, Use returns an error with the chain of the modules. When a module is used on
+// another goroutine while it is being initialized, Use waits for the initialization to finish.
+//
+// When the initialization of a module fails with a panic, the error is returned by every later call, too, and
+// the initialization is not repeated. When it fails only because a module that it uses could not be used, it
+// is repeated by the next call.
+func (c *ModuleContext) Use(path string) (*Struct, error) {
+	m, err := c.use(nil, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// MustUse is like Use, but it panics with the error when the module cannot be used. The generated code uses the
+// modules in the main function with MustUse.
+func (c *ModuleContext) MustUse(path string) *Struct {
+	return c.mustUse(nil, path)
+}
+
+func (c *ModuleContext) mustUse(chain []string, path string) *Struct {
+	m, err := c.use(chain, path)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+// the chain contains the modules whose initialization is using the module, on the current goroutine
+func (c *ModuleContext) use(chain []string, path string) (*Struct, *ModuleError) {
+	for i, p := range chain {
+		if p == path {
+			return nil, &ModuleError{
+				Path: path,
+				Err: fmt.Errorf(
+					"%w: %s",
+					ErrCircularReference,
+					strings.Join(append(chain[i:len(chain):len(chain)], path), " -> "),
+				),
+			}
+		}
+	}
+
 	c.lock.Lock()
-	m, ok := c.cache[path]
-	if ok {
+	for {
+		if m, ok := c.cache[path]; ok {
+			c.lock.Unlock()
+			return NewStruct(m), nil
+		}
+
+		if err, ok := c.failed[path]; ok {
+			c.lock.Unlock()
+			return nil, err
+		}
+
+		done, ok := c.initializing[path]
+		if !ok {
+			break
+		}
+
 		c.lock.Unlock()
-		return &Struct{m}
+		<-done
+		c.lock.Lock()
 	}
 
-	init := c.initializers[path]
-	ml, ok := c.moduleLocks[path]
+	init, ok := c.initializers[path]
 	if !ok {
-		ml = &sync.Mutex{}
-		c.moduleLocks[path] = ml
+		c.lock.Unlock()
+		return nil, &ModuleError{Path: path, Err: ErrModuleNotFound}
 	}
 
-	ml.Lock()
+	done := make(chan struct{})
+	c.initializing[path] = done
 	c.lock.Unlock()
 
-	m = init()
+	chain = append(chain[:len(chain):len(chain)], path)
+	m, err := initModule(path, func() map[string]interface{} {
+		return init(func(p string) *Struct { return c.mustUse(chain, p) })
+	})
 
 	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.initializing, path)
+	close(done)
+	if err != nil {
+		if !usedModuleFailed(err) {
+			c.failed[path] = err
+		}
+
+		return nil, err
+	}
+
 	c.cache[path] = m
-	c.lock.Unlock()
-	ml.Unlock()
+	return NewStruct(m), nil
+}
 
-	return &Struct{m}
+// tells whether the initialization of a module failed, because a module that it uses could not be used
+func usedModuleFailed(err *ModuleError) bool {
+	var used *ModuleError
+	return errors.As(err.Err, &used) && used.Path != err.Path
 }
 
 func Ref(v, k interface{}) interface{} {
@@ -131,9 +279,9 @@ func Ref(v, k interface{}) interface{} {
 	case string:
 		return string(vt[k.(int)])
 	case *List:
-		return vt.Values[k.(int)]
+		return vt.Get(k.(int))
 	case *Struct:
-		ret := vt.Values[k.(string)]
+		ret := vt.Get(k.(string))
 		if ret == nil {
 			panic("ref: undefined key: " + k.(string))
 		}
@@ -160,13 +308,13 @@ func RefRange(v, from, to interface{}) interface{} {
 	case *List:
 		switch {
 		case from == nil && to == nil:
-			return &List{vt.Values[:]}
+			return vt.Slice(0, vt.Len())
 		case from == nil:
-			return &List{vt.Values[:to.(int)]}
+			return vt.Slice(0, to.(int))
 		case to == nil:
-			return &List{vt.Values[from.(int):]}
+			return vt.Slice(from.(int), vt.Len())
 		default:
-			return &List{vt.Values[from.(int):to.(int)]}
+			return vt.Slice(from.(int), to.(int))
 		}
 	default:
 		panic("ref range: unsupported code")
@@ -176,9 +324,9 @@ func RefRange(v, from, to interface{}) interface{} {
 func SetRef(e, k, v interface{}) interface{} {
 	switch et := e.(type) {
 	case *List:
-		et.Values[k.(int)] = v
+		et.Set(k.(int), v)
 	case *Struct:
-		et.Values[k.(string)] = v
+		et.Set(k.(string), v)
 	default:
 		panic("set-ref: unsupported code")
 	}
@@ -438,10 +586,16 @@ var Len = &Function{
 	F: func(a []interface{}) interface{} {
 		switch at := a[0].(type) {
 		case *List:
-			return len(at.Values)
+			return at.Len()
 		case *Struct:
-			return len(at.Values)
+			return at.Len()
 		case string:
+			return len(at)
+		case chan interface{}:
+			if schedule != nil {
+				return schedule.length(at)
+			}
+
 			return len(at)
 		default:
 			panic(fmt.Sprintf("len: unsupported code: %v", a[0]))
@@ -458,11 +612,11 @@ var Keys = &Function{
 		}
 
 		var keys []interface{}
-		for k := range s.Values {
+		for _, k := range s.Keys() {
 			keys = append(keys, k)
 		}
 
-		return &List{Values: keys}
+		return NewList(keys)
 	},
 	FixedArgs: 1,
 }
@@ -479,7 +633,7 @@ var Format = &Function{
 			panic("format: unsupported code: " + fmt.Sprint(a[1]))
 		}
 
-		return fmt.Sprintf(f, args.Values...)
+		return fmt.Sprintf(f, args.Values()...)
 	},
 	FixedArgs: 2,
 }
@@ -585,47 +739,73 @@ var ParseFloat = &Function{
 	FixedArgs: 1,
 }
 
-func convertAST(goAST *parser.Node) *Struct {
+func formatGo(a []interface{}) interface{} {
+	b, err := format.Source([]byte(a[0].(string)))
+	if err != nil {
+		return err
+	}
+
+	return string(b)
+}
+
+var FormatGo = &Function{
+	F: formatGo,
+	FixedArgs: 1,
+}
+
+// the line starts are the offsets of the first characters of the lines in the source
+func lineStarts(doc []rune) []int {
+	starts := []int{0}
+	for i, r := range doc {
+		if r == '\n' {
+			starts = append(starts, i+1)
+		}
+	}
+
+	return starts
+}
+
+func convertAST(goAST *parser.Node, file string, starts []int) *Struct {
 	ast := make(map[string]interface{})
 	ast["name"] = goAST.Name
 	ast["text"] = goAST.Text()
 
-	// TODO:
-	ast["file"] = ""
-	ast["from"] = 0
-	ast["to"] = 0
-	ast["line"] = 0
-	ast["column"] = 0
+	line := sort.Search(len(starts), func(i int) bool { return starts[i] > goAST.From })
+	ast["file"] = file
+	ast["from"] = goAST.From
+	ast["to"] = goAST.To
+	ast["line"] = line
+	ast["column"] = goAST.From - starts[line-1] + 1
 
 	var nodes []interface{}
 	for i := range goAST.Nodes {
-		nodes = append(nodes, convertAST(goAST.Nodes[i]))
+		nodes = append(nodes, convertAST(goAST.Nodes[i], file, starts))
 	}
 
-	ast["nodes"] = &List{nodes}
-	return &Struct{ast}
+	ast["nodes"] = NewList(nodes)
+	return NewStruct(ast)
 }
 
-func parseAST(doc string) (ast *Struct, err error) {
+func parseAST(file, doc string) (ast *Struct, err error) {
 	var goAST *parser.Node
 	goAST, err = parser.Parse(bytes.NewBufferString(doc))
 	if err != nil {
 		return
 	}
 
-	return convertAST(goAST), nil
+	return convertAST(goAST, file, lineStarts(goAST.Tokens())), nil
 }
 
 var ParseAST = &Function{
 	F: func(a []interface{}) interface{} {
-		ast, err := parseAST(a[0].(string))
+		ast, err := parseAST(a[0].(string), a[1].(string))
 		if err != nil {
 			return err
 		}
 
 		return ast
 	},
-	FixedArgs: 1,
+	FixedArgs: 2,
 }
 
 var Int = &Function{
@@ -688,8 +868,7 @@ var Has = &Function{
 			return false
 		}
 
-		_, ok = s.Values[a[0].(string)]
-		return ok
+		return s.Has(a[0].(string))
 	},
 	FixedArgs: 2,
 }
@@ -721,6 +900,154 @@ var Exit = &Function{
 	FixedArgs: 1,
 }
 
+var WriteFile = &Function{
+	F: func(a []interface{}) interface{} {
+		s, ok := a[1].(string)
+		if !ok {
+			panic("writeFile: unsupported code")
+		}
+
+		return ioutil.WriteFile(a[0].(string), []byte(s), 0666)
+	},
+	FixedArgs: 2,
+}
+
+var MakeDir = &Function{
+	F: func(a []interface{}) interface{} {
+		return os.MkdirAll(a[0].(string), 0777)
+	},
+	FixedArgs: 1,
+}
+
+var RemoveAll = &Function{
+	F: func(a []interface{}) interface{} {
+		return os.RemoveAll(a[0].(string))
+	},
+	FixedArgs: 1,
+}
+
+// returns the names of the entries in a directory, sorted
+var ReadDir = &Function{
+	F: func(a []interface{}) interface{} {
+		entries, err := os.ReadDir(a[0].(string))
+		if err != nil {
+			return err
+		}
+
+		var names []interface{}
+		for _, e := range entries {
+			names = append(names, e.Name())
+		}
+
+		return NewList(names)
+	},
+	FixedArgs: 1,
+}
+
+var MakeTempDir = &Function{
+	F: func([]interface{}) interface{} {
+		dir, err := ioutil.TempDir("", "mml")
+		if err != nil {
+			return err
+		}
+
+		return dir
+	},
+}
+
+var Symlink = &Function{
+	F: func(a []interface{}) interface{} {
+		return os.Symlink(a[0].(string), a[1].(string))
+	},
+	FixedArgs: 2,
+}
+
+var WorkDir = &Function{
+	F: func([]interface{}) interface{} {
+		dir, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+
+		return dir
+	},
+}
+
+// returns the path of the executable of the current process
+var Executable = &Function{
+	F: func([]interface{}) interface{} {
+		p, err := os.Executable()
+		if err != nil {
+			return err
+		}
+
+		return p
+	},
+}
+
+var GetEnv = &Function{
+	F: func(a []interface{}) interface{} {
+		v, ok := os.LookupEnv(a[0].(string))
+		if !ok {
+			return fmt.Errorf("environment variable not set: %s", a[0])
+		}
+
+		return v
+	},
+	FixedArgs: 1,
+}
+
+// the modules of the standard library, used by the compiler
+//
+//go:embed channels.mml errors.mml floats.mml functions.mml interop.mml ints.mml io.mml lang.mml lists.mml log.mml
+//go:embed match.mml paths.mml strings.mml structs.mml sync.mml tasks.mml
+var stdlib embed.FS
+
+// returns the source of a module of the standard library, or an error when the module doesn't exist
+var StdlibSource = &Function{
+	F: func(a []interface{}) interface{} {
+		b, err := stdlib.ReadFile(a[0].(string) + ".mml")
+		if err != nil {
+			return fmt.Errorf("no module in the standard library: %s", a[0])
+		}
+
+		return string(b)
+	},
+	FixedArgs: 1,
+}
+
+// executes a command in a directory, with the standard input and output of the current process, and returns
+// the exit code of the command, or an error when the command could not be started
+var Execute = &Function{
+	F: func(a []interface{}) interface{} {
+		var args []string
+		for _, arg := range list(a[2], "execute").Values() {
+			s, ok := arg.(string)
+			if !ok {
+				panic(fmt.Sprintf("execute: unsupported code: %v", arg))
+			}
+
+			args = append(args, s)
+		}
+
+		cmd := exec.Command(a[1].(string), args...)
+		cmd.Dir = a[0].(string)
+		cmd.Stdin = os.Stdin
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			if exitErr, ok := err.(*exec.ExitError); ok {
+				return exitErr.ExitCode()
+			}
+
+			return err
+		}
+
+		return 0
+	},
+	FixedArgs: 3,
+}
+
 var Open = &Function{
 	F: func(a []interface{}) interface{} {
 		f, err := os.Open(a[0].(string))
@@ -763,6 +1090,257 @@ var Open = &Function{
 	FixedArgs: 1,
 }
 
+func list(v interface{}, op string) *List {
+	l, ok := v.(*List)
+	if !ok {
+		panic(fmt.Sprintf("%s: unsupported code: %v", op, v))
+	}
+
+	return l
+}
+
+func call(f interface{}, a ...interface{}) interface{} {
+	return f.(*Function).Call(a)
+}
+
+var ListFold = &Function{
+	F: func(a []interface{}) interface{} {
+		r := a[1]
+		for _, i := range list(a[2], "fold").Values() {
+			r = call(a[0], i, r)
+		}
+
+		return r
+	},
+	FixedArgs: 3,
+}
+
+var ListFoldr = &Function{
+	F: func(a []interface{}) interface{} {
+		r := a[1]
+		l := list(a[2], "foldr").Values()
+		for i := len(l) - 1; i >= 0; i-- {
+			r = call(a[0], l[i], r)
+		}
+
+		return r
+	},
+	FixedArgs: 3,
+}
+
+var ListMap = &Function{
+	F: func(a []interface{}) interface{} {
+		l := list(a[1], "map").Values()
+		for i := range l {
+			l[i] = call(a[0], l[i])
+		}
+
+		return NewList(l)
+	},
+	FixedArgs: 2,
+}
+
+func filter(p interface{}, l []interface{}) []interface{} {
+	var r []interface{}
+	for _, i := range l {
+		if call(p, i).(bool) {
+			r = append(r, i)
+		}
+	}
+
+	return r
+}
+
+// a bottom-up merge sort: it doesn't recurse, so it doesn't depend on the order of the input for its speed or
+// for its use of the stack. It returns the equal items in the reverse of their original order, the same way as
+// the sort of the lists module did before it was implemented natively, so it sorts the reversed list, keeping
+// the order of the equal items in it.
+func sortList(less interface{}, l []interface{}) []interface{} {
+	from := make([]interface{}, len(l))
+	for i := range l {
+		from[len(l)-1-i] = l[i]
+	}
+
+	to := make([]interface{}, len(l))
+	for width := 1; width < len(from); width *= 2 {
+		for low := 0; low < len(from); low += 2 * width {
+			middle, high := low+width, low+2*width
+			if middle > len(from) {
+				middle = len(from)
+			}
+
+			if high > len(from) {
+				high = len(from)
+			}
+
+			i, j := low, middle
+			for k := low; k < high; k++ {
+				if j == high || i < middle && !call(less, from[j], from[i]).(bool) {
+					to[k] = from[i]
+					i++
+					continue
+				}
+
+				to[k] = from[j]
+				j++
+			}
+		}
+
+		from, to = to, from
+	}
+
+	return from
+}
+
+var ListFilter = &Function{
+	F: func(a []interface{}) interface{} {
+		return NewList(filter(a[0], list(a[1], "filter").Values()))
+	},
+	FixedArgs: 2,
+}
+
+var ListSort = &Function{
+	F: func(a []interface{}) interface{} {
+		return NewList(sortList(a[0], list(a[1], "sort").Values()))
+	},
+	FixedArgs: 2,
+}
+
+var StringJoin = &Function{
+	F: func(a []interface{}) interface{} {
+		j, ok := a[0].(string)
+		if !ok {
+			panic(fmt.Sprintf("join: unsupported code: %v", a[0]))
+		}
+
+		l := list(a[1], "join").Values()
+		switch len(l) {
+		case 0:
+			return ""
+		case 1:
+			return l[0]
+		}
+
+		s := make([]string, len(l))
+		for i := range l {
+			if s[i], ok = l[i].(string); !ok {
+				panic(fmt.Sprintf("join: unsupported code: %v", l[i]))
+			}
+		}
+
+		return strings.Join(s, j)
+	},
+	FixedArgs: 2,
+}
+
+// like indexing, escaping and unescaping work on the bytes of the string
+var stringEscapes = map[byte]string{
+	'\b': "\\b",
+	'\f': "\\f",
+	'\n': "\\n",
+	'\r': "\\r",
+	'\t': "\\t",
+	'\v': "\\v",
+	'"':  "\\\"",
+	'\\': "\\\\",
+}
+
+var StringEscape = &Function{
+	F: func(a []interface{}) interface{} {
+		s := a[0].(string)
+		var b strings.Builder
+		for i := 0; i < len(s); i++ {
+			if e, ok := stringEscapes[s[i]]; ok {
+				b.WriteString(e)
+			} else {
+				b.WriteString(string(rune(s[i])))
+			}
+		}
+
+		return b.String()
+	},
+	FixedArgs: 1,
+}
+
+var stringUnescapes = map[byte]string{
+	'b': "\b",
+	'f': "\f",
+	'n': "\n",
+	'r': "\r",
+	't': "\t",
+	'v': "\v",
+}
+
+var StringUnescape = &Function{
+	F: func(a []interface{}) interface{} {
+		var (
+			s   = a[0].(string)
+			b   strings.Builder
+			esc bool
+		)
+
+		for i := 0; i < len(s); i++ {
+			c := string(rune(s[i]))
+			if esc {
+				if u, ok := stringUnescapes[s[i]]; ok {
+					c = u
+				}
+
+				b.WriteString(c)
+				esc = false
+				continue
+			}
+
+			if c == "\\" {
+				esc = true
+				continue
+			}
+
+			b.WriteString(c)
+		}
+
+		return b.String()
+	},
+	FixedArgs: 1,
+}
+
+var StringSplit = &Function{
+	F: func(a []interface{}) interface{} {
+		sep, ok := a[0].(string)
+		if !ok {
+			panic(fmt.Sprintf("split: unsupported code: %v", a[0]))
+		}
+
+		s, ok := a[1].(string)
+		if !ok {
+			panic(fmt.Sprintf("split: unsupported code: %v", a[1]))
+		}
+
+		parts := strings.Split(s, sep)
+		l := make([]interface{}, len(parts))
+		for i := range parts {
+			l[i] = parts[i]
+		}
+
+		return NewList(l)
+	},
+	FixedArgs: 2,
+}
+
+// the hex encoded SHA-256 sum of the bytes of the string
+var StringHash = &Function{
+	F: func(a []interface{}) interface{} {
+		s, ok := a[0].(string)
+		if !ok {
+			panic(fmt.Sprintf("hash: unsupported code: %v", a[0]))
+		}
+
+		sum := sha256.Sum256([]byte(s))
+		return hex.EncodeToString(sum[:])
+	},
+	FixedArgs: 1,
+}
+
 var (
 	Close *Function
 	Args  interface{}
@@ -771,6 +1349,16 @@ var (
 func init() {
 	Close = &Function{
 		F: func(a []interface{}) interface{} {
+			if c, ok := a[0].(chan interface{}); ok {
+				if schedule != nil {
+					schedule.close(c)
+				} else {
+					close(c)
+				}
+
+				return nil
+			}
+
 			return a[0].(*Function).F([]interface{}{Close})
 		},
 		FixedArgs: 1,
@@ -781,5 +1369,5 @@ func init() {
 		args = append(args, os.Args[i])
 	}
 
-	Args = &List{args}
+	Args = NewList(args)
 }
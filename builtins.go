@@ -0,0 +1,95 @@
+// Generated code, see builtins.mml
+
+package mml
+
+// the built-in definitions of the compiler, in code.builtin. The map is created on every call, after the init
+// function of the package set the definitions that depend on the process, e.g. Args.
+func builtinDefinitions() map[string]interface{} {
+	return map[string]interface{}{
+		"args":           Args,
+		"atomic":         Atomic,
+		"await":          Await,
+		"bool":           Bool,
+		"bufchan":        Bufchan,
+		"cancel":         Cancel,
+		"chan":           Chan,
+		"close":          Close,
+		"closed":         Closed,
+		"error":          Error,
+		"executable":     Executable,
+		"execute":        Execute,
+		"exit":           Exit,
+		"float":          Float,
+		"format":         Format,
+		"formatGo":       FormatGo,
+		"getEnv":         GetEnv,
+		"has":            Has,
+		"int":            Int,
+		"interopUse":     InteropUse,
+		"isBool":         IsBool,
+		"isCancelled":    IsCancelled,
+		"isChannel":      IsChannel,
+		"isError":        IsError,
+		"isFloat":        IsFloat,
+		"isFunction":     IsFunction,
+		"isInt":          IsInt,
+		"isList":         IsList,
+		"isString":       IsString,
+		"isStruct":       IsStruct,
+		"keys":           Keys,
+		"len":            Len,
+		"listFilter":     ListFilter,
+		"listFold":       ListFold,
+		"listFoldr":      ListFoldr,
+		"listMap":        ListMap,
+		"listSort":       ListSort,
+		"makeDir":        MakeDir,
+		"makeTempDir":    MakeTempDir,
+		"mutex":          Mutex,
+		"once":           Once,
+		"open":           Open,
+		"panic":          Panic,
+		"parseAST":       ParseAST,
+		"parseFloat":     ParseFloat,
+		"parseInt":       ParseInt,
+		"readDir":        ReadDir,
+		"removeAll":      RemoveAll,
+		"spawn":          Spawn,
+		"spawnIn":        SpawnIn,
+		"stderr":         Stderr,
+		"stdin":          Stdin,
+		"stdlibSource":   StdlibSource,
+		"stdout":         Stdout,
+		"string":         String,
+		"stringEscape":   StringEscape,
+		"stringHash":     StringHash,
+		"stringJoin":     StringJoin,
+		"stringSplit":    StringSplit,
+		"stringUnescape": StringUnescape,
+		"symlink":        Symlink,
+		"taskGroup":      TaskGroup,
+		"wait":           Wait,
+		"workDir":        WorkDir,
+		"writeFile":      WriteFile,
+	}
+}
+
+// the built-in definitions acting on the host, which the evaluated code gets only when the host allows them
+var hostEffects = []string{
+	"args",
+	"executable",
+	"execute",
+	"getEnv",
+	"interopUse",
+	"makeDir",
+	"makeTempDir",
+	"open",
+	"readDir",
+	"removeAll",
+	"stderr",
+	"stdin",
+	"stdout",
+	"symlink",
+	"workDir",
+	"writeFile",
+}
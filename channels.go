@@ -0,0 +1,98 @@
+package mml
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+type SelectCase struct {
+	Send    bool
+	Channel interface{}
+	Value   interface{}
+}
+
+// Closed is the value received from a closed channel.
+var Closed interface{} = errors.New("closed channel")
+
+func channel(c interface{}, op string) chan interface{} {
+	ch, ok := c.(chan interface{})
+	if !ok {
+		panic(fmt.Sprintf("%s: unsupported code: %v", op, c))
+	}
+
+	return ch
+}
+
+func Send(c, v interface{}, position string) {
+	if schedule != nil {
+		schedule.send(position, channel(c, "send"), v)
+		return
+	}
+
+	channel(c, "send") <- v
+}
+
+func Receive(c interface{}, position string) interface{} {
+	if schedule != nil {
+		return schedule.receive(position, channel(c, "receive"))
+	}
+
+	v, ok := <-channel(c, "receive")
+	if !ok {
+		return Closed
+	}
+
+	return v
+}
+
+// Select returns the index of the selected case, and the received value if it was a receive case. When the
+// default case is selected, the returned index is -1.
+func Select(cases []SelectCase, hasDefault bool, position string) (int, interface{}) {
+	if schedule != nil {
+		return schedule.selectCase(position, cases, hasDefault)
+	}
+
+	rc := make([]reflect.SelectCase, len(cases), len(cases)+1)
+	for i, c := range cases {
+		ch := reflect.ValueOf(channel(c.Channel, "select"))
+		if c.Send {
+			rc[i] = reflect.SelectCase{
+				Dir:  reflect.SelectSend,
+				Chan: ch,
+				Send: reflect.ValueOf(&cases[i].Value).Elem(),
+			}
+		} else {
+			rc[i] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: ch}
+		}
+	}
+
+	if hasDefault {
+		rc = append(rc, reflect.SelectCase{Dir: reflect.SelectDefault})
+	}
+
+	i, v, ok := reflect.Select(rc)
+	switch {
+	case i == len(cases):
+		return -1, nil
+	case cases[i].Send:
+		return i, nil
+	case !ok:
+		return i, Closed
+	default:
+		return i, v.Interface()
+	}
+}
+
+var Chan = &Function{
+	F: func([]interface{}) interface{} {
+		return make(chan interface{})
+	},
+}
+
+var Bufchan = &Function{
+	F: func(a []interface{}) interface{} {
+		return make(chan interface{}, a[0].(int))
+	},
+	FixedArgs: 1,
+}
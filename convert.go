@@ -0,0 +1,159 @@
+package mml
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ToGo converts an MML value to a Go value. The lists are converted to []interface{}, and the structs to
+// map[string]interface{}, recursively. The other values, int, float64, string, bool, error, chan interface{} and
+// *Function, are returned unchanged.
+func ToGo(v interface{}) interface{} {
+	switch vt := v.(type) {
+	case *List:
+		l := make([]interface{}, vt.Len())
+		for i := range l {
+			l[i] = ToGo(vt.Get(i))
+		}
+
+		return l
+	case *Struct:
+		s := make(map[string]interface{})
+		for _, k := range vt.Keys() {
+			s[k] = ToGo(vt.Get(k))
+		}
+
+		return s
+	default:
+		return v
+	}
+}
+
+const maxInt = int(^uint(0) >> 1)
+
+// FromGo converts a Go value to an MML value. The signed and unsigned integers are converted to int, float32 to
+// float64, the slices and arrays to lists, and the maps with string keys to structs, recursively. The values
+// that MML uses directly, int, float64, string, bool, error, chan interface{}, *Function, *List, *Struct and nil,
+// are returned unchanged. For the other values, and for the unsigned integers greater than the maximum int,
+// FromGo returns an error.
+func FromGo(v interface{}) (interface{}, error) {
+	switch v.(type) {
+	case nil, int, float64, string, bool, error, chan interface{}, *Function, *List, *Struct:
+		return v, nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if rv.Uint() > uint64(maxInt) {
+			return nil, fmt.Errorf("unsupported Go value: %T %d, out of the range of int", v, rv.Uint())
+		}
+
+		return int(rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), nil
+	case reflect.String:
+		return rv.String(), nil
+	case reflect.Bool:
+		return rv.Bool(), nil
+	case reflect.Slice, reflect.Array:
+		l := make([]interface{}, rv.Len())
+		for i := range l {
+			item, err := FromGo(rv.Index(i).Interface())
+			if err != nil {
+				return nil, err
+			}
+
+			l[i] = item
+		}
+
+		return NewList(l), nil
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("unsupported Go value: %T", v)
+		}
+
+		s := make(map[string]interface{})
+		for _, k := range rv.MapKeys() {
+			value, err := FromGo(rv.MapIndex(k).Interface())
+			if err != nil {
+				return nil, err
+			}
+
+			s[k.String()] = value
+		}
+
+		return NewStruct(s), nil
+	default:
+		return nil, fmt.Errorf("unsupported Go value: %T", v)
+	}
+}
+
+func export(path, name string) (interface{}, error) {
+	s, err := Modules.Use(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.Has(name) {
+		return nil, fmt.Errorf("module %s: undefined export: %s", path, name)
+	}
+
+	return s.Get(name), nil
+}
+
+// Export returns an exported definition of a module converted with ToGo, and initializes the module when it
+// is used for the first time.
+func Export(path, name string) (interface{}, error) {
+	v, err := export(path, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToGo(v), nil
+}
+
+// CallExport calls an exported function of a module. The arguments are converted with FromGo, and the result
+// with ToGo. When the function returns an MML error, CallExport returns it as the error, and when it panics, e.g.
+// because of invalid arguments, the panic is returned as an error, too.
+func CallExport(path, name string, args ...interface{}) (result interface{}, err error) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+
+		if rerr, ok := r.(error); ok {
+			err = fmt.Errorf("module %s: %s: %w", path, name, rerr)
+			return
+		}
+
+		err = fmt.Errorf("module %s: %s: %v", path, name, r)
+	}()
+
+	v, err := export(path, name)
+	if err != nil {
+		return nil, err
+	}
+
+	f, ok := v.(*Function)
+	if !ok {
+		return nil, fmt.Errorf("module %s: not a function: %s", path, name)
+	}
+
+	a := make([]interface{}, len(args))
+	for i := range args {
+		if a[i], err = FromGo(args[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	result = f.Call(a)
+	if err, ok := result.(error); ok {
+		return nil, err
+	}
+
+	return ToGo(result), nil
+}
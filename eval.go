@@ -0,0 +1,703 @@
+package mml
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aryszka/mml/parser"
+)
+
+// the module evaluated by Eval from its source
+const evalPath = "<eval>"
+
+// ErrNotAllowed is the error of the built-in functions acting on the host, when they are called by the code
+// evaluated with Eval, and the host did not allow them.
+var ErrNotAllowed = errors.New("not allowed")
+
+// ExitError is the error returned by Eval, as the Err of a *ModuleError, when the evaluated code calls exit.
+type ExitError struct {
+	Code int
+}
+
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("exit with %d", e.Code)
+}
+
+// ends the evaluation instead of the host process
+var evalExit = &Function{
+	F: func(a []interface{}) interface{} {
+		panic(&ExitError{Code: a[0].(int)})
+	},
+	FixedArgs: 1,
+}
+
+func notAllowed(name string, f *Function) *Function {
+	return &Function{
+		F: func([]interface{}) interface{} {
+			panic(fmt.Errorf("%s: %w", name, ErrNotAllowed))
+		},
+		FixedArgs: f.FixedArgs,
+	}
+}
+
+// the built-in definitions available to the evaluated modules. The ones acting on the host and not in effects
+// fail with ErrNotAllowed, except for args, which is empty.
+func evalBuiltins(effects []string) (map[string]interface{}, error) {
+	allowed := make(map[string]bool)
+	for _, e := range effects {
+		allowed[e] = true
+	}
+
+	builtins := builtinDefinitions()
+	for _, name := range hostEffects {
+		if allowed[name] {
+			delete(allowed, name)
+			continue
+		}
+
+		if name == "args" {
+			builtins[name] = NewList(nil)
+			continue
+		}
+
+		builtins[name] = notAllowed(name, builtins[name].(*Function))
+	}
+
+	for name := range allowed {
+		return nil, fmt.Errorf("effects: not a built-in acting on the host: %s", name)
+	}
+
+	builtins["exit"] = evalExit
+	return builtins, nil
+}
+
+// the parsed modules of the standard library are shared by the evaluations
+var evalStdlib = struct {
+	lock  sync.Mutex
+	nodes map[string]*parser.Node
+}{nodes: make(map[string]*parser.Node)}
+
+// a module read by Eval, with the located paths of its uses, and the names defined by its statement lists
+type evalModule struct {
+	path    string
+	file    string
+	stdlib  bool
+	node    *parser.Node
+	starts  []int
+	uses    map[string]string
+	scopes  map[*parser.Node][]string
+	exports []string
+}
+
+type evalLoader struct {
+	modules fs.FS
+	loaded  map[string]*evalModule
+	loading []string
+}
+
+// the names of the statically known definitions of a scope during the checks
+type evalNames struct {
+	parent *evalNames
+	names  map[string]bool
+}
+
+func isComment(n *parser.Node) bool {
+	switch n.Name {
+	case "line-comment", "block-comment", "docs":
+		return true
+	default:
+		return false
+	}
+}
+
+// the child nodes without the comments
+func children(n *parser.Node) []*parser.Node {
+	var c []*parser.Node
+	for _, ni := range n.Nodes {
+		if !isComment(ni) {
+			c = append(c, ni)
+		}
+	}
+
+	return c
+}
+
+func (m *evalModule) position(n *parser.Node) string {
+	line := sort.Search(len(m.starts), func(i int) bool { return m.starts[i] > n.From })
+	return fmt.Sprintf("%s:%d:%d", m.file, line, n.From-m.starts[line-1]+1)
+}
+
+func (m *evalModule) errorf(n *parser.Node, format string, a ...interface{}) error {
+	return fmt.Errorf("%s: %s", m.position(n), fmt.Sprintf(format, a...))
+}
+
+func unquote(n *parser.Node) string {
+	t := n.Text()
+	return StringUnescape.F([]interface{}{t[1 : len(t)-1]}).(string)
+}
+
+func parseModule(file, source string) (*parser.Node, error) {
+	n, err := parser.Parse(bytes.NewBufferString(source))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+
+	return n, nil
+}
+
+func stdlibModule(name string) (*parser.Node, bool, error) {
+	evalStdlib.lock.Lock()
+	defer evalStdlib.lock.Unlock()
+	if n, ok := evalStdlib.nodes[name]; ok {
+		return n, true, nil
+	}
+
+	source, err := stdlib.ReadFile(name + ".mml")
+	if err != nil {
+		return nil, false, nil
+	}
+
+	n, err := parseModule(fmt.Sprintf("mml:/%s.mml", name), string(source))
+	if err != nil {
+		return nil, true, err
+	}
+
+	evalStdlib.nodes[name] = n
+	return n, true, nil
+}
+
+// the paths of the modules read from the file system are relative to its root, with the same rules as the
+// paths of the compiler: the paths starting with ./ or ../ are relative to the using module, and the other
+// relative paths are looked up first in the file system, and then in the standard library. The modules of the
+// standard library use only each other.
+func (l *evalLoader) locate(from, usePath string) (string, error) {
+	p := strings.TrimSuffix(usePath, ".mml")
+	switch {
+	case strings.HasPrefix(p, "/"):
+		p = path.Clean(p[1:])
+	case p == "." || p == ".." || strings.HasPrefix(p, "./") || strings.HasPrefix(p, "../"):
+		if strings.HasPrefix(from, "mml:/") {
+			return "", fmt.Errorf("module not found: %s", usePath)
+		}
+
+		p = path.Join(path.Dir(from), p)
+	default:
+		p = path.Clean(p)
+		if !strings.HasPrefix(from, "mml:/") && l.exists(p) {
+			return p, nil
+		}
+
+		if _, ok, _ := stdlibModule(p); ok {
+			return "mml:/" + p, nil
+		}
+	}
+
+	if p == ".." || strings.HasPrefix(p, "../") {
+		return "", fmt.Errorf("module not found: %s", usePath)
+	}
+
+	return p, nil
+}
+
+func (l *evalLoader) exists(p string) bool {
+	if l.modules == nil {
+		return false
+	}
+
+	_, err := fs.Stat(l.modules, p+".mml")
+	return err == nil
+}
+
+func (l *evalLoader) read(p string) (*parser.Node, string, error) {
+	if strings.HasPrefix(p, "mml:/") {
+		n, _, err := stdlibModule(p[len("mml:/"):])
+		return n, p + ".mml", err
+	}
+
+	file := p + ".mml"
+	if l.modules == nil {
+		return nil, file, fmt.Errorf("module not found: %s", p)
+	}
+
+	source, err := fs.ReadFile(l.modules, file)
+	if err != nil {
+		return nil, file, fmt.Errorf("module not found: %s", p)
+	}
+
+	n, err := parseModule(file, string(source))
+	return n, file, err
+}
+
+func collectUses(n *parser.Node, uses []*parser.Node) []*parser.Node {
+	if n.Name == "use-fact" || n.Name == "use-effect" {
+		return append(uses, n)
+	}
+
+	for _, ni := range n.Nodes {
+		uses = collectUses(ni, uses)
+	}
+
+	return uses
+}
+
+// the string of the path in a use-fact or use-effect node
+func usePathNode(u *parser.Node) *parser.Node {
+	c := children(u)
+	return c[len(c)-1]
+}
+
+// the name bound by a use, or "." for the inline uses
+func useCapture(u *parser.Node) string {
+	c := children(u)
+	switch {
+	case len(c) == 1:
+		return moduleName(unquote(c[0]))
+	case c[0].Name == "use-inline":
+		return "."
+	default:
+		return c[0].Text()
+	}
+}
+
+func moduleName(usePath string) string {
+	return path.Base(strings.TrimSuffix(usePath, ".mml"))
+}
+
+func (l *evalLoader) load(p, file string, n *parser.Node, stdlib bool) (*evalModule, error) {
+	for i, li := range l.loading {
+		if li == p {
+			chain := append(l.loading[i:len(l.loading):len(l.loading)], p)
+			return nil, fmt.Errorf("%w: %s", ErrCircularReference, strings.Join(chain, " -> "))
+		}
+	}
+
+	if m, ok := l.loaded[p]; ok {
+		return m, nil
+	}
+
+	l.loading = append(l.loading, p)
+	defer func() { l.loading = l.loading[:len(l.loading)-1] }()
+
+	m := &evalModule{
+		path:   p,
+		file:   file,
+		stdlib: stdlib,
+		node:   n,
+		starts: lineStarts(n.Tokens()),
+		uses:   make(map[string]string),
+		scopes: make(map[*parser.Node][]string),
+	}
+
+	for _, u := range collectUses(n, nil) {
+		pathNode := usePathNode(u)
+		usePath := unquote(pathNode)
+		located, err := l.locate(p, usePath)
+		if err != nil {
+			return nil, m.errorf(pathNode, "%v", err)
+		}
+
+		m.uses[usePath] = located
+		if _, ok := l.loaded[located]; ok {
+			continue
+		}
+
+		un, ufile, err := l.read(located)
+		if err != nil {
+			return nil, m.errorf(pathNode, "%v", err)
+		}
+
+		if _, err := l.load(located, ufile, un, strings.HasPrefix(located, "mml:/")); err != nil {
+			return nil, err
+		}
+	}
+
+	l.loaded[p] = m
+	return m, nil
+}
+
+// the capture nodes of a definition: value-capture, mutable-capture, function-capture or effect-capture
+func captures(n *parser.Node) []*parser.Node {
+	switch n.Name {
+	case "value-capture", "mutable-capture", "function-capture", "effect-capture":
+		return []*parser.Node{n}
+	case "value-definition",
+		"value-definition-group",
+		"mutable-definition-group",
+		"function-definition",
+		"function-definition-group",
+		"effect-definition-group",
+		"docs-value-capture",
+		"docs-mixed-capture",
+		"docs-function-capture",
+		"docs-mixed-function-capture":
+		var c []*parser.Node
+		for _, ni := range children(n) {
+			c = append(c, captures(ni)...)
+		}
+
+		return c
+	default:
+		return nil
+	}
+}
+
+func isDefinition(n *parser.Node) bool {
+	return len(captures(n)) > 0
+}
+
+func (l *evalLoader) usedModule(m *evalModule, u *parser.Node) *evalModule {
+	return l.loaded[m.uses[unquote(usePathNode(u))]]
+}
+
+// the names exported by a module, including the definitions exported from its used modules
+func (l *evalLoader) exports(m *evalModule) []string {
+	if m.exports != nil {
+		return m.exports
+	}
+
+	names := []string{}
+	for _, s := range children(m.node) {
+		if s.Name != "export-statement" {
+			continue
+		}
+
+		e := children(s)
+		switch e[0].Name {
+		case "use-modules":
+			for _, u := range children(e[0]) {
+				names = append(names, l.exports(l.usedModule(m, u))...)
+			}
+		case "use-selection":
+			for _, si := range children(e[0]) {
+				names = append(names, si.Text())
+			}
+		default:
+			for _, c := range captures(e[0]) {
+				names = append(names, children(c)[0].Text())
+			}
+		}
+	}
+
+	m.exports = names
+	return names
+}
+
+// the names defined by the statements of a statement list, including the used modules. It fails when a name is
+// defined more than once.
+func (l *evalLoader) hoist(m *evalModule, statements []*parser.Node) ([]string, error) {
+	var names []string
+	defined := make(map[string]bool)
+	define := func(c *parser.Node) error {
+		name := children(c)[0]
+		if defined[name.Text()] {
+			return m.errorf(name, "duplicate definition: %s", name.Text())
+		}
+
+		defined[name.Text()] = true
+		names = append(names, name.Text())
+		return nil
+	}
+
+	useNames := func(u *parser.Node, exported bool) {
+		capture := useCapture(u)
+		switch {
+		case exported:
+			names = append(names, reexportSymbol(u))
+			names = append(names, l.exports(l.usedModule(m, u))...)
+		case capture == ".":
+			names = append(names, l.exports(l.usedModule(m, u))...)
+		default:
+			names = append(names, capture)
+		}
+	}
+
+	for _, s := range statements {
+		exported := s.Name == "export-statement"
+		if exported && children(s)[0].Name == "use-selection" {
+			e := children(s)
+			names = append(names, reexportSymbol(e[1]))
+			for _, si := range children(e[0]) {
+				names = append(names, si.Text())
+			}
+
+			continue
+		}
+
+		if exported {
+			s = children(s)[0]
+		}
+
+		switch {
+		case s.Name == "use-modules":
+			for _, u := range children(s) {
+				useNames(u, exported)
+			}
+		case isDefinition(s):
+			for _, c := range captures(s) {
+				if err := define(c); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return names, nil
+}
+
+func (n *evalNames) defined(name string) bool {
+	return n.names[name] || n.parent != nil && n.parent.defined(name)
+}
+
+func (l *evalLoader) scope(m *evalModule, parent *evalNames, key *parser.Node, statements []*parser.Node) (*evalNames, error) {
+	names, err := l.hoist(m, statements)
+	if err != nil {
+		return nil, err
+	}
+
+	m.scopes[key] = names
+	s := &evalNames{parent: parent, names: make(map[string]bool)}
+	for _, n := range names {
+		s.names[n] = true
+	}
+
+	return s, nil
+}
+
+func scopeOf(parent *evalNames, names ...string) *evalNames {
+	s := &evalNames{parent: parent, names: make(map[string]bool)}
+	for _, n := range names {
+		s.names[n] = true
+	}
+
+	return s
+}
+
+func (l *evalLoader) checkAll(m *evalModule, s *evalNames, nodes []*parser.Node) error {
+	for _, n := range nodes {
+		if err := l.check(m, s, n); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (l *evalLoader) checkStatements(m *evalModule, parent *evalNames, key *parser.Node, statements []*parser.Node) error {
+	s, err := l.scope(m, parent, key, statements)
+	if err != nil {
+		return err
+	}
+
+	return l.checkAll(m, s, statements)
+}
+
+// the parameter names and the body of a function-fact, starting at the offset
+func functionFact(n *parser.Node, offset int) ([]string, string, *parser.Node) {
+	c := children(n)[offset:]
+	var (
+		params  []string
+		collect string
+	)
+
+	for _, p := range c[:len(c)-1] {
+		if p.Name == "collect-parameter" {
+			collect = children(p)[0].Text()
+			continue
+		}
+
+		params = append(params, p.Text())
+	}
+
+	return params, collect, c[len(c)-1]
+}
+
+// check verifies that the symbols referenced by the code are defined, and that no name is defined twice in the
+// same scope, while it records the names of the scopes of the statement lists
+func (l *evalLoader) check(m *evalModule, s *evalNames, n *parser.Node) error {
+	c := children(n)
+	switch n.Name {
+	case "symbol":
+		if !s.defined(n.Text()) {
+			return m.errorf(n, "undefined: %s", n.Text())
+		}
+
+		return nil
+	case "int", "float":
+		parse := parseInt
+		if n.Name == "float" {
+			parse = parseFloat
+		}
+
+		if err, isErr := parse([]interface{}{n.Text()}).(error); isErr {
+			return m.errorf(n, "%v", err)
+		}
+
+		return nil
+	case "mml", "block":
+		return l.checkStatements(m, s, n, c)
+	case "case-block", "default-block":
+		if n.Name == "case-block" {
+			if err := l.check(m, s, c[0]); err != nil {
+				return err
+			}
+
+			c = c[1:]
+		}
+
+		return l.checkStatements(m, s, n, c)
+	case "select-case-block":
+		caseScope := s
+		if c[0].Name == "receive-definition" {
+			definition := children(c[0])
+			if err := l.check(m, s, definition[1]); err != nil {
+				return err
+			}
+
+			caseScope = scopeOf(s, definition[0].Text())
+		} else if err := l.check(m, s, c[0]); err != nil {
+			return err
+		}
+
+		return l.checkStatements(m, caseScope, n, c[1:])
+	case "function", "effect", "function-capture", "effect-capture":
+		offset := 0
+		if n.Name == "function-capture" || n.Name == "effect-capture" {
+			offset = 1
+		}
+
+		params, collect, body := functionFact(n, offset)
+		if collect != "" {
+			params = append(params, collect)
+		}
+
+		return l.check(m, scopeOf(s, params...), body)
+	case "value-capture", "mutable-capture":
+		return l.check(m, s, c[1])
+	case "entry":
+		if c[0].Name != "symbol" {
+			if err := l.check(m, s, c[0]); err != nil {
+				return err
+			}
+		}
+
+		return l.check(m, s, c[1])
+	case "loop":
+		if len(c) == 2 && c[0].Name == "range-over" {
+			r := children(c[0])
+			if len(r) > 0 && r[0].Name == "symbol" {
+				if err := l.checkAll(m, s, r[1:]); err != nil {
+					return err
+				}
+
+				return l.check(m, scopeOf(s, r[0].Text()), c[1])
+			}
+		}
+
+		return l.checkAll(m, s, c)
+	case "symbol-index", "use-modules", "use-selection", "use-fact", "use-effect":
+		return nil
+	default:
+		return l.checkAll(m, s, c)
+	}
+}
+
+func newNames(names map[string]interface{}) *evalNames {
+	s := &evalNames{names: make(map[string]bool)}
+	for n := range names {
+		s.names[n] = true
+	}
+
+	return s
+}
+
+// EvalOptions are the optional settings of EvalWith.
+type EvalOptions struct {
+	// Effects lists the built-in definitions acting on the host, e.g. writeFile, execute or stdout, that the
+	// evaluated code can use, both in the modules read from the file system and in the standard library. The
+	// ones not listed fail with ErrNotAllowed, and args is empty.
+	Effects []string
+}
+
+// Eval evaluates a module from its source, and returns its exported definitions as a map, converted with ToGo.
+// The modules used by the source are read from the modules file system, and from the standard library. The
+// paths of the modules are relative to the root of the file system, except for the paths starting with ./ or
+// ../, which are relative to the using module, and they can be nil when the source uses only the standard
+// library.
+//
+// The entries of env are defined as built-in definitions for the modules read from the file system, shadowing
+// the built-in functions with the same name. The values of env are converted with FromGo, and the functions
+// need to be *Function, e.g. created with NewGoFunction.
+//
+// Eval doesn't allow any of the built-in definitions acting on the host. To allow some of them, use EvalWith.
+// The evaluated code cannot exit the host process: exit ends the evaluation with an *ExitError.
+//
+// Before the evaluation, Eval verifies that the source and the used modules can be parsed, and that they
+// reference only defined names. The evaluation happens in the current process, with an interpreter, and the
+// errors of the evaluation, the panics of the MML code, are returned as a *ModuleError. The panics in the
+// goroutines started by the evaluated code end only the goroutine, and when they happen before the evaluation
+// finishes, the first one is returned as a *ModuleError, too.
+func Eval(source string, modules fs.FS, env map[string]interface{}) (interface{}, error) {
+	return EvalWith(source, modules, env, EvalOptions{})
+}
+
+// EvalWith is like Eval, with the settings in o.
+func EvalWith(source string, modules fs.FS, env map[string]interface{}, o EvalOptions) (interface{}, error) {
+	n, err := parseModule(evalPath, source)
+	if err != nil {
+		return nil, err
+	}
+
+	builtins, err := evalBuiltins(o.Effects)
+	if err != nil {
+		return nil, err
+	}
+
+	hostDefinitions := make(map[string]interface{})
+	for k, v := range builtins {
+		hostDefinitions[k] = v
+	}
+
+	for k, v := range env {
+		mv, err := FromGo(v)
+		if err != nil {
+			return nil, fmt.Errorf("env: %s: %w", k, err)
+		}
+
+		hostDefinitions[k] = mv
+	}
+
+	l := &evalLoader{modules: modules, loaded: make(map[string]*evalModule)}
+	if _, err := l.load(evalPath, evalPath, n, false); err != nil {
+		return nil, err
+	}
+
+	builtinNames, hostNames := newNames(builtins), newNames(hostDefinitions)
+	for _, m := range l.loaded {
+		names := hostNames
+		if m.stdlib {
+			names = builtinNames
+		}
+
+		if err := l.check(m, names, m.node); err != nil {
+			return nil, err
+		}
+	}
+
+	e := newEvaluation(l.loaded, builtins, hostDefinitions)
+	exports := e.use(evalPath)
+	if err, ok := exports.(*ModuleError); ok {
+		return nil, err
+	}
+
+	if err := e.goroutineFailure(); err != nil {
+		return nil, err
+	}
+
+	return ToGo(exports), nil
+}
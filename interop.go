@@ -0,0 +1,216 @@
+package mml
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Type is the type of a parameter or of the result of a Go function used from MML.
+type Type int
+
+const (
+	AnyType Type = iota
+	IntType
+	FloatType
+	StringType
+	BoolType
+	ErrorType
+	ListType
+	StructType
+	FunctionType
+	ChannelType
+)
+
+// FunctionSignature declares the parameters and the result of a Go function used from MML. When Variadic is
+// true, the function accepts any number of further arguments of the Collect type.
+type FunctionSignature struct {
+	Params   []Type
+	Variadic bool
+	Collect  Type
+	Returns  Type
+}
+
+// GoFunction is the implementation of a function used from MML. It receives the fixed arguments, and, when the
+// function is variadic, the rest of the arguments in collectArgs.
+type GoFunction func(args []interface{}, collectArgs []interface{}) interface{}
+
+type interopFunction struct {
+	signature FunctionSignature
+	function  *Function
+}
+
+// InteropRegistry holds the Go functions that MML code can use with interop.use(package, name). The package
+// is the import path of the Go package registering the functions.
+type InteropRegistry struct {
+	lock      sync.Mutex
+	functions map[string]map[string]interopFunction
+}
+
+// Interop is the registry of the Go functions used from MML. The Go packages register their functions in their
+// init functions, and the generated code imports the packages used by interop.use.
+var Interop = &InteropRegistry{functions: make(map[string]map[string]interopFunction)}
+
+var typeNames = []string{"any", "int", "float", "string", "bool", "error", "list", "struct", "function", "channel"}
+
+func (t Type) String() string {
+	if t < 0 || int(t) >= len(typeNames) {
+		return fmt.Sprintf("type(%d)", int(t))
+	}
+
+	return typeNames[t]
+}
+
+func (t Type) matches(v interface{}) bool {
+	switch t {
+	case IntType:
+		_, ok := v.(int)
+		return ok
+	case FloatType:
+		_, ok := v.(float64)
+		return ok
+	case StringType:
+		_, ok := v.(string)
+		return ok
+	case BoolType:
+		_, ok := v.(bool)
+		return ok
+	case ErrorType:
+		_, ok := v.(error)
+		return ok
+	case ListType:
+		_, ok := v.(*List)
+		return ok
+	case StructType:
+		_, ok := v.(*Struct)
+		return ok
+	case FunctionType:
+		_, ok := v.(*Function)
+		return ok
+	case ChannelType:
+		_, ok := v.(chan interface{})
+		return ok
+	default:
+		return true
+	}
+}
+
+// NewGoFunction returns an MML function implemented in Go. The arguments and the result are checked against
+// the signature when the function is called, and the function panics when they don't match.
+func NewGoFunction(name string, s FunctionSignature, f GoFunction) *Function {
+	return &Function{
+		F: func(a []interface{}) interface{} {
+			if len(a) > len(s.Params) && !s.Variadic {
+				panic(fmt.Sprintf("%s: too many arguments: %d", name, len(a)))
+			}
+
+			for i := range a {
+				t := s.Collect
+				if i < len(s.Params) {
+					t = s.Params[i]
+				}
+
+				if !t.matches(a[i]) {
+					panic(fmt.Sprintf("%s: argument %d: expected %v, got: %v", name, i+1, t, a[i]))
+				}
+			}
+
+			var result interface{}
+			if s.Variadic {
+				result = f(a[:len(s.Params)], a[len(s.Params):])
+			} else {
+				result = f(a, nil)
+			}
+
+			if _, isErr := result.(error); !isErr && result != nil && !s.Returns.matches(result) {
+				panic(fmt.Sprintf("%s: expected %v result, got: %v", name, s.Returns, result))
+			}
+
+			return result
+		},
+		FixedArgs: len(s.Params),
+	}
+}
+
+// Register registers a Go function, that MML code can use with interop.use(pkg, name). It is typically called
+// from the init function of the package with the import path pkg.
+func (r *InteropRegistry) Register(pkg, name string, s FunctionSignature, f GoFunction) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.functions[pkg]; !ok {
+		r.functions[pkg] = make(map[string]interopFunction)
+	}
+
+	r.functions[pkg][name] = interopFunction{
+		signature: s,
+		function:  NewGoFunction(fmt.Sprintf("%s.%s", pkg, name), s, f),
+	}
+}
+
+// Signature returns the signature of a registered function.
+func (r *InteropRegistry) Signature(pkg, name string) (FunctionSignature, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	f, ok := r.functions[pkg][name]
+	return f.signature, ok
+}
+
+// Use returns a registered function. It panics when the function is not registered.
+func (r *InteropRegistry) Use(pkg, name string) *Function {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	f, ok := r.functions[pkg][name]
+	if !ok {
+		panic(fmt.Sprintf("interop: function not registered: %s.%s", pkg, name))
+	}
+
+	return f.function
+}
+
+// Describe writes the signatures of the registered functions, one per line, in the format of the compiler:
+//
+//	<package> <name> <result> <variadic> <collect> <parameters...>
+//
+// where the types are written with their names, e.g. string, and variadic is true or false.
+func (r *InteropRegistry) Describe(w io.Writer) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var lines []string
+	for pkg, functions := range r.functions {
+		for name, f := range functions {
+			fields := []string{
+				pkg,
+				name,
+				f.signature.Returns.String(),
+				fmt.Sprint(f.signature.Variadic),
+				f.signature.Collect.String(),
+			}
+
+			for _, p := range f.signature.Params {
+				fields = append(fields, p.String())
+			}
+
+			lines = append(lines, strings.Join(fields, " "))
+		}
+	}
+
+	sort.Strings(lines)
+	for _, l := range lines {
+		if _, err := fmt.Fprintln(w, l); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var InteropUse = &Function{
+	F: func(a []interface{}) interface{} {
+		return Interop.Use(a[0].(string), a[1].(string))
+	},
+	FixedArgs: 2,
+}
@@ -0,0 +1,845 @@
+package mml
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/aryszka/mml/parser"
+)
+
+// the control flow after a statement
+type evalFlow int
+
+const (
+	evalNext evalFlow = iota
+	evalBreak
+	evalContinue
+	evalReturn
+)
+
+// the variables of a scope. The names are known when the scope is created, and the values are stored in cells,
+// so that the goroutines using the scope only share the variables, the same way as in the compiled code.
+type evalScope struct {
+	parent *evalScope
+	values map[string]*interface{}
+}
+
+// the deferred calls of a function, and the function itself, to turn its calls to itself in tail position into
+// a loop, the same way as the compiled code
+type evalFrame struct {
+	self   *Function
+	defers []func()
+}
+
+// the arguments of a call that a function makes to itself in tail position
+type evalTailCall struct {
+	args []interface{}
+}
+
+type evaluation struct {
+	modules      map[string]*evalModule
+	builtins     *evalScope
+	host         *evalScope
+	lock         sync.Mutex
+	cache        map[string]map[string]interface{}
+	failed       map[string]*ModuleError
+	initializing []string
+
+	// the first panic of the goroutines started by the go statements
+	goroutineFailed *ModuleError
+}
+
+// interprets the code of a module
+type evalInterpreter struct {
+	e       *evaluation
+	m       *evalModule
+	exports map[string]interface{}
+}
+
+var evalBinaryOperators = map[string]binaryOperator{
+	"binary-and":    binaryAnd,
+	"binary-or":     binaryOr,
+	"xor":           xor,
+	"and-not":       andNot,
+	"lshift":        lshift,
+	"rshift":        rshift,
+	"mul":           mul,
+	"div":           div,
+	"mod":           mod,
+	"add":           add,
+	"sub":           sub,
+	"eq":            eq,
+	"not-eq":        notEq,
+	"less":          less,
+	"less-or-eq":    lessOrEq,
+	"greater":       greater,
+	"greater-or-eq": greaterOrEq,
+}
+
+var evalUnaryOperators = map[string]unaryOperator{
+	"binary-not": binaryNot,
+	"plus":       plus,
+	"minus":      minus,
+}
+
+func newEvalScope(parent *evalScope, names []string) *evalScope {
+	s := &evalScope{parent: parent, values: make(map[string]*interface{})}
+	for _, n := range names {
+		s.values[n] = new(interface{})
+	}
+
+	return s
+}
+
+func valueScope(values map[string]interface{}) *evalScope {
+	s := &evalScope{values: make(map[string]*interface{})}
+	for n, v := range values {
+		v := v
+		s.values[n] = &v
+	}
+
+	return s
+}
+
+func (s *evalScope) cell(name string) *interface{} {
+	if c, ok := s.values[name]; ok {
+		return c
+	}
+
+	if s.parent == nil {
+		panic("undefined: " + name)
+	}
+
+	return s.parent.cell(name)
+}
+
+func (s *evalScope) set(name string, v interface{}) {
+	*s.cell(name) = v
+}
+
+func (f *evalFrame) runDefers() {
+	for i := len(f.defers) - 1; i >= 0; i-- {
+		f.defers[i]()
+	}
+}
+
+func newEvaluation(modules map[string]*evalModule, builtins, host map[string]interface{}) *evaluation {
+	return &evaluation{
+		modules:  modules,
+		builtins: valueScope(builtins),
+		host:     valueScope(host),
+		cache:    make(map[string]map[string]interface{}),
+		failed:   make(map[string]*ModuleError),
+	}
+}
+
+// use returns the exports of a module, or a *ModuleError, the same way as ModuleContext.Use
+func (e *evaluation) use(path string) interface{} {
+	e.lock.Lock()
+	if m, ok := e.cache[path]; ok {
+		e.lock.Unlock()
+		return NewStruct(m)
+	}
+
+	if err, ok := e.failed[path]; ok {
+		e.lock.Unlock()
+		return err
+	}
+
+	for _, p := range e.initializing {
+		if p == path {
+			e.lock.Unlock()
+			return &ModuleError{Path: path, Err: ErrCircularReference}
+		}
+	}
+
+	e.initializing = append(e.initializing, path)
+	e.lock.Unlock()
+
+	m, err := initModule(path, func() map[string]interface{} { return e.run(e.modules[path]) })
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.initializing = e.initializing[:len(e.initializing)-1]
+	if err != nil {
+		e.failed[path] = err
+		return err
+	}
+
+	e.cache[path] = m
+	return NewStruct(m)
+}
+
+// the goroutines of the evaluated code cannot stop the host process with a panic or with exit, instead, their
+// first failure is recorded for Eval
+func (e *evaluation) recovering(path, position string, f *Function) *Function {
+	return &Function{
+		F: func(a []interface{}) interface{} {
+			defer func() {
+				r := recover()
+				if r == nil {
+					return
+				}
+
+				e.lock.Lock()
+				defer e.lock.Unlock()
+				if e.goroutineFailed == nil {
+					e.goroutineFailed = &ModuleError{
+						Path: path,
+						Err:  fmt.Errorf("goroutine started at %s: %w", position, recoveredError(r)),
+					}
+				}
+			}()
+
+			return f.Call(a)
+		},
+	}
+}
+
+func (e *evaluation) goroutineFailure() error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if e.goroutineFailed == nil {
+		return nil
+	}
+
+	return e.goroutineFailed
+}
+
+func (e *evaluation) run(m *evalModule) map[string]interface{} {
+	parent := e.host
+	if m.stdlib {
+		parent = e.builtins
+	}
+
+	i := &evalInterpreter{e: e, m: m, exports: make(map[string]interface{})}
+	f := &evalFrame{}
+	defer f.runDefers()
+	i.statements(parent, f, m.node, children(m.node))
+	return i.exports
+}
+
+func (i *evalInterpreter) statements(parent *evalScope, f *evalFrame, key *parser.Node, nodes []*parser.Node) (evalFlow, interface{}) {
+	s := newEvalScope(parent, i.m.scopes[key])
+	for _, n := range nodes {
+		if flow, v := i.statement(s, f, n); flow != evalNext {
+			return flow, v
+		}
+	}
+
+	return evalNext, nil
+}
+
+func (i *evalInterpreter) block(s *evalScope, f *evalFrame, b *parser.Node) (evalFlow, interface{}) {
+	return i.statements(s, f, b, children(b))
+}
+
+func (i *evalInterpreter) statement(s *evalScope, f *evalFrame, n *parser.Node) (evalFlow, interface{}) {
+	c := children(n)
+	switch n.Name {
+	case "ret":
+		if len(c) == 0 {
+			return evalReturn, nil
+		}
+
+		return evalReturn, i.tail(s, f, c[0])
+	case "check-ret":
+		v := i.expression(s, c[0])
+		if _, isErr := v.(error); isErr {
+			return evalReturn, v
+		}
+
+		return evalNext, nil
+	case "if-statement":
+		for j := 0; j+1 < len(c); j += 2 {
+			if i.expression(s, c[j]).(bool) {
+				return i.block(s, f, c[j+1])
+			}
+		}
+
+		if len(c)%2 == 1 {
+			return i.block(s, f, c[len(c)-1])
+		}
+
+		return evalNext, nil
+	case "switch-statement":
+		return i.switchStatement(s, f, c)
+	case "select-statement":
+		return i.selectStatement(s, f, n, c)
+	case "loop":
+		return i.loop(s, f, c)
+	case "break":
+		return evalBreak, nil
+	case "continue":
+		return evalContinue, nil
+	case "send-statement":
+		Send(i.expression(s, c[0]), i.expression(s, c[1]), i.m.position(n))
+		return evalNext, nil
+	case "go-statement":
+		fn, args := i.callee(s, c[0])
+		position := i.m.position(n)
+		Go(position, i.e.recovering(i.m.path, position, fn.(*Function)), args)
+		return evalNext, nil
+	case "defer-statement":
+		fn, args := i.callee(s, c[0])
+		f.defers = append(f.defers, func() { fn.(*Function).Call(args) })
+		return evalNext, nil
+	case "assign":
+		i.assign(s, c)
+		return evalNext, nil
+	case "export-statement":
+		i.export(s, c)
+		return evalNext, nil
+	case "use-modules":
+		for _, u := range c {
+			i.use(s, u, false)
+		}
+
+		return evalNext, nil
+	case "shebang":
+		return evalNext, nil
+	default:
+		if isDefinition(n) {
+			i.define(s, n, false)
+			return evalNext, nil
+		}
+
+		i.expression(s, n)
+		return evalNext, nil
+	}
+}
+
+// the same as in Go, break in a switch ends the switch
+func (i *evalInterpreter) switchStatement(s *evalScope, f *evalFrame, c []*parser.Node) (evalFlow, interface{}) {
+	var value interface{}
+	hasExpression := len(c) > 0 && c[0].Name != "case-block" && c[0].Name != "default-block"
+	if hasExpression {
+		value = i.expression(s, c[0])
+		c = c[1:]
+	}
+
+	var (
+		flow        evalFlow
+		result      interface{}
+		matched     bool
+		defaultCase *parser.Node
+	)
+
+	for _, ci := range c {
+		if ci.Name == "default-block" {
+			defaultCase = ci
+			continue
+		}
+
+		cc := children(ci)
+		v := i.expression(s, cc[0])
+		if hasExpression && value == v || !hasExpression && v.(bool) {
+			flow, result = i.statements(s, f, ci, cc[1:])
+			matched = true
+			break
+		}
+	}
+
+	if !matched && defaultCase != nil {
+		flow, result = i.block(s, f, defaultCase)
+	}
+
+	if flow == evalBreak {
+		return evalNext, nil
+	}
+
+	return flow, result
+}
+
+func (i *evalInterpreter) selectStatement(s *evalScope, f *evalFrame, n *parser.Node, c []*parser.Node) (evalFlow, interface{}) {
+	var (
+		cases       []SelectCase
+		blocks      []*parser.Node
+		defaultCase *parser.Node
+	)
+
+	for _, ci := range c {
+		if ci.Name == "default-block" {
+			defaultCase = ci
+			continue
+		}
+
+		comm := children(ci)[0]
+		cc := children(comm)
+		switch comm.Name {
+		case "send-statement":
+			cases = append(cases, SelectCase{
+				Send:    true,
+				Channel: i.expression(s, cc[0]),
+				Value:   i.expression(s, cc[1]),
+			})
+		case "receive-expression":
+			cases = append(cases, SelectCase{Channel: i.expression(s, cc[0])})
+		default:
+			cases = append(cases, SelectCase{Channel: i.expression(s, children(cc[1])[0])})
+		}
+
+		blocks = append(blocks, ci)
+	}
+
+	var (
+		flow   evalFlow
+		result interface{}
+	)
+
+	index, value := Select(cases, defaultCase != nil, i.m.position(n))
+	if index < 0 {
+		flow, result = i.block(s, f, defaultCase)
+	} else {
+		b := blocks[index]
+		bc := children(b)
+		caseScope := s
+		if bc[0].Name == "receive-definition" {
+			name := children(bc[0])[0].Text()
+			caseScope = newEvalScope(s, []string{name})
+			caseScope.set(name, value)
+		}
+
+		flow, result = i.statements(caseScope, f, b, bc[1:])
+	}
+
+	if flow == evalBreak {
+		return evalNext, nil
+	}
+
+	return flow, result
+}
+
+// runs the body of a loop with the loop variable, when there is one, and tells whether the loop continues
+func (i *evalInterpreter) iteration(s *evalScope, f *evalFrame, body *parser.Node, symbol string, v interface{}) (bool, evalFlow, interface{}) {
+	if symbol != "" {
+		s = newEvalScope(s, []string{symbol})
+		s.set(symbol, v)
+	}
+
+	flow, result := i.block(s, f, body)
+	switch flow {
+	case evalBreak:
+		return false, evalNext, nil
+	case evalReturn:
+		return false, flow, result
+	default:
+		return true, evalNext, nil
+	}
+}
+
+func (i *evalInterpreter) loop(s *evalScope, f *evalFrame, c []*parser.Node) (evalFlow, interface{}) {
+	body := c[len(c)-1]
+	if len(c) == 1 {
+		for {
+			if next, flow, result := i.iteration(s, f, body, "", nil); !next {
+				return flow, result
+			}
+		}
+	}
+
+	if c[0].Name != "range-over" {
+		for i.expression(s, c[0]).(bool) {
+			if next, flow, result := i.iteration(s, f, body, "", nil); !next {
+				return flow, result
+			}
+		}
+
+		return evalNext, nil
+	}
+
+	r := children(c[0])
+	var symbol string
+	if len(r) > 0 && r[0].Name == "symbol" {
+		symbol = r[0].Text()
+		r = r[1:]
+	}
+
+	if len(r) > 0 && r[0].Name != "range-from" && r[0].Name != "range-to" {
+		for _, v := range i.expression(s, r[0]).(*List).Values() {
+			if next, flow, result := i.iteration(s, f, body, symbol, v); !next {
+				return flow, result
+			}
+		}
+
+		return evalNext, nil
+	}
+
+	var (
+		from  int
+		to    int
+		hasTo bool
+	)
+
+	for _, ri := range r {
+		v := i.expression(s, children(ri)[0]).(int)
+		if ri.Name == "range-from" {
+			from = v
+		} else {
+			to, hasTo = v, true
+		}
+	}
+
+	for counter := from; !hasTo || counter < to; counter++ {
+		if next, flow, result := i.iteration(s, f, body, symbol, counter); !next {
+			return flow, result
+		}
+	}
+
+	return evalNext, nil
+}
+
+func (i *evalInterpreter) define(s *evalScope, n *parser.Node, exported bool) {
+	for _, c := range captures(n) {
+		cc := children(c)
+		name := cc[0].Text()
+
+		var v interface{}
+		if c.Name == "function-capture" || c.Name == "effect-capture" {
+			v = i.function(s, c, 1)
+		} else {
+			v = i.expression(s, cc[1])
+		}
+
+		s.set(name, v)
+		if exported {
+			i.exports[name] = v
+		}
+	}
+}
+
+func (i *evalInterpreter) assign(s *evalScope, c []*parser.Node) {
+	if c[0].Name == "symbol" {
+		s.set(c[0].Text(), i.expression(s, c[1]))
+		return
+	}
+
+	ic := children(c[0])
+	container := i.expression(s, ic[0])
+	for _, index := range ic[1 : len(ic)-1] {
+		container = i.index(s, container, index)
+	}
+
+	var key interface{}
+	last := ic[len(ic)-1]
+	switch last.Name {
+	case "symbol-index":
+		key = symbolIndex(last)
+	case "expression-index":
+		key = i.expression(s, children(last)[0])
+	default:
+		panic("set-ref: unsupported code")
+	}
+
+	SetRef(container, key, i.expression(s, c[1]))
+}
+
+// the module symbol of an exported use, bound to the name of the module in case of an inline use
+func reexportSymbol(u *parser.Node) string {
+	if c := useCapture(u); c != "." {
+		return c
+	}
+
+	return moduleName(unquote(usePathNode(u)))
+}
+
+func (i *evalInterpreter) usedModule(u *parser.Node) *Struct {
+	m := i.e.use(i.m.uses[unquote(usePathNode(u))])
+	if err, ok := m.(*ModuleError); ok {
+		panic(err)
+	}
+
+	return m.(*Struct)
+}
+
+func (i *evalInterpreter) use(s *evalScope, u *parser.Node, exported bool) {
+	m := i.usedModule(u)
+	capture := useCapture(u)
+	if exported {
+		s.set(reexportSymbol(u), m)
+	} else if capture != "." {
+		s.set(capture, m)
+		return
+	}
+
+	for _, k := range m.Keys() {
+		s.set(k, m.Get(k))
+		if exported {
+			i.exports[k] = m.Get(k)
+		}
+	}
+}
+
+func (i *evalInterpreter) export(s *evalScope, c []*parser.Node) {
+	switch c[0].Name {
+	case "use-modules":
+		for _, u := range children(c[0]) {
+			i.use(s, u, true)
+		}
+	case "use-selection":
+		m := i.usedModule(c[1])
+		s.set(reexportSymbol(c[1]), m)
+		for _, si := range children(c[0]) {
+			v := Ref(m, si.Text())
+			s.set(si.Text(), v)
+			i.exports[si.Text()] = v
+		}
+	default:
+		i.define(s, c[0], true)
+	}
+}
+
+func (i *evalInterpreter) function(s *evalScope, n *parser.Node, offset int) *Function {
+	params, collect, body := functionFact(n, offset)
+	names := params
+	if collect != "" {
+		names = append(params[:len(params):len(params)], collect)
+	}
+
+	self := &Function{FixedArgs: len(params)}
+	self.F = func(a []interface{}) interface{} {
+		f := &evalFrame{self: self}
+		defer f.runDefers()
+		for {
+			v := i.call(s, f, names, params, collect, body, a)
+			if t, ok := v.(*evalTailCall); ok {
+				a = t.args
+				continue
+			}
+
+			return v
+		}
+	}
+
+	return self
+}
+
+func (i *evalInterpreter) call(s *evalScope, f *evalFrame, names, params []string, collect string, body *parser.Node, a []interface{}) interface{} {
+	fs := newEvalScope(s, names)
+	for j, p := range params {
+		fs.set(p, a[j])
+	}
+
+	if collect != "" {
+		fs.set(collect, NewList(a[len(params):]))
+	}
+
+	switch body.Name {
+	case "block":
+		if flow, v := i.block(fs, f, body); flow == evalReturn {
+			return v
+		}
+
+		return nil
+	case "send-statement", "go-statement", "defer-statement", "assign":
+		i.statement(fs, f, body)
+		return nil
+	default:
+		return i.tail(fs, f, body)
+	}
+}
+
+// evaluates an expression in tail position, where a call of the function to itself, with at least as many
+// arguments as its fixed parameters, is returned as an *evalTailCall instead of being called
+func (i *evalInterpreter) tail(s *evalScope, f *evalFrame, n *parser.Node) interface{} {
+	switch n.Name {
+	case "application":
+		fn, args := i.callee(s, n)
+		if fn == f.self && len(args) >= f.self.FixedArgs {
+			return &evalTailCall{args: args}
+		}
+
+		return fn.(*Function).Call(args)
+	case "ternary":
+		c := children(n)
+		if i.expression(s, c[0]).(bool) {
+			return i.tail(s, f, c[1])
+		}
+
+		return i.tail(s, f, c[2])
+	default:
+		return i.expression(s, n)
+	}
+}
+
+func symbolIndex(n *parser.Node) string {
+	c := children(n)
+	if len(c) == 0 {
+		return "use"
+	}
+
+	return c[0].Text()
+}
+
+func (i *evalInterpreter) index(s *evalScope, v interface{}, n *parser.Node) interface{} {
+	switch n.Name {
+	case "symbol-index":
+		return Ref(v, symbolIndex(n))
+	case "expression-index":
+		return Ref(v, i.expression(s, children(n)[0]))
+	default:
+		var from, to interface{}
+		for _, r := range children(n) {
+			if r.Name == "range-from" {
+				from = i.expression(s, children(r)[0])
+			} else {
+				to = i.expression(s, children(r)[0])
+			}
+		}
+
+		return RefRange(v, from, to)
+	}
+}
+
+// the arguments with the spread lists expanded
+func (i *evalInterpreter) values(s *evalScope, nodes []*parser.Node) []interface{} {
+	v := []interface{}{}
+	for _, n := range nodes {
+		if n.Name == "spread" {
+			v = append(v, i.expression(s, children(n)[0]).(*List).Values()...)
+			continue
+		}
+
+		v = append(v, i.expression(s, n))
+	}
+
+	return v
+}
+
+// the function and the arguments of an application
+func (i *evalInterpreter) callee(s *evalScope, n *parser.Node) (interface{}, []interface{}) {
+	c := children(n)
+	fn := i.expression(s, c[0])
+	if f, ok := fn.(*Function); ok && schedule != nil && f.at != nil {
+		fn = At(i.m.position(n), f)
+	}
+
+	return fn, i.values(s, c[1:])
+}
+
+func (i *evalInterpreter) list(s *evalScope, c []*parser.Node) interface{} {
+	l := &List{}
+	for _, n := range c {
+		if n.Name == "spread" {
+			l = l.Concat(i.expression(s, children(n)[0]).(*List))
+			continue
+		}
+
+		l = l.Append(i.expression(s, n))
+	}
+
+	return l
+}
+
+func (i *evalInterpreter) structure(s *evalScope, c []*parser.Node) interface{} {
+	st := &Struct{}
+	for _, n := range c {
+		e := children(n)
+		if n.Name == "spread" {
+			st.Merge(i.expression(s, e[0]).(*Struct))
+			continue
+		}
+
+		var key string
+		switch e[0].Name {
+		case "symbol":
+			key = e[0].Text()
+		case "string":
+			key = unquote(e[0])
+		default:
+			key = i.expression(s, children(e[0])[0]).(string)
+		}
+
+		st.Set(key, i.expression(s, e[1]))
+	}
+
+	return st
+}
+
+func (i *evalInterpreter) binary(s *evalScope, c []*parser.Node) interface{} {
+	v := i.expression(s, c[0])
+	for j := 1; j+1 < len(c); j += 2 {
+		switch op := c[j].Name; op {
+		case "logical-and":
+			v = v.(bool) && i.expression(s, c[j+1]).(bool)
+		case "logical-or":
+			v = v.(bool) || i.expression(s, c[j+1]).(bool)
+		case "lshift", "rshift":
+			right := i.expression(s, c[j+1])
+			left, leftInt := v.(int)
+			shift, rightInt := right.(int)
+			switch {
+			case leftInt && rightInt && op == "lshift":
+				v = left << shift
+			case leftInt && rightInt:
+				v = left >> shift
+			default:
+				v = BinaryOp(int(evalBinaryOperators[op]), v, right)
+			}
+		default:
+			v = BinaryOp(int(evalBinaryOperators[op]), v, i.expression(s, c[j+1]))
+		}
+	}
+
+	return v
+}
+
+func (i *evalInterpreter) expression(s *evalScope, n *parser.Node) interface{} {
+	c := children(n)
+	switch n.Name {
+	case "int":
+		return parseInt([]interface{}{n.Text()})
+	case "float":
+		return parseFloat([]interface{}{n.Text()})
+	case "string":
+		return unquote(n)
+	case "true":
+		return true
+	case "false":
+		return false
+	case "symbol":
+		return *s.cell(n.Text())
+	case "list", "mutable-list":
+		return i.list(s, c)
+	case "struct", "mutable-struct":
+		return i.structure(s, c)
+	case "function", "effect":
+		return i.function(s, n, 0)
+	case "indexer":
+		v := i.expression(s, c[0])
+		for _, index := range c[1:] {
+			v = i.index(s, v, index)
+		}
+
+		return v
+	case "application":
+		fn, args := i.callee(s, n)
+		return fn.(*Function).Call(args)
+	case "receive-expression":
+		return Receive(i.expression(s, c[0]), i.m.position(n))
+	case "unary":
+		arg := i.expression(s, c[1])
+		if c[0].Name == "logical-not" {
+			return !arg.(bool)
+		}
+
+		return UnaryOp(int(evalUnaryOperators[c[0].Name]), arg)
+	case "binary0", "binary1", "binary2", "binary3", "binary4":
+		return i.binary(s, c)
+	case "ternary":
+		if i.expression(s, c[0]).(bool) {
+			return i.expression(s, c[1])
+		}
+
+		return i.expression(s, c[2])
+	case "chaining":
+		v := i.expression(s, c[0])
+		for _, fn := range c[1:] {
+			v = i.expression(s, fn).(*Function).Call([]interface{}{v})
+		}
+
+		return v
+	default:
+		panic(fmt.Sprintf("%s: unsupported code: %s", i.m.position(n), n.Name))
+	}
+}
@@ -0,0 +1,268 @@
+package mml
+
+import "fmt"
+
+// the maximum number of items in a leaf
+const listWidth = 32
+
+// listNode is a node of a persistent, balanced tree of the items of a list. The items are stored in the leaves,
+// in chunks of at most listWidth items, while the branches store the size and the height of their subtree. The
+// heights of the two subtrees of a branch differ by at most one, the same way as in an AVL tree, so two trees
+// can be concatenated by walking down only on one side of the taller one. The nodes are never changed after
+// they were created, changing a tree copies only the path to the changed leaf, and shares the rest with the
+// original version.
+type listNode struct {
+	left, right *listNode
+	items       []interface{}
+	size        int
+	height      int
+}
+
+// List is a sequence of values. It is backed by a persistent tree, so slicing a list, appending to it, or
+// concatenating two lists, takes time proportional to the logarithm of the length of the lists, and shares
+// the items with the original lists instead of copying them. The last at most listWidth items are stored in a
+// separate tail, until it gets full, so that appending the items one by one doesn't need to change the tree
+// every time.
+type List struct {
+	root *listNode
+	tail []interface{}
+}
+
+func listLeaf(items []interface{}) *listNode {
+	return &listNode{items: items, size: len(items)}
+}
+
+func listBranch(left, right *listNode) *listNode {
+	height := left.height
+	if right.height > height {
+		height = right.height
+	}
+
+	return &listNode{left: left, right: right, size: left.size + right.size, height: height + 1}
+}
+
+// joins two trees whose heights differ by at most two, rotating them when necessary
+func listJoin(left, right *listNode) *listNode {
+	switch {
+	case left.height > right.height+1:
+		if left.left.height >= left.right.height {
+			return listBranch(left.left, listBranch(left.right, right))
+		}
+
+		return listBranch(
+			listBranch(left.left, left.right.left),
+			listBranch(left.right.right, right),
+		)
+	case right.height > left.height+1:
+		if right.right.height >= right.left.height {
+			return listBranch(listBranch(left, right.left), right.right)
+		}
+
+		return listBranch(
+			listBranch(left, right.left.left),
+			listBranch(right.left.right, right.right),
+		)
+	default:
+		return listBranch(left, right)
+	}
+}
+
+// concatenates two trees. The small leaves next to each other are merged, so that appending the items one by
+// one fills the leaves.
+func listConcat(left, right *listNode) *listNode {
+	switch {
+	case left == nil:
+		return right
+	case right == nil:
+		return left
+	case left.height == 0 && right.height == 0 && left.size+right.size <= listWidth:
+		items := make([]interface{}, 0, left.size+right.size)
+		items = append(items, left.items...)
+		return listLeaf(append(items, right.items...))
+	case left.height > right.height+1:
+		return listJoin(left.left, listConcat(left.right, right))
+	case right.height > left.height+1:
+		return listJoin(listConcat(left, right.left), right.right)
+	default:
+		return listBranch(left, right)
+	}
+}
+
+// builds a balanced tree from a slice, taking the ownership of it
+func listBuild(values []interface{}) *listNode {
+	if len(values) == 0 {
+		return nil
+	}
+
+	if len(values) <= listWidth {
+		return listLeaf(values[:len(values):len(values)])
+	}
+
+	leaves := (len(values) + listWidth - 1) / listWidth
+	middle := leaves / 2 * listWidth
+	return listBranch(listBuild(values[:middle]), listBuild(values[middle:]))
+}
+
+func (n *listNode) get(i int) interface{} {
+	for n.height > 0 {
+		if i < n.left.size {
+			n = n.left
+			continue
+		}
+
+		i -= n.left.size
+		n = n.right
+	}
+
+	return n.items[i]
+}
+
+func (n *listNode) set(i int, value interface{}) *listNode {
+	if n.height == 0 {
+		items := append([]interface{}(nil), n.items...)
+		items[i] = value
+		return listLeaf(items)
+	}
+
+	if i < n.left.size {
+		return listBranch(n.left.set(i, value), n.right)
+	}
+
+	return listBranch(n.left, n.right.set(i-n.left.size, value))
+}
+
+func (n *listNode) slice(from, to int) *listNode {
+	switch {
+	case from == to:
+		return nil
+	case from == 0 && to == n.size:
+		return n
+	case n.height == 0:
+		return listLeaf(n.items[from:to:to])
+	case to <= n.left.size:
+		return n.left.slice(from, to)
+	case from >= n.left.size:
+		return n.right.slice(from-n.left.size, to-n.left.size)
+	default:
+		return listConcat(n.left.slice(from, n.left.size), n.right.slice(0, to-n.left.size))
+	}
+}
+
+func (n *listNode) appendTo(values []interface{}) []interface{} {
+	if n.height == 0 {
+		return append(values, n.items...)
+	}
+
+	return n.right.appendTo(n.left.appendTo(values))
+}
+
+func (n *listNode) length() int {
+	if n == nil {
+		return 0
+	}
+
+	return n.size
+}
+
+// NewList creates a list from a slice of values. The list takes the ownership of the slice, it must not be
+// changed afterwards.
+func NewList(values []interface{}) *List {
+	split := len(values) - len(values)%listWidth
+	return &List{root: listBuild(values[:split]), tail: values[split:len(values):len(values)]}
+}
+
+func (l *List) Len() int {
+	return l.root.length() + len(l.tail)
+}
+
+func (l *List) checkIndex(i int) {
+	if i < 0 || i >= l.Len() {
+		panic(fmt.Sprintf("index out of range: %d, length: %d", i, l.Len()))
+	}
+}
+
+func (l *List) Get(i int) interface{} {
+	l.checkIndex(i)
+	if i >= l.root.length() {
+		return l.tail[i-l.root.length()]
+	}
+
+	return l.root.get(i)
+}
+
+// Set changes an item of the list in place. It is used only with mutable lists. The other lists sharing the
+// items, including the slices taken from the list earlier, are not affected.
+func (l *List) Set(i int, value interface{}) {
+	l.checkIndex(i)
+	if i >= l.root.length() {
+		l.tail = append([]interface{}(nil), l.tail...)
+		l.tail[i-l.root.length()] = value
+		return
+	}
+
+	l.root = l.root.set(i, value)
+}
+
+func (l *List) Slice(from, to int) *List {
+	if from < 0 || to < from || to > l.Len() {
+		panic(fmt.Sprintf("slice bounds out of range: %d:%d, length: %d", from, to, l.Len()))
+	}
+
+	size := l.root.length()
+	switch {
+	case from >= size:
+		return &List{tail: l.tail[from-size : to-size : to-size]}
+	case to <= size:
+		return &List{root: l.root.slice(from, to)}
+	default:
+		return &List{root: l.root.slice(from, size), tail: l.tail[: to-size : to-size]}
+	}
+}
+
+// the list with the tail stored in the tree
+func (l *List) flush() *listNode {
+	if len(l.tail) == 0 {
+		return l.root
+	}
+
+	return listConcat(l.root, listLeaf(l.tail))
+}
+
+// Append returns a new list with the values appended.
+func (l *List) Append(values ...interface{}) *List {
+	if len(l.tail)+len(values) <= listWidth {
+		tail := make([]interface{}, len(l.tail), len(l.tail)+len(values))
+		copy(tail, l.tail)
+		return &List{root: l.root, tail: append(tail, values...)}
+	}
+
+	return NewList(append([]interface{}(nil), values...)).prepend(l.flush())
+}
+
+// Concat returns a new list with the items of the other list appended.
+func (l *List) Concat(other *List) *List {
+	if other.root == nil {
+		return l.Append(other.tail...)
+	}
+
+	return other.prepend(l.flush())
+}
+
+func (l *List) prepend(root *listNode) *List {
+	return &List{root: listConcat(root, l.root), tail: l.tail}
+}
+
+// Values returns the items of the list in a new slice.
+func (l *List) Values() []interface{} {
+	values := make([]interface{}, 0, l.Len())
+	if l.root != nil {
+		values = l.root.appendTo(values)
+	}
+
+	return append(values, l.tail...)
+}
+
+// String formats the list the same way as the slice of its items.
+func (l *List) String() string {
+	return fmt.Sprintf("&{%v}", l.Values())
+}
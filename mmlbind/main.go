@@ -0,0 +1,523 @@
+/*
+Command mmlbind generates MML bindings for the exported functions of a Go package.
+
+It reads the package from its source with go/types, from the standard library or from the GOPATH, and for each
+exported function whose parameters and results can be converted to and from MML values, it generates a Go
+adapter function, registered with mml.Interop.Register, and an MML module exporting the function as an effect.
+
+Usage:
+
+	mmlbind [-go <file>] [-mml <file>] <package> <adapter package>
+
+The adapter package is the import path where the generated Go code is placed, e.g. in the GOPATH, and the MML
+module uses the functions with this import path. The output files default to the last segment of the adapter
+package with the .go and the .mml extensions, in the working directory.
+
+The supported parameter and result types are the integer, floating point, string and boolean types, the slices
+of the supported types, the maps with string keys and values of the supported types, and error. The functions
+can return no result, one result, or a result and an error.
+*/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+const runtimePackage = "github.com/aryszka/mml"
+
+// the keywords of MML, that cannot be used as the names of the functions or the parameters
+var keywords = []string{
+	"true", "false", "return", "check", "fn", "if", "else", "switch", "case", "default", "send", "receive",
+	"select", "go", "defer", "in", "for", "break", "continue", "let", "export", "use",
+}
+
+var symbolExpression = regexp.MustCompile("^[a-zA-Z_][a-zA-Z_0-9]*$")
+
+type param struct {
+	name string
+	typ  types.Type
+}
+
+type binding struct {
+	name    string
+	mmlName string
+	params  []param
+	collect types.Type
+	result  types.Type
+	error   bool
+}
+
+func errorType(t types.Type) bool {
+	return types.Identical(t, types.Universe.Lookup("error").Type())
+}
+
+func convertible(t types.Type) bool {
+	switch tt := t.(type) {
+	case *types.Basic:
+		return tt.Info()&(types.IsInteger|types.IsFloat|types.IsString|types.IsBoolean) != 0 &&
+			tt.Info()&types.IsUntyped == 0
+	case *types.Slice:
+		return convertible(tt.Elem())
+	case *types.Map:
+		key, ok := tt.Key().(*types.Basic)
+		return ok && key.Kind() == types.String && convertible(tt.Elem())
+	default:
+		return errorType(t)
+	}
+}
+
+func mmlType(t types.Type) string {
+	switch tt := t.(type) {
+	case nil:
+		return "mml.AnyType"
+	case *types.Basic:
+		switch {
+		case tt.Info()&types.IsInteger != 0:
+			return "mml.IntType"
+		case tt.Info()&types.IsFloat != 0:
+			return "mml.FloatType"
+		case tt.Info()&types.IsString != 0:
+			return "mml.StringType"
+		default:
+			return "mml.BoolType"
+		}
+	case *types.Slice:
+		return "mml.ListType"
+	case *types.Map:
+		return "mml.StructType"
+	default:
+		return "mml.ErrorType"
+	}
+}
+
+func contains(s string, l []string) bool {
+	for _, li := range l {
+		if li == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// the MML name of an exported Go name, with the leading capitals in lower case, e.g. HTMLEscape becomes
+// htmlEscape
+func mmlName(name string) string {
+	r := []rune(name)
+	for i := range r {
+		if !unicode.IsUpper(r[i]) || i > 0 && i < len(r)-1 && unicode.IsLower(r[i+1]) {
+			break
+		}
+
+		r[i] = unicode.ToLower(r[i])
+	}
+
+	n := string(r)
+	if contains(n, keywords) {
+		return n + "_"
+	}
+
+	return n
+}
+
+// the functions whose MML name would be the same as the MML name of another function, or the name of the Go
+// function that another one is called with in the generated module, e.g. Foo and FOO, or Foo and GoFoo, are
+// exported with their Go names. These start with a capital letter, so they are different from the rest of the
+// names.
+func setMMLNames(b []binding) {
+	used := make(map[string]int)
+	for _, bi := range b {
+		used[mmlName(bi.name)]++
+		used["go"+bi.name]++
+	}
+
+	for i := range b {
+		b[i].mmlName = mmlName(b[i].name)
+		if used[b[i].mmlName] > 1 {
+			log.Printf("%s is exported as %s, because %s would be ambiguous", b[i].name, b[i].name, b[i].mmlName)
+			b[i].mmlName = b[i].name
+		}
+	}
+}
+
+func paramName(p *types.Var, i int) string {
+	name := p.Name()
+	if name == "" || name == "_" || !symbolExpression.MatchString(name) {
+		return fmt.Sprintf("arg%d", i)
+	}
+
+	if contains(name, keywords) {
+		return name + "_"
+	}
+
+	return name
+}
+
+// returns false when the function cannot be bound
+func bind(f *types.Func) (binding, bool) {
+	s := f.Type().(*types.Signature)
+	if s.TypeParams().Len() > 0 || !symbolExpression.MatchString(f.Name()) {
+		return binding{}, false
+	}
+
+	b := binding{name: f.Name()}
+	for i := 0; i < s.Params().Len(); i++ {
+		p := s.Params().At(i)
+		t := p.Type()
+		if s.Variadic() && i == s.Params().Len()-1 {
+			b.collect = t.(*types.Slice).Elem()
+			t = b.collect
+		}
+
+		if !convertible(t) {
+			return binding{}, false
+		}
+
+		b.params = append(b.params, param{name: paramName(p, i), typ: p.Type()})
+	}
+
+	r := s.Results()
+	switch {
+	case r.Len() == 0:
+	case r.Len() == 1 && convertible(r.At(0).Type()):
+		b.result = r.At(0).Type()
+	case r.Len() == 2 && convertible(r.At(0).Type()) && !errorType(r.At(0).Type()) && errorType(r.At(1).Type()):
+		b.result = r.At(0).Type()
+		b.error = true
+	default:
+		return binding{}, false
+	}
+
+	return b, true
+}
+
+func bindings(p *types.Package) []binding {
+	var b []binding
+	for _, name := range p.Scope().Names() {
+		f, ok := p.Scope().Lookup(name).(*types.Func)
+		if !ok || !f.Exported() {
+			continue
+		}
+
+		if bf, ok := bind(f); ok {
+			b = append(b, bf)
+		}
+	}
+
+	setMMLNames(b)
+	return b
+}
+
+// the Go expression converting the MML value of the expression e to the type t
+func toGo(e string, t types.Type) string {
+	switch tt := t.(type) {
+	case *types.Basic:
+		var mt string
+		switch {
+		case tt.Info()&types.IsInteger != 0:
+			mt = "int"
+		case tt.Info()&types.IsFloat != 0:
+			mt = "float64"
+		case tt.Info()&types.IsString != 0:
+			mt = "string"
+		default:
+			mt = "bool"
+		}
+
+		if tt.Name() == mt {
+			return fmt.Sprintf("%s.(%s)", e, mt)
+		}
+
+		return fmt.Sprintf("%s(%s.(%s))", tt.Name(), e, mt)
+	case *types.Slice:
+		return fmt.Sprintf(
+			"func(l *mml.List) %s {\ns := make(%s, l.Len())\nfor i := range s {\ns[i] = %s\n}\n\nreturn s\n}(%s.(*mml.List))",
+			types.TypeString(t, nil),
+			types.TypeString(t, nil),
+			toGo("l.Get(i)", tt.Elem()),
+			e,
+		)
+	case *types.Map:
+		return fmt.Sprintf(
+			"func(st *mml.Struct) %s {\nm := make(%s)\nfor _, k := range st.Keys() {\nm[k] = %s\n}\n\nreturn m\n}(%s.(*mml.Struct))",
+			types.TypeString(t, nil),
+			types.TypeString(t, nil),
+			toGo("st.Get(k)", tt.Elem()),
+			e,
+		)
+	default:
+		return fmt.Sprintf("%s.(error)", e)
+	}
+}
+
+func adapterFunction(qualifier string, b binding) string {
+	var fixed []param
+	if b.collect == nil {
+		fixed = b.params
+	} else {
+		fixed = b.params[:len(b.params)-1]
+	}
+
+	var body, args, params []string
+	for i, p := range fixed {
+		args = append(args, toGo(fmt.Sprintf("args[%d]", i), p.typ))
+		params = append(params, mmlType(p.typ))
+	}
+
+	collectArgs := "_"
+	if b.collect != nil {
+		collectArgs = "collectArgs"
+		body = append(body, fmt.Sprintf(
+			"rest := make(%s, len(collectArgs))\nfor i := range collectArgs {\nrest[i] = %s\n}\n",
+			types.TypeString(b.params[len(b.params)-1].typ, nil),
+			toGo("collectArgs[i]", b.collect),
+		))
+
+		args = append(args, "rest...")
+	}
+
+	call := fmt.Sprintf("%s%s(%s)", qualifier, b.name, strings.Join(args, ", "))
+	switch {
+	case b.result == nil:
+		body = append(body, call, "return nil")
+	case errorType(b.result):
+		body = append(body, "return "+call)
+	case b.error:
+		body = append(body, fmt.Sprintf("r, err := %s\nif err != nil {\nreturn err\n}\n", call), "return mmlFromGo(r)")
+	default:
+		body = append(body, fmt.Sprintf("return mmlFromGo(%s)", call))
+	}
+
+	return fmt.Sprintf(
+		"mml.Interop.Register(mmlAdapterPackage, %q, mml.FunctionSignature{\nParams: []mml.Type{%s},\nVariadic: %t,\nCollect: %s,\nReturns: %s,\n}, func(args, %s []interface{}) interface{} {\n%s\n})\n",
+		b.name,
+		strings.Join(params, ", "),
+		b.collect != nil,
+		mmlType(b.collect),
+		mmlType(b.result),
+		collectArgs,
+		strings.Join(body, "\n"),
+	)
+}
+
+// when the adapter package is the bound package itself, the generated code is an additional file of the
+// package, and the functions are called without the package name
+func adapterCode(p *types.Package, adapter string, b []binding) ([]byte, error) {
+	var qualifier, alias, head string
+	switch {
+	case adapter == p.Path():
+		head = fmt.Sprintf(
+			"// Generated code\n\n// registers the functions of the package, to be used from MML with interop.use\n\npackage %s\n\nimport %q\n",
+			p.Name(),
+			runtimePackage,
+		)
+	case p.Name() == "mml":
+		qualifier = "bound."
+		alias = "bound "
+	default:
+		qualifier = p.Name() + "."
+	}
+
+	if head == "" {
+		head = fmt.Sprintf(
+			"// Generated code\n\n// Package %s registers the functions of the Go package %s, to be used from MML with interop.use.\npackage %s\n\nimport (\n%s%q\n\n%q\n)\n",
+			packageName(adapter),
+			p.Path(),
+			packageName(adapter),
+			alias,
+			p.Path(),
+			runtimePackage,
+		)
+	}
+
+	var functions []string
+	for _, bi := range b {
+		functions = append(functions, adapterFunction(qualifier, bi))
+	}
+
+	code := fmt.Sprintf(`%s
+const mmlAdapterPackage = %q
+
+func mmlFromGo(v interface{}) interface{} {
+	mv, err := mml.FromGo(v)
+	if err != nil {
+		return err
+	}
+
+	return mv
+}
+
+func init() {
+%s}
+`,
+		head,
+		adapter,
+		strings.Join(functions, "\n"),
+	)
+
+	return format.Source([]byte(code))
+}
+
+func moduleCode(name string, p *types.Package, adapter string, b []binding) []byte {
+	var definitions, functions []string
+	for _, bi := range b {
+		var params, args []string
+		for i, p := range bi.params {
+			if bi.collect != nil && i == len(bi.params)-1 {
+				params = append(params, "..."+p.name)
+				args = append(args, p.name+"...")
+			} else {
+				params = append(params, p.name)
+				args = append(args, p.name)
+			}
+		}
+
+		definitions = append(definitions, fmt.Sprintf("\tgo%s interop.use(%q, %q)\n", bi.name, adapter, bi.name))
+		functions = append(functions, fmt.Sprintf(
+			"export fn~ %s(%s) go%s(%s)\n",
+			bi.mmlName,
+			strings.Join(params, ", "),
+			bi.name,
+			strings.Join(args, ", "),
+		))
+	}
+
+	return []byte(fmt.Sprintf(`// Generated code
+
+/*
+module %s exports the functions of the Go package %s, registered by the adapter package %s.
+*/
+
+use "interop"
+
+let (
+%s)
+
+%s`,
+		name,
+		p.Path(),
+		adapter,
+		strings.Join(definitions, ""),
+		strings.Join(functions, ""),
+	))
+}
+
+// loads a package from its source, without the file exclude, that can be the output of an earlier run
+func load(pkg, exclude string) (*types.Package, error) {
+	ctx := build.Default
+	ctx.CgoEnabled = false
+	bp, err := ctx.Import(pkg, "", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, name := range bp.GoFiles {
+		fileName := filepath.Join(bp.Dir, name)
+		if fileName == exclude {
+			continue
+		}
+
+		f, err := parser.ParseFile(fset, fileName, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, f)
+	}
+
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	return conf.Check(bp.ImportPath, fset, files, nil)
+}
+
+// the Go package name of an import path
+func packageName(importPath string) string {
+	name := []rune(path.Base(importPath))
+	for i := range name {
+		if !unicode.IsLetter(name[i]) && !unicode.IsDigit(name[i]) && name[i] != '_' {
+			name[i] = '_'
+		}
+	}
+
+	if len(name) == 0 || unicode.IsDigit(name[0]) {
+		return "_" + string(name)
+	}
+
+	return string(name)
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("mmlbind: ")
+
+	goFile := flag.String("go", "", "output file of the Go adapter package")
+	mmlFile := flag.String("mml", "", "output file of the MML module")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: mmlbind [-go <file>] [-mml <file>] <package> <adapter package>")
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	pkg, adapter := flag.Arg(0), flag.Arg(1)
+	if *goFile == "" {
+		*goFile = path.Base(adapter) + ".go"
+	}
+
+	if *mmlFile == "" {
+		*mmlFile = path.Base(adapter) + ".mml"
+	}
+
+	// the packages are read in GOPATH mode, the same way as the compiler vendors the packages used with
+	// interop.use
+	os.Setenv("GO111MODULE", "off")
+	exclude, err := filepath.Abs(*goFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	p, err := load(pkg, exclude)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	b := bindings(p)
+	if len(b) == 0 {
+		log.Fatalf("no functions to bind in %s", pkg)
+	}
+
+	goCode, err := adapterCode(p, adapter, b)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(*goFile, goCode, 0666); err != nil {
+		log.Fatal(err)
+	}
+
+	moduleName := strings.TrimSuffix(filepath.Base(*mmlFile), ".mml")
+	if err := ioutil.WriteFile(*mmlFile, moduleCode(moduleName, p, adapter, b), 0666); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -947,7 +947,7 @@ func Parse(r io.Reader) (*Node, error) {
 	var p158 = charParser{id: 158, chars: []rune{114}}
 	var p159 = charParser{id: 159, chars: []rune{116}}
 	p160.items = []parser{&p154, &p155, &p156, &p157, &p158, &p159}
-	var p164 = sequenceParser{id: 164, commit: 282, name: "use", allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
+	var p164 = sequenceParser{id: 164, commit: 282, name: "use", allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}, generalizations: []int{845}}
 	var p161 = charParser{id: 161, chars: []rune{117}}
 	var p162 = charParser{id: 162, chars: []rune{115}}
 	var p163 = charParser{id: 163, chars: []rune{101}}
@@ -1135,7 +1135,7 @@ func Parse(r io.Reader) (*Node, error) {
 	p208.items = []parser{&p197, &p205, &p207}
 	var p209 = choiceParser{id: 209, commit: 258, name: "bool", generalizations: []int{373, 225, 314, 502, 439, 440, 441, 442, 443, 494, 618, 611}}
 	p209.options = []parser{&p48, &p54}
-	var p214 = sequenceParser{id: 214, commit: 296, name: "symbol", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}, generalizations: []int{373, 225, 250, 314, 630, 502, 439, 440, 441, 442, 443, 494, 618, 611, 733, 748}}
+	var p214 = sequenceParser{id: 214, commit: 296, name: "symbol", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}, generalizations: []int{373, 225, 250, 314, 630, 502, 439, 440, 441, 442, 443, 494, 618, 611, 733, 748, 845}}
 	var p211 = sequenceParser{id: 211, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p210 = charParser{id: 210, chars: []rune{95}, ranges: [][]rune{{97, 122}, {65, 90}}}
 	p211.items = []parser{&p210}
@@ -1338,7 +1338,9 @@ func Parse(r io.Reader) (*Node, error) {
 	var p339 = sequenceParser{id: 339, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
 	p339.items = []parser{&p828, &p14}
 	p340.items = []parser{&p828, &p14, &p339}
-	p341.items = []parser{&p338, &p340, &p828, &p214}
+	var p845 = choiceParser{id: 845, commit: 2}
+	p845.options = []parser{&p214, &p164}
+	p341.items = []parser{&p338, &p340, &p828, &p845}
 	var p350 = sequenceParser{id: 350, commit: 256, name: "expression-index", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{360}}
 	var p343 = sequenceParser{id: 343, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p342 = charParser{id: 342, chars: []rune{91}}
@@ -1900,8 +1902,8 @@ func Parse(r io.Reader) (*Node, error) {
 	p627.items = []parser{&p626, &p828, &p307}
 	p628.options = []parser{&p624, &p627}
 	p629.items = []parser{&p134, &p828, &p628}
-	var p730 = choiceParser{id: 730, commit: 258, name: "definition", generalizations: []int{798}}
-	var p657 = sequenceParser{id: 657, commit: 256, name: "value-definition", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{730, 798}}
+	var p730 = choiceParser{id: 730, commit: 258, name: "definition", generalizations: []int{798, 844}}
+	var p657 = sequenceParser{id: 657, commit: 256, name: "value-definition", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{730, 798, 844}}
 	var p653 = sequenceParser{id: 653, commit: 266, name: "docsLet", ranges: [][]int{{0, 1}, {1, 1}, {0, 1}, {1, 1}}}
 	var p638 = sequenceParser{id: 638, commit: 256, name: "docs", ranges: [][]int{{1, 1}, {0, -1}, {0, 1}}}
 	p638.items = []parser{&p43, &p828, &p14}
@@ -1939,7 +1941,7 @@ func Parse(r io.Reader) (*Node, error) {
 	p652.items = []parser{&p649, &p651, &p828, &p646}
 	p654.options = []parser{&p647, &p652}
 	p657.items = []parser{&p653, &p656, &p828, &p654}
-	var p675 = sequenceParser{id: 675, commit: 256, name: "value-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{730, 798}}
+	var p675 = sequenceParser{id: 675, commit: 256, name: "value-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{730, 798, 844}}
 	var p674 = sequenceParser{id: 674, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p673 = sequenceParser{id: 673, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
 	p673.items = []parser{&p828, &p14}
@@ -1963,7 +1965,7 @@ func Parse(r io.Reader) (*Node, error) {
 	var p671 = charParser{id: 671, chars: []rune{41}}
 	p672.items = []parser{&p671}
 	p675.items = []parser{&p153, &p674, &p828, &p670, &p828, &p224, &p828, &p668, &p828, &p224, &p828, &p672}
-	var p686 = sequenceParser{id: 686, commit: 256, name: "mutable-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{730, 798}}
+	var p686 = sequenceParser{id: 686, commit: 256, name: "mutable-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{730, 798, 844}}
 	var p683 = sequenceParser{id: 683, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p682 = sequenceParser{id: 682, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
 	p682.items = []parser{&p828, &p14}
@@ -1992,7 +1994,7 @@ func Parse(r io.Reader) (*Node, error) {
 	var p680 = charParser{id: 680, chars: []rune{41}}
 	p681.items = []parser{&p680}
 	p686.items = []parser{&p153, &p683, &p828, &p677, &p685, &p828, &p679, &p828, &p224, &p828, &p664, &p828, &p224, &p828, &p681}
-	var p700 = sequenceParser{id: 700, commit: 256, name: "function-definition", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{730, 798}}
+	var p700 = sequenceParser{id: 700, commit: 256, name: "function-definition", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{730, 798, 844}}
 	var p696 = sequenceParser{id: 696, commit: 266, name: "docsFn", ranges: [][]int{{0, 1}, {1, 1}, {0, 1}, {1, 1}}}
 	p696.items = []parser{&p638, &p70}
 	var p699 = sequenceParser{id: 699, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
@@ -2019,7 +2021,7 @@ func Parse(r io.Reader) (*Node, error) {
 	p695.items = []parser{&p692, &p694, &p828, &p689}
 	p697.options = []parser{&p690, &p695}
 	p700.items = []parser{&p696, &p699, &p828, &p697}
-	var p718 = sequenceParser{id: 718, commit: 256, name: "function-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{730, 798}}
+	var p718 = sequenceParser{id: 718, commit: 256, name: "function-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{730, 798, 844}}
 	var p717 = sequenceParser{id: 717, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p716 = sequenceParser{id: 716, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
 	p716.items = []parser{&p828, &p14}
@@ -2043,7 +2045,7 @@ func Parse(r io.Reader) (*Node, error) {
 	var p714 = charParser{id: 714, chars: []rune{41}}
 	p715.items = []parser{&p714}
 	p718.items = []parser{&p70, &p717, &p828, &p713, &p828, &p224, &p828, &p711, &p828, &p224, &p828, &p715}
-	var p729 = sequenceParser{id: 729, commit: 256, name: "effect-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{730, 798}}
+	var p729 = sequenceParser{id: 729, commit: 256, name: "effect-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{730, 798, 844}}
 	var p726 = sequenceParser{id: 726, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p725 = sequenceParser{id: 725, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
 	p725.items = []parser{&p828, &p14}
@@ -2078,9 +2080,8 @@ func Parse(r io.Reader) (*Node, error) {
 	var p795 = sequenceParser{id: 795, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
 	p795.items = []parser{&p828, &p14}
 	p796.items = []parser{&p828, &p14, &p795}
-	p797.items = []parser{&p160, &p796, &p828, &p730}
-	var p794 = choiceParser{id: 794, commit: 256, name: "use-modules", generalizations: []int{798}}
-	var p775 = sequenceParser{id: 775, commit: 258, name: "use-statement", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{794, 798}}
+	var p794 = choiceParser{id: 794, commit: 256, name: "use-modules", generalizations: []int{798, 844}}
+	var p775 = sequenceParser{id: 775, commit: 258, name: "use-statement", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{794, 798, 844}}
 	var p774 = sequenceParser{id: 774, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p773 = sequenceParser{id: 773, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
 	p773.items = []parser{&p828, &p14}
@@ -2145,7 +2146,7 @@ func Parse(r io.Reader) (*Node, error) {
 	p761.options = []parser{&p747, &p760}
 	p772.options = []parser{&p742, &p761}
 	p775.items = []parser{&p164, &p774, &p828, &p772}
-	var p782 = sequenceParser{id: 782, commit: 258, name: "use-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{794, 798}}
+	var p782 = sequenceParser{id: 782, commit: 258, name: "use-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{794, 798, 844}}
 	var p781 = sequenceParser{id: 781, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p780 = sequenceParser{id: 780, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
 	p780.items = []parser{&p828, &p14}
@@ -2169,7 +2170,7 @@ func Parse(r io.Reader) (*Node, error) {
 	var p778 = charParser{id: 778, chars: []rune{41}}
 	p779.items = []parser{&p778}
 	p782.items = []parser{&p164, &p781, &p828, &p777, &p828, &p224, &p828, &p771, &p828, &p224, &p828, &p779}
-	var p793 = sequenceParser{id: 793, commit: 258, name: "use-effect-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{794, 798}}
+	var p793 = sequenceParser{id: 793, commit: 258, name: "use-effect-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{794, 798, 844}}
 	var p790 = sequenceParser{id: 790, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p789 = sequenceParser{id: 789, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
 	p789.items = []parser{&p828, &p14}
@@ -2197,6 +2198,33 @@ func Parse(r io.Reader) (*Node, error) {
 	p788.items = []parser{&p787}
 	p793.items = []parser{&p164, &p790, &p828, &p784, &p792, &p828, &p786, &p828, &p224, &p828, &p765, &p828, &p224, &p828, &p788}
 	p794.options = []parser{&p775, &p782, &p793}
+	var p844 = choiceParser{id: 844, commit: 2}
+	var p843 = sequenceParser{id: 843, commit: 258, name: "use-selective", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{844}}
+	var p840 = sequenceParser{id: 840, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p839 = sequenceParser{id: 839, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p839.items = []parser{&p828, &p14}
+	p840.items = []parser{&p828, &p14, &p839}
+	var p831 = sequenceParser{id: 831, commit: 256, name: "use-selection", ranges: [][]int{{1, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}}
+	var p833 = sequenceParser{id: 833, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var p832 = charParser{id: 832, chars: []rune{40}}
+	p833.items = []parser{&p832}
+	var p836 = sequenceParser{id: 836, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p834 = sequenceParser{id: 834, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
+	p834.items = []parser{&p224, &p828, &p214}
+	var p835 = sequenceParser{id: 835, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p835.items = []parser{&p828, &p834}
+	p836.items = []parser{&p828, &p834, &p835}
+	var p838 = sequenceParser{id: 838, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var p837 = charParser{id: 837, chars: []rune{41}}
+	p838.items = []parser{&p837}
+	p831.items = []parser{&p833, &p828, &p224, &p828, &p214, &p836, &p828, &p224, &p828, &p838}
+	var p842 = sequenceParser{id: 842, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p841 = sequenceParser{id: 841, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p841.items = []parser{&p828, &p14}
+	p842.items = []parser{&p828, &p14, &p841}
+	p843.items = []parser{&p164, &p840, &p828, &p831, &p842, &p828, &p772}
+	p844.options = []parser{&p730, &p794, &p843}
+	p797.items = []parser{&p160, &p796, &p828, &p844}
 	var p807 = sequenceParser{id: 807, commit: 258, name: "statement-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{798}}
 	var p800 = sequenceParser{id: 800, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p799 = charParser{id: 799, chars: []rune{40}}
@@ -2427,7 +2455,7 @@ func Parse(r io.Reader) (*Node, error) {
 	var b53 = charBuilder{}
 	b54.items = []builder{&b49, &b50, &b51, &b52, &b53}
 	b209.options = []builder{&b48, &b54}
-	var b214 = sequenceBuilder{id: 214, commit: 296, name: "symbol", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}, generalizations: []int{373, 225, 250, 314, 630, 502, 439, 440, 441, 442, 443, 494, 618, 611, 733, 748}}
+	var b214 = sequenceBuilder{id: 214, commit: 296, name: "symbol", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}, generalizations: []int{373, 225, 250, 314, 630, 502, 439, 440, 441, 442, 443, 494, 618, 611, 733, 748, 845}}
 	var b211 = sequenceBuilder{id: 211, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b210 = charBuilder{}
 	b211.items = []builder{&b210}
@@ -2651,7 +2679,6 @@ func Parse(r io.Reader) (*Node, error) {
 	var b339 = sequenceBuilder{id: 339, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
 	b339.items = []builder{&b828, &b14}
 	b340.items = []builder{&b828, &b14, &b339}
-	b341.items = []builder{&b338, &b340, &b828, &b214}
 	var b350 = sequenceBuilder{id: 350, commit: 256, name: "expression-index", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{360}}
 	var b343 = sequenceBuilder{id: 343, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b342 = charBuilder{}
@@ -3296,8 +3323,8 @@ func Parse(r io.Reader) (*Node, error) {
 	b627.items = []builder{&b626, &b828, &b307}
 	b628.options = []builder{&b624, &b627}
 	b629.items = []builder{&b134, &b828, &b628}
-	var b730 = choiceBuilder{id: 730, commit: 258, generalizations: []int{798}}
-	var b657 = sequenceBuilder{id: 657, commit: 256, name: "value-definition", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{730, 798}}
+	var b730 = choiceBuilder{id: 730, commit: 258, generalizations: []int{798, 844}}
+	var b657 = sequenceBuilder{id: 657, commit: 256, name: "value-definition", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{730, 798, 844}}
 	var b653 = sequenceBuilder{id: 653, commit: 266, ranges: [][]int{{0, 1}, {1, 1}, {0, 1}, {1, 1}}}
 	var b638 = sequenceBuilder{id: 638, commit: 256, name: "docs", ranges: [][]int{{1, 1}, {0, -1}, {0, 1}}}
 	b638.items = []builder{&b43, &b828, &b14}
@@ -3340,7 +3367,7 @@ func Parse(r io.Reader) (*Node, error) {
 	b652.items = []builder{&b649, &b651, &b828, &b646}
 	b654.options = []builder{&b647, &b652}
 	b657.items = []builder{&b653, &b656, &b828, &b654}
-	var b675 = sequenceBuilder{id: 675, commit: 256, name: "value-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{730, 798}}
+	var b675 = sequenceBuilder{id: 675, commit: 256, name: "value-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{730, 798, 844}}
 	var b674 = sequenceBuilder{id: 674, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b673 = sequenceBuilder{id: 673, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
 	b673.items = []builder{&b828, &b14}
@@ -3364,7 +3391,7 @@ func Parse(r io.Reader) (*Node, error) {
 	var b671 = charBuilder{}
 	b672.items = []builder{&b671}
 	b675.items = []builder{&b153, &b674, &b828, &b670, &b828, &b224, &b828, &b668, &b828, &b224, &b828, &b672}
-	var b686 = sequenceBuilder{id: 686, commit: 256, name: "mutable-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{730, 798}}
+	var b686 = sequenceBuilder{id: 686, commit: 256, name: "mutable-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{730, 798, 844}}
 	var b683 = sequenceBuilder{id: 683, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b682 = sequenceBuilder{id: 682, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
 	b682.items = []builder{&b828, &b14}
@@ -3393,7 +3420,7 @@ func Parse(r io.Reader) (*Node, error) {
 	var b680 = charBuilder{}
 	b681.items = []builder{&b680}
 	b686.items = []builder{&b153, &b683, &b828, &b677, &b685, &b828, &b679, &b828, &b224, &b828, &b664, &b828, &b224, &b828, &b681}
-	var b700 = sequenceBuilder{id: 700, commit: 256, name: "function-definition", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{730, 798}}
+	var b700 = sequenceBuilder{id: 700, commit: 256, name: "function-definition", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{730, 798, 844}}
 	var b696 = sequenceBuilder{id: 696, commit: 266, ranges: [][]int{{0, 1}, {1, 1}, {0, 1}, {1, 1}}}
 	b696.items = []builder{&b638, &b70}
 	var b699 = sequenceBuilder{id: 699, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
@@ -3420,7 +3447,7 @@ func Parse(r io.Reader) (*Node, error) {
 	b695.items = []builder{&b692, &b694, &b828, &b689}
 	b697.options = []builder{&b690, &b695}
 	b700.items = []builder{&b696, &b699, &b828, &b697}
-	var b718 = sequenceBuilder{id: 718, commit: 256, name: "function-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{730, 798}}
+	var b718 = sequenceBuilder{id: 718, commit: 256, name: "function-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{730, 798, 844}}
 	var b717 = sequenceBuilder{id: 717, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b716 = sequenceBuilder{id: 716, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
 	b716.items = []builder{&b828, &b14}
@@ -3444,7 +3471,7 @@ func Parse(r io.Reader) (*Node, error) {
 	var b714 = charBuilder{}
 	b715.items = []builder{&b714}
 	b718.items = []builder{&b70, &b717, &b828, &b713, &b828, &b224, &b828, &b711, &b828, &b224, &b828, &b715}
-	var b729 = sequenceBuilder{id: 729, commit: 256, name: "effect-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{730, 798}}
+	var b729 = sequenceBuilder{id: 729, commit: 256, name: "effect-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{730, 798, 844}}
 	var b726 = sequenceBuilder{id: 726, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b725 = sequenceBuilder{id: 725, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
 	b725.items = []builder{&b828, &b14}
@@ -3487,14 +3514,16 @@ func Parse(r io.Reader) (*Node, error) {
 	var b795 = sequenceBuilder{id: 795, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
 	b795.items = []builder{&b828, &b14}
 	b796.items = []builder{&b828, &b14, &b795}
-	b797.items = []builder{&b160, &b796, &b828, &b730}
-	var b794 = choiceBuilder{id: 794, commit: 256, name: "use-modules", generalizations: []int{798}}
-	var b775 = sequenceBuilder{id: 775, commit: 258, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{794, 798}}
-	var b164 = sequenceBuilder{id: 164, commit: 282, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
+	var b794 = choiceBuilder{id: 794, commit: 256, name: "use-modules", generalizations: []int{798, 844}}
+	var b775 = sequenceBuilder{id: 775, commit: 258, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{794, 798, 844}}
+	var b164 = sequenceBuilder{id: 164, commit: 282, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}, generalizations: []int{845}}
 	var b161 = charBuilder{}
 	var b162 = charBuilder{}
 	var b163 = charBuilder{}
 	b164.items = []builder{&b161, &b162, &b163}
+	var b845 = choiceBuilder{id: 845, commit: 2}
+	b845.options = []builder{&b214, &b164}
+	b341.items = []builder{&b338, &b340, &b828, &b845}
 	var b774 = sequenceBuilder{id: 774, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b773 = sequenceBuilder{id: 773, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
 	b773.items = []builder{&b828, &b14}
@@ -3559,7 +3588,7 @@ func Parse(r io.Reader) (*Node, error) {
 	b761.options = []builder{&b747, &b760}
 	b772.options = []builder{&b742, &b761}
 	b775.items = []builder{&b164, &b774, &b828, &b772}
-	var b782 = sequenceBuilder{id: 782, commit: 258, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{794, 798}}
+	var b782 = sequenceBuilder{id: 782, commit: 258, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{794, 798, 844}}
 	var b781 = sequenceBuilder{id: 781, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b780 = sequenceBuilder{id: 780, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
 	b780.items = []builder{&b828, &b14}
@@ -3583,7 +3612,7 @@ func Parse(r io.Reader) (*Node, error) {
 	var b778 = charBuilder{}
 	b779.items = []builder{&b778}
 	b782.items = []builder{&b164, &b781, &b828, &b777, &b828, &b224, &b828, &b771, &b828, &b224, &b828, &b779}
-	var b793 = sequenceBuilder{id: 793, commit: 258, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{794, 798}}
+	var b793 = sequenceBuilder{id: 793, commit: 258, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{794, 798, 844}}
 	var b790 = sequenceBuilder{id: 790, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b789 = sequenceBuilder{id: 789, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
 	b789.items = []builder{&b828, &b14}
@@ -3611,6 +3640,33 @@ func Parse(r io.Reader) (*Node, error) {
 	b788.items = []builder{&b787}
 	b793.items = []builder{&b164, &b790, &b828, &b784, &b792, &b828, &b786, &b828, &b224, &b828, &b765, &b828, &b224, &b828, &b788}
 	b794.options = []builder{&b775, &b782, &b793}
+	var b844 = choiceBuilder{id: 844, commit: 2}
+	var b843 = sequenceBuilder{id: 843, commit: 258, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{844}}
+	var b840 = sequenceBuilder{id: 840, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b839 = sequenceBuilder{id: 839, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b839.items = []builder{&b828, &b14}
+	b840.items = []builder{&b828, &b14, &b839}
+	var b831 = sequenceBuilder{id: 831, commit: 256, name: "use-selection", ranges: [][]int{{1, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}}
+	var b833 = sequenceBuilder{id: 833, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var b832 = charBuilder{}
+	b833.items = []builder{&b832}
+	var b836 = sequenceBuilder{id: 836, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b834 = sequenceBuilder{id: 834, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
+	b834.items = []builder{&b224, &b828, &b214}
+	var b835 = sequenceBuilder{id: 835, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b835.items = []builder{&b828, &b834}
+	b836.items = []builder{&b828, &b834, &b835}
+	var b838 = sequenceBuilder{id: 838, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var b837 = charBuilder{}
+	b838.items = []builder{&b837}
+	b831.items = []builder{&b833, &b828, &b224, &b828, &b214, &b836, &b828, &b224, &b828, &b838}
+	var b842 = sequenceBuilder{id: 842, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b841 = sequenceBuilder{id: 841, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b841.items = []builder{&b828, &b14}
+	b842.items = []builder{&b828, &b14, &b841}
+	b843.items = []builder{&b164, &b840, &b828, &b831, &b842, &b828, &b772}
+	b844.options = []builder{&b730, &b794, &b843}
+	b797.items = []builder{&b160, &b796, &b828, &b844}
 	var b807 = sequenceBuilder{id: 807, commit: 258, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{798}}
 	var b800 = sequenceBuilder{id: 800, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b799 = charBuilder{}
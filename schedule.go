@@ -0,0 +1,390 @@
+package mml
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"sync"
+)
+
+// ScheduleSeedEnv is the name of the environment variable that enables the deterministic scheduler. When it is
+// set to an integer, the goroutines started by the compiled code run one at a time, and they give way to each
+// other only at channel operations, in an order chosen by a random source seeded with the value. Running a
+// program with the same seed replays the same interleaving. Meant for testing only.
+const ScheduleSeedEnv = "MML_SCHEDULE_SEED"
+
+type goroutine struct {
+	id        int
+	started   string
+	wake      chan struct{}
+	blocked   string
+	blockedAt int
+}
+
+// a blocked channel operation. A select registers a waiter on each of its channels, and all of them share the
+// same operation, so that only one of them can be completed.
+type channelOperation struct {
+	done     bool
+	selected int
+	value    interface{}
+
+	// the channel was closed while the operation was waiting to send
+	closed bool
+}
+
+type channelWaiter struct {
+	operation *channelOperation
+	index     int
+	value     interface{}
+}
+
+type scheduledChannel struct {
+	capacity  int
+	buffer    []interface{}
+	closed    bool
+	senders   []*channelWaiter
+	receivers []*channelWaiter
+}
+
+type scheduler struct {
+	seed       int64
+	random     *rand.Rand
+	nextID     int
+	goroutines []*goroutine
+	current    *goroutine
+	channels   map[chan interface{}]*scheduledChannel
+
+	// incremented on every completed operation, goroutines blocked before the last progress may be able to
+	// continue
+	progress int
+}
+
+var schedule *scheduler
+
+func init() {
+	s, ok := os.LookupEnv(ScheduleSeedEnv)
+	if !ok {
+		return
+	}
+
+	seed, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid %s: %s\n", ScheduleSeedEnv, s)
+		os.Exit(2)
+	}
+
+	schedule = newScheduler(seed)
+}
+
+func newScheduler(seed int64) *scheduler {
+	main := &goroutine{started: "main", wake: make(chan struct{}, 1)}
+	return &scheduler{
+		seed:       seed,
+		random:     rand.New(rand.NewSource(seed)),
+		nextID:     1,
+		goroutines: []*goroutine{main},
+		current:    main,
+		channels:   make(map[chan interface{}]*scheduledChannel),
+	}
+}
+
+func (s *scheduler) runnable() []*goroutine {
+	var r []*goroutine
+	for _, g := range s.goroutines {
+		if g.blocked == "" || g.blockedAt < s.progress {
+			r = append(r, g)
+		}
+	}
+
+	return r
+}
+
+func (s *scheduler) deadlock() {
+	fmt.Fprintf(os.Stderr, "deadlock, %s=%d\n", ScheduleSeedEnv, s.seed)
+	for _, g := range s.goroutines {
+		fmt.Fprintf(os.Stderr, "goroutine %d, started at %s: blocked at %s\n", g.id, g.started, g.blocked)
+	}
+
+	os.Exit(2)
+}
+
+// switchTo passes the control to a goroutine chosen by the random source, and returns false when there is no
+// goroutine that could run
+func (s *scheduler) switchTo() bool {
+	r := s.runnable()
+	if len(r) == 0 {
+		return false
+	}
+
+	s.current = r[s.random.Intn(len(r))]
+	s.current.wake <- struct{}{}
+	return true
+}
+
+func (s *scheduler) yield() {
+	g := s.current
+	if !s.switchTo() {
+		s.deadlock()
+	}
+
+	<-g.wake
+}
+
+func (s *scheduler) waitFor(position string, ready func() bool) {
+	g := s.current
+	s.yield()
+	for !ready() {
+		g.blocked, g.blockedAt = position, s.progress
+		s.yield()
+	}
+
+	g.blocked = ""
+	s.progress++
+}
+
+func (s *scheduler) start(position string, f func()) {
+	g := &goroutine{id: s.nextID, started: position, wake: make(chan struct{}, 1)}
+	s.nextID++
+	s.goroutines = append(s.goroutines, g)
+	go func() {
+		<-g.wake
+		defer s.exit(g)
+		f()
+	}()
+}
+
+func (s *scheduler) exit(g *goroutine) {
+	for i := range s.goroutines {
+		if s.goroutines[i] == g {
+			s.goroutines = append(s.goroutines[:i], s.goroutines[i+1:]...)
+			break
+		}
+	}
+
+	s.progress++
+	if !s.switchTo() {
+		s.deadlock()
+	}
+}
+
+func (s *scheduler) channel(c chan interface{}) *scheduledChannel {
+	sc, ok := s.channels[c]
+	if !ok {
+		sc = &scheduledChannel{capacity: cap(c)}
+		s.channels[c] = sc
+	}
+
+	return sc
+}
+
+func (w *channelWaiter) complete(v interface{}) {
+	w.operation.done = true
+	w.operation.selected = w.index
+	w.operation.value = v
+}
+
+// removes and returns the first waiter whose operation was not completed yet. The waiters of the completed
+// select operations are dropped here, too.
+func firstWaiting(w *[]*channelWaiter) *channelWaiter {
+	for len(*w) > 0 {
+		first := (*w)[0]
+		*w = (*w)[1:]
+		if !first.operation.done {
+			return first
+		}
+	}
+
+	return nil
+}
+
+// a value is passed directly to a waiting receiver, or stored in the buffer when there is space in it
+func (c *scheduledChannel) put(v interface{}) bool {
+	if c.closed {
+		panic("send on closed channel")
+	}
+
+	if r := firstWaiting(&c.receivers); r != nil {
+		r.complete(v)
+		return true
+	}
+
+	if len(c.buffer) < c.capacity {
+		c.buffer = append(c.buffer, v)
+		return true
+	}
+
+	return false
+}
+
+// a value is taken from the buffer, letting a waiting sender fill the freed space, or directly from a waiting
+// sender when the buffer is empty
+func (c *scheduledChannel) take() (interface{}, bool) {
+	if len(c.buffer) > 0 {
+		v := c.buffer[0]
+		c.buffer = c.buffer[1:]
+		if s := firstWaiting(&c.senders); s != nil {
+			c.buffer = append(c.buffer, s.value)
+			s.complete(nil)
+		}
+
+		return v, true
+	}
+
+	if s := firstWaiting(&c.senders); s != nil {
+		s.complete(nil)
+		return s.value, true
+	}
+
+	if c.closed {
+		return Closed, true
+	}
+
+	return nil, false
+}
+
+// operate executes the first case, in random order, that can proceed. When none of them can, and there is no
+// default case, it registers a waiter on each channel of the cases, and blocks until another goroutine
+// completes one of them.
+func (s *scheduler) operate(position string, cases []SelectCase, hasDefault bool) (int, interface{}) {
+	g := s.current
+	s.yield()
+
+	channels := make([]*scheduledChannel, len(cases))
+	for i, c := range cases {
+		channels[i] = s.channel(channel(c.Channel, "select"))
+	}
+
+	for _, i := range s.random.Perm(len(cases)) {
+		var (
+			value interface{}
+			ok    bool
+		)
+
+		if cases[i].Send {
+			ok = channels[i].put(cases[i].Value)
+		} else {
+			value, ok = channels[i].take()
+		}
+
+		if ok {
+			s.progress++
+			return i, value
+		}
+	}
+
+	if hasDefault {
+		s.progress++
+		return -1, nil
+	}
+
+	o := &channelOperation{}
+	for i, c := range cases {
+		w := &channelWaiter{operation: o, index: i, value: c.Value}
+		if c.Send {
+			channels[i].senders = append(channels[i].senders, w)
+		} else {
+			channels[i].receivers = append(channels[i].receivers, w)
+		}
+	}
+
+	for !o.done {
+		g.blocked, g.blockedAt = position, s.progress
+		s.yield()
+	}
+
+	g.blocked = ""
+	if o.closed {
+		panic("send on closed channel")
+	}
+
+	return o.selected, o.value
+}
+
+func (s *scheduler) send(position string, c chan interface{}, v interface{}) {
+	s.operate(position, []SelectCase{{Send: true, Channel: c, Value: v}}, false)
+}
+
+func (s *scheduler) receive(position string, c chan interface{}) interface{} {
+	_, v := s.operate(position, []SelectCase{{Channel: c}}, false)
+	return v
+}
+
+func (s *scheduler) selectCase(position string, cases []SelectCase, hasDefault bool) (int, interface{}) {
+	return s.operate(position, cases, hasDefault)
+}
+
+func (s *scheduler) length(c chan interface{}) int {
+	return len(s.channel(c).buffer)
+}
+
+// closing a channel completes the waiting receivers with Closed, and makes the waiting senders panic
+func (s *scheduler) close(c chan interface{}) {
+	sc := s.channel(c)
+	if sc.closed {
+		panic("close of closed channel")
+	}
+
+	sc.closed = true
+	for r := firstWaiting(&sc.receivers); r != nil; r = firstWaiting(&sc.receivers) {
+		r.complete(Closed)
+	}
+
+	for w := firstWaiting(&sc.senders); w != nil; w = firstWaiting(&sc.senders) {
+		w.operation.closed = true
+		w.complete(nil)
+	}
+
+	s.progress++
+}
+
+// Go starts a function on a new goroutine.
+func Go(position string, f interface{}, args []interface{}) {
+	if schedule == nil {
+		go f.(*Function).Call(args)
+		return
+	}
+
+	schedule.start(position, func() { f.(*Function).Call(args) })
+}
+
+func goBuiltin(description string, f func()) {
+	if schedule == nil {
+		go f()
+		return
+	}
+
+	schedule.start(description, f)
+}
+
+func waitDone(description string, done chan struct{}) {
+	if schedule == nil {
+		<-done
+		return
+	}
+
+	schedule.waitFor(description, func() bool {
+		select {
+		case <-done:
+			return true
+		default:
+			return false
+		}
+	})
+}
+
+func lock(description string, m *sync.Mutex) {
+	if schedule == nil {
+		m.Lock()
+		return
+	}
+
+	schedule.waitFor(description, m.TryLock)
+}
+
+func unlock(m *sync.Mutex) {
+	m.Unlock()
+	if schedule != nil {
+		schedule.progress++
+	}
+}
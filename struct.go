@@ -0,0 +1,254 @@
+package mml
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+const (
+	structBits  = 5
+	structWidth = 1 << structBits
+	structMask  = structWidth - 1
+)
+
+type structEntry struct {
+	hash  uint32
+	key   string
+	value interface{}
+}
+
+// the entries whose keys have the same hash
+type structCollision struct {
+	hash    uint32
+	entries []*structEntry
+}
+
+// structNode is a node of a persistent hash array mapped trie. The children are stored only for the set
+// bits of the bitmap, in the order of the bits, and they can be entries, collisions or further nodes.
+// Changing a trie copies only the path to the changed entry, and shares the rest with the original version.
+type structNode struct {
+	bitmap   uint32
+	children []interface{}
+}
+
+// Struct is a set of key-value pairs. It is backed by a persistent hash array mapped trie, so extending a
+// struct with a spread shares the entries with the original struct instead of copying them.
+type Struct struct {
+	root *structNode
+	size int
+}
+
+var emptyStructNode = &structNode{}
+
+// FNV-1a
+func hashKey(key string) uint32 {
+	h := uint32(2166136261)
+	for i := 0; i < len(key); i++ {
+		h ^= uint32(key[i])
+		h *= 16777619
+	}
+
+	return h
+}
+
+func (n *structNode) position(bit uint32) int {
+	return bits.OnesCount32(n.bitmap & (bit - 1))
+}
+
+func (n *structNode) get(shift uint, hash uint32, key string) (interface{}, bool) {
+	for {
+		bit := uint32(1) << ((hash >> shift) & structMask)
+		if n.bitmap&bit == 0 {
+			return nil, false
+		}
+
+		switch c := n.children[n.position(bit)].(type) {
+		case *structEntry:
+			if c.key != key {
+				return nil, false
+			}
+
+			return c.value, true
+		case *structCollision:
+			for _, e := range c.entries {
+				if e.key == key {
+					return e.value, true
+				}
+			}
+
+			return nil, false
+		default:
+			n = c.(*structNode)
+			shift += structBits
+		}
+	}
+}
+
+func (n *structNode) withChild(position int, child interface{}) *structNode {
+	children := make([]interface{}, len(n.children))
+	copy(children, n.children)
+	children[position] = child
+	return &structNode{bitmap: n.bitmap, children: children}
+}
+
+// merges two entries with different keys into a new node, or into a collision when their hashes are equal
+func mergeEntries(shift uint, e0, e1 *structEntry) interface{} {
+	if e0.hash == e1.hash {
+		return &structCollision{hash: e0.hash, entries: []*structEntry{e0, e1}}
+	}
+
+	n, _ := emptyStructNode.set(shift, e0)
+	n, _ = n.set(shift, e1)
+	return n
+}
+
+func (c *structCollision) set(e *structEntry) (*structCollision, bool) {
+	entries := make([]*structEntry, len(c.entries), len(c.entries)+1)
+	copy(entries, c.entries)
+	for i := range entries {
+		if entries[i].key == e.key {
+			entries[i] = e
+			return &structCollision{hash: c.hash, entries: entries}, false
+		}
+	}
+
+	return &structCollision{hash: c.hash, entries: append(entries, e)}, true
+}
+
+// set returns the changed node, and true when a new key was added
+func (n *structNode) set(shift uint, e *structEntry) (*structNode, bool) {
+	bit := uint32(1) << ((e.hash >> shift) & structMask)
+	position := n.position(bit)
+	if n.bitmap&bit == 0 {
+		children := make([]interface{}, len(n.children)+1)
+		copy(children, n.children[:position])
+		children[position] = e
+		copy(children[position+1:], n.children[position:])
+		return &structNode{bitmap: n.bitmap | bit, children: children}, true
+	}
+
+	switch c := n.children[position].(type) {
+	case *structEntry:
+		if c.key == e.key {
+			return n.withChild(position, e), false
+		}
+
+		return n.withChild(position, mergeEntries(shift+structBits, c, e)), true
+	case *structCollision:
+		if c.hash == e.hash {
+			cc, added := c.set(e)
+			return n.withChild(position, cc), added
+		}
+
+		// the collision needs to be moved one level down, next to the new entry
+		sub := &structNode{
+			bitmap:   uint32(1) << ((c.hash >> (shift + structBits)) & structMask),
+			children: []interface{}{c},
+		}
+
+		sub, _ = sub.set(shift+structBits, e)
+		return n.withChild(position, sub), true
+	default:
+		sub, added := c.(*structNode).set(shift+structBits, e)
+		return n.withChild(position, sub), added
+	}
+}
+
+func (n *structNode) each(f func(*structEntry)) {
+	for _, c := range n.children {
+		switch ct := c.(type) {
+		case *structEntry:
+			f(ct)
+		case *structCollision:
+			for _, e := range ct.entries {
+				f(e)
+			}
+		default:
+			ct.(*structNode).each(f)
+		}
+	}
+}
+
+// NewStruct creates a struct from a map. The map is not referenced by the struct.
+func NewStruct(values map[string]interface{}) *Struct {
+	s := &Struct{}
+	for k, v := range values {
+		s.Set(k, v)
+	}
+
+	return s
+}
+
+func (s *Struct) node() *structNode {
+	if s.root == nil {
+		return emptyStructNode
+	}
+
+	return s.root
+}
+
+func (s *Struct) Len() int {
+	return s.size
+}
+
+// Get returns the value of a key, or nil when the struct doesn't have the key.
+func (s *Struct) Get(key string) interface{} {
+	v, _ := s.node().get(0, hashKey(key), key)
+	return v
+}
+
+func (s *Struct) Has(key string) bool {
+	_, ok := s.node().get(0, hashKey(key), key)
+	return ok
+}
+
+// Set changes the value of a key in place. It is used only while creating a struct, or with mutable structs,
+// the other structs sharing the entries are not affected.
+func (s *Struct) Set(key string, value interface{}) {
+	s.set(&structEntry{hash: hashKey(key), key: key, value: value})
+}
+
+func (s *Struct) set(e *structEntry) {
+	root, added := s.node().set(0, e)
+	s.root = root
+	if added {
+		s.size++
+	}
+}
+
+// Merge sets all the keys of another struct in place. When the struct is empty, it shares the entries of the
+// other struct.
+func (s *Struct) Merge(other *Struct) {
+	if s.size == 0 {
+		s.root, s.size = other.root, other.size
+		return
+	}
+
+	if other.root != nil {
+		other.root.each(s.set)
+	}
+}
+
+func (s *Struct) Keys() []string {
+	keys := make([]string, 0, s.size)
+	if s.root != nil {
+		s.root.each(func(e *structEntry) { keys = append(keys, e.key) })
+	}
+
+	return keys
+}
+
+// Map returns the entries of the struct in a new map.
+func (s *Struct) Map() map[string]interface{} {
+	m := make(map[string]interface{}, s.size)
+	if s.root != nil {
+		s.root.each(func(e *structEntry) { m[e.key] = e.value })
+	}
+
+	return m
+}
+
+// String formats the struct the same way as the map of its entries.
+func (s *Struct) String() string {
+	return fmt.Sprintf("&{%v}", s.Map())
+}
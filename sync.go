@@ -0,0 +1,126 @@
+package mml
+
+import (
+	"fmt"
+	"sync"
+)
+
+func effect(fixedArgs int, f func([]interface{}) interface{}) *Function {
+	return &Function{F: f, FixedArgs: fixedArgs}
+}
+
+func lockAt(m *sync.Mutex) func(string) *Function {
+	var at func(string) *Function
+	at = func(position string) *Function {
+		f := effect(0, func([]interface{}) interface{} {
+			lock(position, m)
+			return nil
+		})
+
+		f.at = at
+		return f
+	}
+
+	return at
+}
+
+var Mutex = &Function{
+	F: func([]interface{}) interface{} {
+		var m sync.Mutex
+		return NewStruct(map[string]interface{}{
+			"lock": lockAt(&m)("lock"),
+			"unlock": effect(0, func([]interface{}) interface{} {
+				unlock(&m)
+				return nil
+			}),
+		})
+	},
+}
+
+func atomicAdd(left, right interface{}) interface{} {
+	switch lt := left.(type) {
+	case int:
+		return lt + right.(int)
+	case float64:
+		return lt + right.(float64)
+	default:
+		panic(fmt.Sprintf("atomic add: unsupported code: %v", left))
+	}
+}
+
+var Atomic = &Function{
+	F: func(a []interface{}) interface{} {
+		var (
+			m sync.Mutex
+			v = a[0]
+		)
+
+		return NewStruct(map[string]interface{}{
+			"load": effect(0, func([]interface{}) interface{} {
+				m.Lock()
+				defer m.Unlock()
+				return v
+			}),
+			"store": effect(1, func(a []interface{}) interface{} {
+				m.Lock()
+				defer m.Unlock()
+				v = a[0]
+				return nil
+			}),
+			"swap": effect(1, func(a []interface{}) interface{} {
+				m.Lock()
+				defer m.Unlock()
+				prev := v
+				v = a[0]
+				return prev
+			}),
+			"add": effect(1, func(a []interface{}) interface{} {
+				m.Lock()
+				defer m.Unlock()
+				v = atomicAdd(v, a[0])
+				return v
+			}),
+			"compareAndSwap": effect(2, func(a []interface{}) interface{} {
+				m.Lock()
+				defer m.Unlock()
+				if v != a[0] {
+					return false
+				}
+
+				v = a[1]
+				return true
+			}),
+		})
+	},
+	FixedArgs: 1,
+}
+
+// the functions created by once can be called from anywhere, so their calls are reported with the position
+// where they were created
+func onceAt(description string) *Function {
+	return &Function{
+		F: func(a []interface{}) interface{} {
+			var (
+				m      sync.Mutex
+				done   bool
+				f      = a[0].(*Function)
+				result interface{}
+			)
+
+			return effect(0, func([]interface{}) interface{} {
+				lock(description, &m)
+				defer unlock(&m)
+				if !done {
+					result = f.Call(nil)
+					done = true
+				}
+
+				return result
+			})
+		},
+		FixedArgs: 1,
+		at:        func(position string) *Function { return onceAt("the function created by once at " + position) },
+	}
+}
+
+var Once = onceAt("once")
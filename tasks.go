@@ -0,0 +1,222 @@
+package mml
+
+import (
+	"fmt"
+	"sync"
+)
+
+type task struct {
+	done   chan struct{}
+	result interface{}
+	group  *taskGroup
+}
+
+type taskGroup struct {
+	lock       sync.Mutex
+	tasks      []*task
+	err        error
+	cancelled  chan interface{}
+	cancelOnce sync.Once
+}
+
+func newTaskGroup() *taskGroup {
+	return &taskGroup{cancelled: make(chan interface{})}
+}
+
+func recoveredError(r interface{}) error {
+	if err, ok := r.(error); ok {
+		return err
+	}
+
+	return fmt.Errorf("%v", r)
+}
+
+func (g *taskGroup) cancel() {
+	g.cancelOnce.Do(func() { close(g.cancelled) })
+}
+
+func (g *taskGroup) isCancelled() bool {
+	select {
+	case <-g.cancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+func (g *taskGroup) fail(err error) {
+	g.lock.Lock()
+	if g.err == nil {
+		g.err = err
+	}
+
+	g.lock.Unlock()
+	g.cancel()
+}
+
+// the results are collected in the order of spawning, while the error is the first one that occurred.
+// Tasks spawned while waiting are waited for, too.
+func (g *taskGroup) wait(position string) interface{} {
+	for i := 0; ; i++ {
+		g.lock.Lock()
+		if i == len(g.tasks) {
+			g.lock.Unlock()
+			break
+		}
+
+		t := g.tasks[i]
+		g.lock.Unlock()
+		waitDone(position, t.done)
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	if g.err != nil {
+		return g.err
+	}
+
+	var results []interface{}
+	for _, t := range g.tasks {
+		results = append(results, t.result)
+	}
+
+	return NewList(results)
+}
+
+func spawn(position string, g *taskGroup, f *Function, args []interface{}) *task {
+	t := &task{done: make(chan struct{}), group: g}
+
+	g.lock.Lock()
+	g.tasks = append(g.tasks, t)
+	g.lock.Unlock()
+
+	goBuiltin(position, func() {
+		defer close(t.done)
+		defer func() {
+			if r := recover(); r != nil {
+				t.result = recoveredError(r)
+			}
+
+			if err, ok := t.result.(error); ok {
+				g.fail(err)
+			}
+		}()
+
+		t.result = f.Call(args)
+	})
+
+	return t
+}
+
+func groupOf(v interface{}) *taskGroup {
+	switch vt := v.(type) {
+	case *task:
+		return vt.group
+	case *taskGroup:
+		return vt
+	default:
+		panic("unsupported code: expected task or task group: " + fmt.Sprint(v))
+	}
+}
+
+func spawnAt(position string) *Function {
+	return &Function{
+		F: func(a []interface{}) interface{} {
+			return spawn(position, newTaskGroup(), a[0].(*Function), a[1:])
+		},
+		FixedArgs: 1,
+		at:        spawnAt,
+	}
+}
+
+func spawnInAt(position string) *Function {
+	return &Function{
+		F: func(a []interface{}) interface{} {
+			g, ok := a[0].(*taskGroup)
+			if !ok {
+				panic("spawn in: unsupported code: " + fmt.Sprint(a[0]))
+			}
+
+			return spawn(position, g, a[1].(*Function), a[2:])
+		},
+		FixedArgs: 2,
+		at:        spawnInAt,
+	}
+}
+
+var Spawn = spawnAt("spawn")
+
+func awaitAt(position string) *Function {
+	return &Function{
+		F: func(a []interface{}) interface{} {
+			t, ok := a[0].(*task)
+			if !ok {
+				panic("await: unsupported code: " + fmt.Sprint(a[0]))
+			}
+
+			waitDone(position, t.done)
+			return t.result
+		},
+		FixedArgs: 1,
+		at:        awaitAt,
+	}
+}
+
+var Await = awaitAt("await")
+
+var TaskGroup = &Function{
+	F: func([]interface{}) interface{} {
+		return newTaskGroup()
+	},
+}
+
+var SpawnIn = spawnInAt("spawnIn")
+
+// At is used by the compiled code when calling the built-in functions that start goroutines or wait for them,
+// spawn, spawnIn, await, wait, once and the lock of a mutex. When the deterministic scheduler is enabled, it
+// returns a version of them that reports the started or blocked goroutines with the position of the call, and
+// in case of once, with the position where the function was created. Other functions are returned unchanged.
+func At(position string, f *Function) *Function {
+	if schedule == nil || f.at == nil {
+		return f
+	}
+
+	p := f.at(position)
+	if len(f.args) > 0 {
+		p = p.Bind(f.args)
+	}
+
+	return p
+}
+
+func waitAt(position string) *Function {
+	return &Function{
+		F: func(a []interface{}) interface{} {
+			g, ok := a[0].(*taskGroup)
+			if !ok {
+				panic("wait: unsupported code: " + fmt.Sprint(a[0]))
+			}
+
+			return g.wait(position)
+		},
+		FixedArgs: 1,
+		at:        waitAt,
+	}
+}
+
+var Wait = waitAt("wait")
+
+var Cancel = &Function{
+	F: func(a []interface{}) interface{} {
+		groupOf(a[0]).cancel()
+		return nil
+	},
+	FixedArgs: 1,
+}
+
+var IsCancelled = &Function{
+	F: func(a []interface{}) interface{} {
+		return groupOf(a[0]).isCancelled()
+	},
+	FixedArgs: 1,
+}